@@ -0,0 +1,410 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned when a call is rejected by an open circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit
+type CircuitState int
+
+// Circuit states
+const (
+	StateClosed CircuitState = iota
+	StateOpen
+	StateHalfOpen
+)
+
+// String returns the name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case StateClosed:
+		return "CLOSED"
+	case StateOpen:
+		return "OPEN"
+	case StateHalfOpen:
+		return "HALF_OPEN"
+	}
+	return "UNKNOWN"
+}
+
+// Call outcomes reported to CircuitBreakerMetrics
+const (
+	OutcomeSuccess  = "success"
+	OutcomeFailure  = "failure"
+	OutcomeSlow     = "slow"
+	OutcomeRejected = "rejected"
+)
+
+// CircuitOpenError is the error returned for rejected calls. It matches
+// ErrCircuitOpen with errors.Is.
+type CircuitOpenError struct {
+	Target     string
+	Method     string
+	RetryAfter time.Duration
+}
+
+// Error implements error
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%v for %s%s (retry after %v)", ErrCircuitOpen, e.Target, e.Method, e.RetryAfter)
+}
+
+// Is reports whether target is ErrCircuitOpen
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreakerMetrics receives call outcomes and state changes, e.g. to export them to Prometheus
+type CircuitBreakerMetrics interface {
+	RecordCall(target, method, outcome string)
+	RecordState(target, method, state string)
+}
+
+// CircuitBreakerConfig contains configuration for the circuit breaker
+type CircuitBreakerConfig struct {
+	// FailureRateThreshold is the failure percentage (0-100) at which a circuit opens
+	FailureRateThreshold float64
+	// SlowCallRateThreshold is the slow call percentage (0-100) at which a circuit opens
+	SlowCallRateThreshold float64
+	// SlowCallDuration is the duration above which a call counts as slow
+	SlowCallDuration time.Duration
+	// WindowSize is the number of most recent calls used to compute the rates
+	WindowSize int
+	// MinimumCalls is the number of calls required before the rates are evaluated
+	MinimumCalls int
+	// OpenTimeout is how long a circuit stays open before allowing trial calls
+	OpenTimeout time.Duration
+	// HalfOpenCalls is the number of trial calls permitted in the half-open state
+	HalfOpenCalls int
+
+	// IsFailure decides whether an error counts as a failure; nil uses IsTransientError
+	IsFailure func(err error) bool
+	// OnStateChange is called whenever a circuit changes state
+	OnStateChange func(target, method string, from, to CircuitState)
+	// Metrics receives call outcomes and state changes
+	Metrics CircuitBreakerMetrics
+}
+
+// DefaultCircuitBreakerConfig returns a default configuration for the circuit breaker
+func DefaultCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		FailureRateThreshold:  50,
+		SlowCallRateThreshold: 100,
+		SlowCallDuration:      time.Second,
+		WindowSize:            20,
+		MinimumCalls:          10,
+		OpenTimeout:           10 * time.Second,
+		HalfOpenCalls:         3,
+	}
+}
+
+// IsTransientError reports whether err indicates an unhealthy service rather
+// than a rejected request. Calculation errors such as division by zero are not
+// transient and never open a circuit.
+func IsTransientError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal,
+		codes.Unknown, codes.ResourceExhausted, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// CircuitBreaker tracks one circuit per target and method
+type CircuitBreaker struct {
+	config *CircuitBreakerConfig
+
+	mu       sync.Mutex
+	circuits map[circuitKey]*circuit
+	changes  []stateChange
+}
+
+// stateChange is a pending OnStateChange notification
+type stateChange struct {
+	key      circuitKey
+	from, to CircuitState
+}
+
+// circuitKey identifies a circuit
+type circuitKey struct {
+	target string
+	method string
+}
+
+// circuit holds the state and sliding window of a single target and method
+type circuit struct {
+	state    CircuitState
+	openedAt time.Time
+
+	// outcomes is a ring buffer of the most recent calls in the closed state
+	outcomes []outcome
+	next     int
+	count    int
+
+	// trial call accounting for the half-open state
+	halfOpenStarted int
+	halfOpenResults []outcome
+}
+
+// outcome is the result of a single call
+type outcome struct {
+	failed bool
+	slow   bool
+}
+
+// NewCircuitBreaker creates a new circuit breaker
+func NewCircuitBreaker(config *CircuitBreakerConfig) *CircuitBreaker {
+	if config == nil {
+		config = DefaultCircuitBreakerConfig()
+	}
+	// Defaults are filled in on a copy, the caller may share its config
+	copied := *config
+	config = &copied
+	if config.WindowSize <= 0 {
+		config.WindowSize = 1
+	}
+	if config.MinimumCalls > config.WindowSize {
+		config.MinimumCalls = config.WindowSize
+	}
+	if config.HalfOpenCalls <= 0 {
+		config.HalfOpenCalls = 1
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = IsTransientError
+	}
+
+	return &CircuitBreaker{
+		config:   config,
+		circuits: make(map[circuitKey]*circuit),
+	}
+}
+
+// State returns the current state of the circuit for target and method
+func (cb *CircuitBreaker) State(target, method string) CircuitState {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	c, ok := cb.circuits[circuitKey{target, method}]
+	if !ok {
+		return StateClosed
+	}
+	cb.checkTimeout(circuitKey{target, method}, c)
+	return c.state
+}
+
+// Execute runs fn if the circuit for target and method permits it and records the outcome
+func (cb *CircuitBreaker) Execute(target, method string, fn func() error) error {
+	key := circuitKey{target, method}
+
+	if err := cb.acquire(key); err != nil {
+		cb.recordMetric(key, OutcomeRejected)
+		return err
+	}
+
+	start := time.Now()
+	err := fn()
+	duration := time.Since(start)
+
+	// Calls abandoned by the caller say nothing about the service
+	if status.Code(err) == codes.Canceled || errors.Is(err, context.Canceled) {
+		cb.release(key)
+		return err
+	}
+
+	o := outcome{
+		failed: err != nil && cb.config.IsFailure(err),
+		slow:   cb.config.SlowCallDuration > 0 && duration > cb.config.SlowCallDuration,
+	}
+	cb.record(key, o)
+
+	switch {
+	case o.failed:
+		cb.recordMetric(key, OutcomeFailure)
+	case o.slow:
+		cb.recordMetric(key, OutcomeSlow)
+	default:
+		cb.recordMetric(key, OutcomeSuccess)
+	}
+
+	return err
+}
+
+// UnaryClientInterceptor returns a client interceptor that guards every RPC with the breaker
+func (cb *CircuitBreaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return cb.Execute(cc.Target(), method, func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// acquire checks whether a call may proceed
+func (cb *CircuitBreaker) acquire(key circuitKey) error {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	c := cb.circuit(key)
+	cb.checkTimeout(key, c)
+
+	switch c.state {
+	case StateOpen:
+		return &CircuitOpenError{
+			Target:     key.target,
+			Method:     key.method,
+			RetryAfter: time.Until(c.openedAt.Add(cb.config.OpenTimeout)),
+		}
+	case StateHalfOpen:
+		if c.halfOpenStarted >= cb.config.HalfOpenCalls {
+			return &CircuitOpenError{
+				Target: key.target,
+				Method: key.method,
+			}
+		}
+		c.halfOpenStarted++
+	}
+
+	return nil
+}
+
+// release gives back a trial call slot without recording an outcome
+func (cb *CircuitBreaker) release(key circuitKey) {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	c := cb.circuit(key)
+	if c.state == StateHalfOpen && c.halfOpenStarted > 0 {
+		c.halfOpenStarted--
+	}
+}
+
+// record adds the outcome of a call and transitions the circuit if needed
+func (cb *CircuitBreaker) record(key circuitKey, o outcome) {
+	cb.mu.Lock()
+	defer cb.unlock()
+
+	c := cb.circuit(key)
+
+	switch c.state {
+	case StateClosed:
+		c.outcomes[c.next] = o
+		c.next = (c.next + 1) % len(c.outcomes)
+		if c.count < len(c.outcomes) {
+			c.count++
+		}
+
+		if c.count >= cb.config.MinimumCalls && cb.tripped(c.outcomes[:c.count]) {
+			cb.transition(key, c, StateOpen)
+		}
+
+	case StateHalfOpen:
+		c.halfOpenResults = append(c.halfOpenResults, o)
+		if len(c.halfOpenResults) < cb.config.HalfOpenCalls {
+			return
+		}
+
+		if cb.tripped(c.halfOpenResults) {
+			cb.transition(key, c, StateOpen)
+		} else {
+			cb.transition(key, c, StateClosed)
+		}
+	}
+}
+
+// tripped reports whether the failure or slow call rate exceeds its threshold
+func (cb *CircuitBreaker) tripped(outcomes []outcome) bool {
+	if len(outcomes) == 0 {
+		return false
+	}
+
+	var failed, slow int
+	for _, o := range outcomes {
+		if o.failed {
+			failed++
+		}
+		if o.slow {
+			slow++
+		}
+	}
+
+	total := float64(len(outcomes))
+	if cb.config.FailureRateThreshold > 0 && float64(failed)*100/total >= cb.config.FailureRateThreshold {
+		return true
+	}
+	if cb.config.SlowCallRateThreshold > 0 && float64(slow)*100/total >= cb.config.SlowCallRateThreshold {
+		return true
+	}
+
+	return false
+}
+
+// checkTimeout moves an open circuit to half-open once its timeout has passed
+func (cb *CircuitBreaker) checkTimeout(key circuitKey, c *circuit) {
+	if c.state == StateOpen && time.Since(c.openedAt) >= cb.config.OpenTimeout {
+		cb.transition(key, c, StateHalfOpen)
+	}
+}
+
+// transition changes the state of a circuit and resets its counters
+func (cb *CircuitBreaker) transition(key circuitKey, c *circuit, to CircuitState) {
+	from := c.state
+	c.state = to
+	c.halfOpenStarted = 0
+	c.halfOpenResults = nil
+
+	switch to {
+	case StateOpen:
+		c.openedAt = time.Now()
+	case StateClosed:
+		c.next = 0
+		c.count = 0
+	}
+
+	if cb.config.Metrics != nil {
+		cb.config.Metrics.RecordState(key.target, key.method, to.String())
+	}
+	if cb.config.OnStateChange != nil {
+		cb.changes = append(cb.changes, stateChange{key, from, to})
+	}
+}
+
+// unlock releases the lock and then delivers pending state change
+// notifications, so that callbacks may query the breaker
+func (cb *CircuitBreaker) unlock() {
+	changes := cb.changes
+	cb.changes = nil
+	cb.mu.Unlock()
+
+	for _, change := range changes {
+		cb.config.OnStateChange(change.key.target, change.key.method, change.from, change.to)
+	}
+}
+
+// circuit returns the circuit for key, creating it if needed
+func (cb *CircuitBreaker) circuit(key circuitKey) *circuit {
+	c, ok := cb.circuits[key]
+	if !ok {
+		c = &circuit{
+			state:    StateClosed,
+			outcomes: make([]outcome, cb.config.WindowSize),
+		}
+		cb.circuits[key] = c
+	}
+	return c
+}
+
+// recordMetric reports a call outcome to the configured metrics
+func (cb *CircuitBreaker) recordMetric(key circuitKey, outcome string) {
+	if cb.config.Metrics != nil {
+		cb.config.Metrics.RecordCall(key.target, key.method, outcome)
+	}
+}
@@ -0,0 +1,251 @@
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	client "llamacalc/api/client/go"
+)
+
+var (
+	errUnavailable = status.Error(codes.Unavailable, "down")
+	errInvalid     = status.Error(codes.InvalidArgument, "division by zero")
+)
+
+// metrics records what a breaker reports
+type metrics struct {
+	mu     sync.Mutex
+	calls  []string
+	states []string
+}
+
+func (m *metrics) RecordCall(target, method, outcome string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, outcome)
+}
+
+func (m *metrics) RecordState(target, method, state string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states = append(m.states, state)
+}
+
+// testConfig returns a configuration deciding on windows of 4 calls
+func testConfig() *client.CircuitBreakerConfig {
+	return &client.CircuitBreakerConfig{
+		FailureRateThreshold: 50,
+		SlowCallDuration:     5 * time.Millisecond,
+		WindowSize:           4,
+		MinimumCalls:         4,
+		OpenTimeout:          20 * time.Millisecond,
+		HalfOpenCalls:        2,
+	}
+}
+
+// call returns a call that fails with err after sleeping for d
+func call(err error, d time.Duration) func() error {
+	return func() error {
+		time.Sleep(d)
+		return err
+	}
+}
+
+func TestCircuitOpens(t *testing.T) {
+	slow := 10 * time.Millisecond
+	tests := []struct {
+		Name      string
+		Configure func(*client.CircuitBreakerConfig)
+		Calls     []func() error
+		Want      client.CircuitState
+	}{
+		{"below minimum calls", nil, []func() error{call(errUnavailable, 0), call(errUnavailable, 0), call(errUnavailable, 0)}, client.StateClosed},
+		{"below failure rate", nil, []func() error{call(nil, 0), call(errUnavailable, 0), call(nil, 0), call(nil, 0)}, client.StateClosed},
+		{"failure rate", nil, []func() error{call(nil, 0), call(errUnavailable, 0), call(nil, 0), call(errUnavailable, 0)}, client.StateOpen},
+		{"calculation errors", nil, []func() error{call(errInvalid, 0), call(errInvalid, 0), call(errInvalid, 0), call(errInvalid, 0)}, client.StateClosed},
+		{"custom failures", func(config *client.CircuitBreakerConfig) {
+			config.IsFailure = func(err error) bool { return err != nil }
+		}, []func() error{call(errInvalid, 0), call(errInvalid, 0), call(nil, 0), call(nil, 0)}, client.StateOpen},
+		{"slow calls disabled", nil, []func() error{call(nil, slow), call(nil, slow), call(nil, slow), call(nil, slow)}, client.StateClosed},
+		{"below slow call rate", func(config *client.CircuitBreakerConfig) {
+			config.SlowCallRateThreshold = 75
+		}, []func() error{call(nil, slow), call(nil, slow), call(nil, 0), call(nil, 0)}, client.StateClosed},
+		{"slow call rate", func(config *client.CircuitBreakerConfig) {
+			config.SlowCallRateThreshold = 75
+		}, []func() error{call(nil, slow), call(nil, slow), call(nil, 0), call(nil, slow)}, client.StateOpen},
+		{"sliding window", nil, []func() error{call(nil, 0), call(errUnavailable, 0), call(nil, 0), call(nil, 0), call(errUnavailable, 0)}, client.StateOpen},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			config := testConfig()
+			if tc.Configure != nil {
+				tc.Configure(config)
+			}
+			cb := client.NewCircuitBreaker(config)
+			for _, fn := range tc.Calls {
+				cb.Execute("calc", "/Add", fn)
+			}
+			if state := cb.State("calc", "/Add"); state != tc.Want {
+				t.Errorf("got %v, want %v", state, tc.Want)
+			}
+		})
+	}
+}
+
+// open opens the circuit of cb for calc/Add and returns cb
+func open(t *testing.T, cb *client.CircuitBreaker) *client.CircuitBreaker {
+	t.Helper()
+	for i := 0; i < 4; i++ {
+		cb.Execute("calc", "/Add", call(errUnavailable, 0))
+	}
+	if state := cb.State("calc", "/Add"); state != client.StateOpen {
+		t.Fatalf("got %v, want OPEN", state)
+	}
+	return cb
+}
+
+func TestOpenCircuit(t *testing.T) {
+	cb := open(t, client.NewCircuitBreaker(testConfig()))
+
+	called := false
+	err := cb.Execute("calc", "/Add", func() error {
+		called = true
+		return nil
+	})
+	if called {
+		t.Error("open circuit let a call through")
+	}
+	var openErr *client.CircuitOpenError
+	if !errors.Is(err, client.ErrCircuitOpen) || !errors.As(err, &openErr) {
+		t.Fatalf("got %v, want ErrCircuitOpen", err)
+	}
+	if openErr.Target != "calc" || openErr.Method != "/Add" || openErr.RetryAfter <= 0 || openErr.RetryAfter > 20*time.Millisecond {
+		t.Errorf("got %+v", openErr)
+	}
+	if wrapped := fmt.Errorf("add: %w", err); !errors.Is(wrapped, client.ErrCircuitOpen) {
+		t.Error("wrapped error does not match ErrCircuitOpen")
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	if state := cb.State("calc", "/Add"); state != client.StateHalfOpen {
+		t.Errorf("got %v after the open timeout, want HALF_OPEN", state)
+	}
+}
+
+func TestHalfOpen(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Trials []error
+		Want   client.CircuitState
+	}{
+		{"trials succeed", []error{nil, nil}, client.StateClosed},
+		{"trial fails", []error{nil, errUnavailable}, client.StateOpen},
+		{"calculation error", []error{errInvalid, nil}, client.StateClosed},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			cb := open(t, client.NewCircuitBreaker(testConfig()))
+			time.Sleep(25 * time.Millisecond)
+
+			// Only HalfOpenCalls trial calls are let through at a time
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var wg sync.WaitGroup
+			for _, err := range tc.Trials {
+				err := err
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					cb.Execute("calc", "/Add", func() error {
+						started <- struct{}{}
+						<-release
+						return err
+					})
+				}()
+			}
+			for range tc.Trials {
+				<-started
+			}
+			if err := cb.Execute("calc", "/Add", call(nil, 0)); !errors.Is(err, client.ErrCircuitOpen) {
+				t.Errorf("got %v for a call beyond the trials, want ErrCircuitOpen", err)
+			}
+			close(release)
+			wg.Wait()
+
+			if state := cb.State("calc", "/Add"); state != tc.Want {
+				t.Errorf("got %v, want %v", state, tc.Want)
+			}
+		})
+	}
+}
+
+func TestCircuitIsolation(t *testing.T) {
+	cb := open(t, client.NewCircuitBreaker(testConfig()))
+
+	for _, key := range [][2]string{{"calc", "/Subtract"}, {"other", "/Add"}} {
+		if state := cb.State(key[0], key[1]); state != client.StateClosed {
+			t.Errorf("%s%s: got %v, want CLOSED", key[0], key[1], state)
+		}
+		if err := cb.Execute(key[0], key[1], call(nil, 0)); err != nil {
+			t.Errorf("%s%s: got %v", key[0], key[1], err)
+		}
+	}
+}
+
+func TestCircuitCallbacks(t *testing.T) {
+	config := testConfig()
+	m := &metrics{}
+	config.Metrics = m
+	var changes []string
+	var cb *client.CircuitBreaker
+	config.OnStateChange = func(target, method string, from, to client.CircuitState) {
+		// Callbacks may query the breaker
+		if state := cb.State(target, method); state != to {
+			t.Errorf("state %v in callback, want %v", state, to)
+		}
+		changes = append(changes, fmt.Sprintf("%s%s %v->%v", target, method, from, to))
+	}
+	cb = client.NewCircuitBreaker(config)
+	open(t, cb)
+
+	cb.Execute("calc", "/Add", call(nil, 0))
+	time.Sleep(25 * time.Millisecond)
+	cb.Execute("calc", "/Add", call(nil, 0))
+	cb.Execute("calc", "/Add", call(nil, 10*time.Millisecond))
+
+	want := []string{"calc/Add CLOSED->OPEN", "calc/Add OPEN->HALF_OPEN", "calc/Add HALF_OPEN->CLOSED"}
+	if fmt.Sprint(changes) != fmt.Sprint(want) {
+		t.Errorf("got changes %v, want %v", changes, want)
+	}
+	if want := []string{"OPEN", "HALF_OPEN", "CLOSED"}; fmt.Sprint(m.states) != fmt.Sprint(want) {
+		t.Errorf("got states %v, want %v", m.states, want)
+	}
+	wantCalls := []string{
+		client.OutcomeFailure, client.OutcomeFailure, client.OutcomeFailure, client.OutcomeFailure,
+		client.OutcomeRejected, client.OutcomeSuccess, client.OutcomeSlow,
+	}
+	if fmt.Sprint(m.calls) != fmt.Sprint(wantCalls) {
+		t.Errorf("got calls %v, want %v", m.calls, wantCalls)
+	}
+}
+
+func TestCircuitBreakerConfig(t *testing.T) {
+	// The defaults are not written to the caller's config
+	config := &client.CircuitBreakerConfig{FailureRateThreshold: 50, OpenTimeout: time.Minute}
+	cb := client.NewCircuitBreaker(config)
+	if config.WindowSize != 0 || config.HalfOpenCalls != 0 || config.IsFailure != nil {
+		t.Errorf("config changed to %+v", config)
+	}
+
+	// A window of one call opens on its failure
+	cb.Execute("calc", "/Add", call(errUnavailable, 0))
+	if state := cb.State("calc", "/Add"); state != client.StateOpen {
+		t.Errorf("got %v, want OPEN", state)
+	}
+}
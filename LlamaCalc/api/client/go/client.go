@@ -6,21 +6,38 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"time"
 
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/balancer/leastrequest" // registers least_request_experimental
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // enables client-side health checking
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 
-	pb "llamacalc/pkg/proto"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/fit"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/stats"
 )
 
 // LlamaCalcClient is a client for the LlamaCalc gRPC service
 type LlamaCalcClient struct {
 	conn         *grpc.ClientConn
 	client       pb.CalculatorClient
-	healthClient pb.HealthServiceClient
+	linalgClient pb.LinearAlgebraClient
+	progClient   pb.ProgrammerClient
+	finClient    pb.FinanceClient
+	numClient    pb.NumericsClient
+	symClient    pb.SymbolicClient
+	sessClient   pb.SessionClient
+	libClient    pb.LibraryClient
+	histClient   pb.HistoryClient
+	healthClient healthpb.HealthClient
+	breaker      *CircuitBreaker
 	config       *ClientConfig
 }
 
@@ -30,6 +47,15 @@ type ClientConfig struct {
 	ServerAddress string
 	Timeout       time.Duration
 
+	// Load balancing settings. When ServerAddresses or AddressFile is set, RPCs
+	// are spread over all listed replicas instead of ServerAddress.
+	ServerAddresses     []string
+	AddressFile         string
+	AddressFileInterval time.Duration
+	LoadBalancingPolicy string
+	HealthCheckEnabled  bool
+	HealthCheckService  string
+
 	// Security settings
 	TLSEnabled  bool
 	MTLSEnabled bool
@@ -38,8 +64,16 @@ type ClientConfig struct {
 	KeyFile     string
 	Insecure    bool
 
+	// CircuitBreaker enables a circuit breaker per target and method; nil disables it
+	CircuitBreaker *CircuitBreakerConfig
+
 	// Authentication settings
 	JWTToken string
+	APIKey   string
+	// TokenSource supplies refreshable tokens; it takes precedence over JWTToken and APIKey
+	TokenSource TokenSource
+	// AllowInsecureCredentials permits sending tokens over a plaintext connection
+	AllowInsecureCredentials bool
 
 	// Connection parameters
 	DialTimeout      time.Duration
@@ -49,23 +83,30 @@ type ClientConfig struct {
 	RetryBackoff     time.Duration
 	MaxRecvMsgSize   int
 	MaxSendMsgSize   int
+
+	// DialOptions are appended to the options built from this configuration,
+	// e.g. to dial through a custom dialer
+	DialOptions []grpc.DialOption
 }
 
 // DefaultClientConfig returns a default configuration for the LlamaCalc client
 func DefaultClientConfig() *ClientConfig {
 	return &ClientConfig{
-		ServerAddress:    "localhost:50051",
-		Timeout:          5 * time.Second,
-		TLSEnabled:       false,
-		MTLSEnabled:      false,
-		Insecure:         true,
-		DialTimeout:      5 * time.Second,
-		KeepAliveTime:    10 * time.Second,
-		KeepAliveTimeout: 3 * time.Second,
-		MaxRetries:       3,
-		RetryBackoff:     100 * time.Millisecond,
-		MaxRecvMsgSize:   4 * 1024 * 1024, // 4 MiB
-		MaxSendMsgSize:   4 * 1024 * 1024, // 4 MiB
+		ServerAddress:       "localhost:50051",
+		Timeout:             5 * time.Second,
+		AddressFileInterval: DefaultAddressFileInterval,
+		LoadBalancingPolicy: RoundRobin,
+		HealthCheckEnabled:  true,
+		TLSEnabled:          false,
+		MTLSEnabled:         false,
+		Insecure:            true,
+		DialTimeout:         5 * time.Second,
+		KeepAliveTime:       10 * time.Second,
+		KeepAliveTimeout:    3 * time.Second,
+		MaxRetries:          3,
+		RetryBackoff:        100 * time.Millisecond,
+		MaxRecvMsgSize:      4 * 1024 * 1024, // 4 MiB
+		MaxSendMsgSize:      4 * 1024 * 1024, // 4 MiB
 	}
 }
 
@@ -107,30 +148,95 @@ func NewLlamaCalcClient(config *ClientConfig) (*LlamaCalcClient, error) {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	// TODO: Add authentication interceptors (JWT token, etc.)
-	// This is a placeholder for now
+	// Configure authentication
+	if source := config.tokenSource(); source != nil {
+		if !config.TLSEnabled && !config.AllowInsecureCredentials {
+			return nil, ErrInsecureCredentials
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(NewTokenCredentials(source, config.AllowInsecureCredentials)))
+	}
+
+	// Configure circuit breaking
+	var breaker *CircuitBreaker
+	if config.CircuitBreaker != nil {
+		breaker = NewCircuitBreaker(config.CircuitBreaker)
+		opts = append(opts, grpc.WithChainUnaryInterceptor(breaker.UnaryClientInterceptor()))
+	}
+
+	// Configure load balancing across replicas
+	target := config.ServerAddress
+	if len(config.ServerAddresses) > 0 || config.AddressFile != "" {
+		sc, err := serviceConfig(config.LoadBalancingPolicy, config.HealthCheckEnabled, config.HealthCheckService)
+		if err != nil {
+			return nil, err
+		}
+
+		interval := config.AddressFileInterval
+		if interval <= 0 {
+			interval = DefaultAddressFileInterval
+		}
+
+		target = resolverScheme + ":///llamacalc"
+		opts = append(opts,
+			grpc.WithResolvers(&addressResolverBuilder{
+				addresses: config.ServerAddresses,
+				file:      config.AddressFile,
+				interval:  interval,
+			}),
+			grpc.WithDefaultServiceConfig(sc),
+		)
+	}
+
+	opts = append(opts, config.DialOptions...)
 
 	// Establish connection
 	ctx, cancel := context.WithTimeout(context.Background(), config.DialTimeout)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, config.ServerAddress, opts...)
+	conn, err := grpc.DialContext(ctx, target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to LlamaCalc server: %v", err)
 	}
 
 	// Create client
 	client := pb.NewCalculatorClient(conn)
-	healthClient := pb.NewHealthServiceClient(conn)
+	healthClient := healthpb.NewHealthClient(conn)
 
 	return &LlamaCalcClient{
 		conn:         conn,
 		client:       client,
+		linalgClient: pb.NewLinearAlgebraClient(conn),
+		progClient:   pb.NewProgrammerClient(conn),
+		finClient:    pb.NewFinanceClient(conn),
+		numClient:    pb.NewNumericsClient(conn),
+		symClient:    pb.NewSymbolicClient(conn),
+		sessClient:   pb.NewSessionClient(conn),
+		libClient:    pb.NewLibraryClient(conn),
+		histClient:   pb.NewHistoryClient(conn),
 		healthClient: healthClient,
+		breaker:      breaker,
 		config:       config,
 	}, nil
 }
 
+// tokenSource returns the configured token source, if any
+func (config *ClientConfig) tokenSource() TokenSource {
+	switch {
+	case config.TokenSource != nil:
+		return config.TokenSource
+	case config.JWTToken != "":
+		return StaticTokenSource(config.JWTToken)
+	case config.APIKey != "":
+		return StaticTokenSource(config.APIKey)
+	}
+	return nil
+}
+
+// CircuitBreaker returns the client's circuit breaker, or nil if it is disabled
+func (c *LlamaCalcClient) CircuitBreaker() *CircuitBreaker {
+	return c.breaker
+}
+
 // Close closes the client connection
 func (c *LlamaCalcClient) Close() error {
 	return c.conn.Close()
@@ -146,11 +252,7 @@ func (c *LlamaCalcClient) Add(ctx context.Context, a, b float64) (float64, error
 		B: b,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("error calling Add: %v", err)
-	}
-
-	if resp.StatusCode != 200 {
-		return 0, fmt.Errorf("error code %d: %s", resp.StatusCode, resp.ErrorMessage)
+		return 0, fmt.Errorf("error calling Add: %w", calcstatus.FromStatus(err))
 	}
 
 	return resp.Result, nil
@@ -166,11 +268,7 @@ func (c *LlamaCalcClient) Subtract(ctx context.Context, a, b float64) (float64,
 		B: b,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("error calling Subtract: %v", err)
-	}
-
-	if resp.StatusCode != 200 {
-		return 0, fmt.Errorf("error code %d: %s", resp.StatusCode, resp.ErrorMessage)
+		return 0, fmt.Errorf("error calling Subtract: %w", calcstatus.FromStatus(err))
 	}
 
 	return resp.Result, nil
@@ -186,11 +284,7 @@ func (c *LlamaCalcClient) Multiply(ctx context.Context, a, b float64) (float64,
 		B: b,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("error calling Multiply: %v", err)
-	}
-
-	if resp.StatusCode != 200 {
-		return 0, fmt.Errorf("error code %d: %s", resp.StatusCode, resp.ErrorMessage)
+		return 0, fmt.Errorf("error calling Multiply: %w", calcstatus.FromStatus(err))
 	}
 
 	return resp.Result, nil
@@ -206,33 +300,473 @@ func (c *LlamaCalcClient) Divide(ctx context.Context, a, b float64) (float64, er
 		B: b,
 	})
 	if err != nil {
-		return 0, fmt.Errorf("error calling Divide: %v", err)
+		return 0, fmt.Errorf("error calling Divide: %w", calcstatus.FromStatus(err))
 	}
 
-	if resp.StatusCode != 200 {
-		return 0, fmt.Errorf("error code %d: %s", resp.StatusCode, resp.ErrorMessage)
+	return resp.Result, nil
+}
+
+// Invoke performs any operation registered on the server, including custom
+// operations, by name. Trigonometric functions use the angle unit set on ctx
+// with calc.WithAngleUnit.
+func (c *LlamaCalcClient) Invoke(ctx context.Context, operation string, args ...float64) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Invoke(ctx, &pb.InvokeRequest{
+		Operation: operation,
+		Args:      args,
+		AngleUnit: angleUnit(ctx),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error calling Invoke: %w", calcstatus.FromStatus(err))
 	}
 
 	return resp.Result, nil
 }
 
+// InvokeUnits performs an operation registered on the server on quantities
+// with units, e.g. Multiply of 100 "MB/s" and 60 "s", and returns the result
+// with its unit. Arguments of incompatible units fail with
+// calc.ErrIncompatibleUnits.
+func (c *LlamaCalcClient) InvokeUnits(ctx context.Context, operation string, args ...calc.Quantity) (calc.Quantity, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &pb.InvokeRequest{
+		Operation: operation,
+		Args:      make([]float64, len(args)),
+		Units:     make([]string, len(args)),
+		AngleUnit: angleUnit(ctx),
+	}
+	for i, arg := range args {
+		req.Args[i], req.Units[i] = arg.Value, arg.Unit
+	}
+
+	resp, err := c.client.Invoke(ctx, req)
+	if err != nil {
+		return calc.Quantity{}, fmt.Errorf("error calling Invoke: %w", calcstatus.FromStatus(err))
+	}
+
+	return calc.Quantity{Value: resp.Result, Unit: resp.Unit}, nil
+}
+
+// Convert converts value from one unit to another of the same dimension,
+// e.g. from "degF" to "degC"
+func (c *LlamaCalcClient) Convert(ctx context.Context, value float64, from, to string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Convert(ctx, &pb.ConvertRequest{
+		Value: value,
+		From:  from,
+		To:    to,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error calling Convert: %w", calcstatus.FromStatus(err))
+	}
+
+	return resp.Result, nil
+}
+
+// Money performs a money operation on the server: Add or Subtract of two
+// amounts, Multiply of an amount by a factor, Allocate of an amount in
+// proportion to ratios, or Split of an amount into a number of parts.
+// Amounts in different currencies fail with calc.ErrCurrencyMismatch.
+func (c *LlamaCalcClient) Money(ctx context.Context, operation string, amounts []calc.Money, numbers []calc.Decimal, mode calc.RoundingMode) ([]calc.Money, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &pb.MoneyRequest{
+		Operation: operation,
+		Amounts:   make([]*pb.Money, len(amounts)),
+		Numbers:   make([]string, len(numbers)),
+		Rounding:  roundingMode(mode),
+	}
+	for i, amount := range amounts {
+		req.Amounts[i] = &pb.Money{Currency: amount.Currency.Code, Amount: amount.Amount.String()}
+	}
+	for i, number := range numbers {
+		req.Numbers[i] = number.String()
+	}
+
+	resp, err := c.client.MoneyCalculate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling MoneyCalculate: %w", calcstatus.FromStatus(err))
+	}
+
+	results := make([]calc.Money, len(resp.Results))
+	for i, result := range resp.Results {
+		money, err := calc.ParseMoney(result.Currency, result.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MoneyCalculate result: %w", err)
+		}
+		results[i] = money
+	}
+	return results, nil
+}
+
+// CurrencyConversion is the result of a currency conversion
+type CurrencyConversion struct {
+	Value calc.Money
+	// Rate is the rate applied, rounded to the decimal places configured
+	// on the server
+	Rate string
+	// Effective is the date of the server's exchange-rate table
+	Effective time.Time
+}
+
+// ConvertCurrency converts amount to the currency with code to using the
+// exchange-rate table of the server
+func (c *LlamaCalcClient) ConvertCurrency(ctx context.Context, amount calc.Money, to string, mode calc.RoundingMode) (*CurrencyConversion, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.ConvertCurrency(ctx, &pb.ConvertCurrencyRequest{
+		Amount:   &pb.Money{Currency: amount.Currency.Code, Amount: amount.Amount.String()},
+		To:       to,
+		Rounding: roundingMode(mode),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error calling ConvertCurrency: %w", calcstatus.FromStatus(err))
+	}
+
+	value, err := calc.ParseMoney(resp.GetResult().GetCurrency(), resp.GetResult().GetAmount())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ConvertCurrency result: %w", err)
+	}
+	effective, err := time.Parse(time.DateOnly, resp.EffectiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ConvertCurrency effective date: %w", err)
+	}
+	return &CurrencyConversion{Value: value, Rate: resp.Rate, Effective: effective}, nil
+}
+
+// roundingModes maps the engine's rounding modes to those of requests
+var roundingModes = map[calc.RoundingMode]pb.RoundingMode{
+	calc.HalfEven: pb.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+	calc.HalfUp:   pb.RoundingMode_ROUNDING_MODE_HALF_UP,
+	calc.Down:     pb.RoundingMode_ROUNDING_MODE_DOWN,
+	calc.Up:       pb.RoundingMode_ROUNDING_MODE_UP,
+	calc.Floor:    pb.RoundingMode_ROUNDING_MODE_FLOOR,
+	calc.Ceiling:  pb.RoundingMode_ROUNDING_MODE_CEILING,
+}
+
+// roundingMode returns the request rounding mode for mode
+func roundingMode(mode calc.RoundingMode) pb.RoundingMode {
+	return roundingModes[mode]
+}
+
+// Evaluate evaluates an arithmetic expression such as "2 * sin(pi / 4)" on
+// the server, using the angle unit set on ctx with calc.WithAngleUnit
+func (c *LlamaCalcClient) Evaluate(ctx context.Context, expression string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Evaluate(ctx, &pb.EvaluateRequest{
+		Expression: expression,
+		AngleUnit:  angleUnit(ctx),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error calling Evaluate: %w", calcstatus.FromStatus(err))
+	}
+
+	return resp.Result, nil
+}
+
+// Complex performs a complex operation registered on the server by name.
+// Polar coordinates use the angle unit set on ctx with calc.WithAngleUnit.
+func (c *LlamaCalcClient) Complex(ctx context.Context, operation string, args ...complex128) (complex128, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &pb.ComplexRequest{
+		Operation: operation,
+		Args:      make([]*pb.Complex, len(args)),
+		AngleUnit: angleUnit(ctx),
+	}
+	for i, arg := range args {
+		req.Args[i] = &pb.Complex{Real: real(arg), Imag: imag(arg)}
+	}
+
+	resp, err := c.client.ComplexCalculate(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("error calling ComplexCalculate: %w", calcstatus.FromStatus(err))
+	}
+
+	return complex(resp.GetResult().GetReal(), resp.GetResult().GetImag()), nil
+}
+
+// RationalResult is the exact result of a rational operation
+type RationalResult struct {
+	// Value is the result in lowest terms
+	Value *big.Rat
+	// Decimal is the result rounded to the decimal places configured on
+	// the server
+	Decimal string
+}
+
+// Rational performs a rational operation registered on the server by name.
+// Arguments are integers ("-3"), fractions ("1/3"), mixed numbers ("1 1/2")
+// or decimals ("0.25"); a *big.Rat can be passed with its RatString method.
+func (c *LlamaCalcClient) Rational(ctx context.Context, operation string, args ...string) (*RationalResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.RationalCalculate(ctx, &pb.RationalRequest{
+		Operation: operation,
+		Args:      args,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error calling RationalCalculate: %w", calcstatus.FromStatus(err))
+	}
+
+	value, ok := new(big.Rat).SetString(resp.Result)
+	if !ok {
+		return nil, fmt.Errorf("invalid rational result %q", resp.Result)
+	}
+
+	return &RationalResult{Value: value, Decimal: resp.Decimal}, nil
+}
+
+// Integer performs an integer operation registered on the server by name.
+// Most operations return a single result; DivMod and QuoRem return the
+// quotient and the remainder, and Factorize the prime factors. Long-running
+// operations stop at the deadline of ctx or the client timeout.
+func (c *LlamaCalcClient) Integer(ctx context.Context, operation string, args ...*big.Int) ([]*big.Int, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	req := &pb.IntegerRequest{
+		Operation: operation,
+		Args:      make([]string, len(args)),
+	}
+	for i, arg := range args {
+		req.Args[i] = arg.String()
+	}
+
+	resp, err := c.client.IntegerCalculate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling IntegerCalculate: %w", calcstatus.FromStatus(err))
+	}
+
+	results := make([]*big.Int, len(resp.Results))
+	for i, result := range resp.Results {
+		value, ok := new(big.Int).SetString(result, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer result %q", result)
+		}
+		results[i] = value
+	}
+
+	return results, nil
+}
+
+// Statistics computes exact descriptive statistics of data on the server,
+// including the given percentiles (between 0 and 100). Datasets too large for
+// a single message can be sent with StreamStatistics.
+func (c *LlamaCalcClient) Statistics(ctx context.Context, data []float64, percentiles ...float64) (*stats.Summary, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Statistics(ctx, &pb.StatisticsRequest{
+		Data:        data,
+		Percentiles: percentiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error calling Statistics: %w", calcstatus.FromStatus(err))
+	}
+
+	return summaryFromResponse(resp), nil
+}
+
+// StatisticsStream sends a dataset to the server in chunks
+type StatisticsStream struct {
+	stream      pb.Calculator_StatisticsStreamClient
+	percentiles []float64
+}
+
+// StreamStatistics starts computing statistics of a dataset that is sent in
+// chunks with Send. The client timeout does not apply; the stream ends when
+// ctx is done or Close is called.
+func (c *LlamaCalcClient) StreamStatistics(ctx context.Context, percentiles ...float64) (*StatisticsStream, error) {
+	stream, err := c.client.StatisticsStream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error calling StatisticsStream: %w", calcstatus.FromStatus(err))
+	}
+
+	return &StatisticsStream{stream: stream, percentiles: percentiles}, nil
+}
+
+// Send sends the next chunk of the dataset
+func (s *StatisticsStream) Send(data ...float64) error {
+	req := &pb.StatisticsRequest{Data: data, Percentiles: s.percentiles}
+	s.percentiles = nil
+
+	if err := s.stream.Send(req); err != nil {
+		// The server's error is reported by Close
+		return fmt.Errorf("error sending statistics chunk: %w", err)
+	}
+	return nil
+}
+
+// Close ends the dataset and returns its statistics. The median and
+// percentiles of large datasets are estimates, see stats.Summary.Approximate.
+func (s *StatisticsStream) Close() (*stats.Summary, error) {
+	if s.percentiles != nil {
+		// Nothing was sent; the percentiles still have to reach the server
+		if err := s.Send(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("error calling StatisticsStream: %w", calcstatus.FromStatus(err))
+	}
+
+	return summaryFromResponse(resp), nil
+}
+
+// summaryFromResponse converts a statistics response into a summary
+func summaryFromResponse(resp *pb.StatisticsResponse) *stats.Summary {
+	summary := &stats.Summary{
+		Count:       resp.Count,
+		Sum:         resp.Sum,
+		Mean:        resp.Mean,
+		Variance:    resp.Variance,
+		StdDev:      resp.Stddev,
+		Min:         resp.Min,
+		Max:         resp.Max,
+		Median:      resp.Median,
+		Mode:        resp.Mode,
+		ModeCount:   resp.ModeCount,
+		Skewness:    resp.Skewness,
+		Kurtosis:    resp.Kurtosis,
+		Approximate: resp.Approximate,
+	}
+	for _, p := range resp.Percentiles {
+		summary.Percentiles = append(summary.Percentiles, p.Value)
+	}
+	return summary
+}
+
+// Fit fits the model selected by opts to the points (x[i], y[i]) on the server
+func (c *LlamaCalcClient) Fit(ctx context.Context, x, y []float64, opts fit.Options) (*fit.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	resp, err := c.client.Fit(ctx, &pb.FitRequest{
+		Model:   fitModels[opts.Model],
+		X:       x,
+		Y:       y,
+		Weights: opts.Weights,
+		Degree:  uint32(opts.Degree),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error calling Fit: %w", calcstatus.FromStatus(err))
+	}
+
+	return &fit.Result{
+		Model:          opts.Model,
+		Coefficients:   resp.Coefficients,
+		StandardErrors: resp.StandardErrors,
+		RSquared:       resp.RSquared,
+		Residuals:      resp.Residuals,
+		Condition:      resp.ConditionNumber,
+	}, nil
+}
+
+// fitModels maps the models of package fit to the API
+var fitModels = map[fit.Model]pb.FitModel{
+	fit.Linear:      pb.FitModel_FIT_MODEL_LINEAR,
+	fit.Polynomial:  pb.FitModel_FIT_MODEL_POLYNOMIAL,
+	fit.Exponential: pb.FitModel_FIT_MODEL_EXPONENTIAL,
+	fit.Logarithmic: pb.FitModel_FIT_MODEL_LOGARITHMIC,
+}
+
+// angleUnit returns the angle unit set on ctx with calc.WithAngleUnit
+func angleUnit(ctx context.Context) pb.AngleUnit {
+	if calc.AngleUnitFromContext(ctx) == calc.Degrees {
+		return pb.AngleUnit_ANGLE_UNIT_DEGREES
+	}
+	return pb.AngleUnit_ANGLE_UNIT_RADIANS
+}
+
+// LinearAlgebra returns a client of the LinearAlgebra service on the same
+// connection. Errors are gRPC status errors; convert them with
+// calcstatus.FromStatus to match them against the errors of package calc.
+func (c *LlamaCalcClient) LinearAlgebra() pb.LinearAlgebraClient {
+	return c.linalgClient
+}
+
+// Programmer returns a client of the Programmer service on the same
+// connection. Errors are gRPC status errors like those of LinearAlgebra.
+func (c *LlamaCalcClient) Programmer() pb.ProgrammerClient {
+	return c.progClient
+}
+
+// Finance returns a client of the Finance service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra.
+func (c *LlamaCalcClient) Finance() pb.FinanceClient {
+	return c.finClient
+}
+
+// Numerics returns a client of the Numerics service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra.
+func (c *LlamaCalcClient) Numerics() pb.NumericsClient {
+	return c.numClient
+}
+
+// Symbolic returns a client of the Symbolic service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra.
+func (c *LlamaCalcClient) Symbolic() pb.SymbolicClient {
+	return c.symClient
+}
+
+// Session returns a client of the Session service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra; unknown and
+// expired sessions are codes.NotFound.
+func (c *LlamaCalcClient) Session() pb.SessionClient {
+	return c.sessClient
+}
+
+// Conn returns the connection of the client, e.g. to call methods that
+// are only known at run time
+func (c *LlamaCalcClient) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Library returns a client of the Library service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra; changes based
+// on an outdated library version are codes.Aborted.
+func (c *LlamaCalcClient) Library() pb.LibraryClient {
+	return c.libClient
+}
+
+// History returns a client of the History service on the same connection.
+// Errors are gRPC status errors like those of LinearAlgebra.
+func (c *LlamaCalcClient) History() pb.HistoryClient {
+	return c.histClient
+}
+
 // CheckHealth checks the health of the server
 func (c *LlamaCalcClient) CheckHealth(ctx context.Context) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
 	defer cancel()
 
-	resp, err := c.healthClient.Health(ctx, &pb.HealthCheckRequest{})
+	resp, err := c.healthClient.Check(ctx, &healthpb.HealthCheckRequest{})
 	if err != nil {
-		return "", fmt.Errorf("error checking health: %v", err)
+		return "", fmt.Errorf("error checking health: %w", err)
 	}
 
 	status := "UNKNOWN"
 	switch resp.Status {
-	case pb.HealthCheckResponse_SERVING:
+	case healthpb.HealthCheckResponse_SERVING:
 		status = "SERVING"
-	case pb.HealthCheckResponse_NOT_SERVING:
+	case healthpb.HealthCheckResponse_NOT_SERVING:
 		status = "NOT_SERVING"
-	case pb.HealthCheckResponse_SERVICE_UNKNOWN:
+	case healthpb.HealthCheckResponse_SERVICE_UNKNOWN:
 		status = "SERVICE_UNKNOWN"
 	}
 
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/credentials"
+)
+
+// DefaultTokenRefreshWindow is how long before expiry a cached token is refreshed
+const DefaultTokenRefreshWindow = 30 * time.Second
+
+// ErrInsecureCredentials is returned when credentials would be sent over a plaintext connection
+var ErrInsecureCredentials = errors.New("refusing to send credentials over insecure transport")
+
+// errNoToken is reported for a token source that returns neither a token nor
+// an error
+var errNoToken = errors.New("token source returned no token")
+
+// Token is an access token attached to outgoing RPCs
+type Token struct {
+	// Value is the raw token (JWT or API key)
+	Value string
+	// Expiry is when the token stops being valid; zero means it never expires
+	Expiry time.Time
+}
+
+// Valid reports whether the token is usable for at least the given window
+func (t *Token) Valid(window time.Duration) bool {
+	if t == nil || t.Value == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return time.Now().Add(window).Before(t.Expiry)
+}
+
+// TokenSource supplies tokens for per-RPC credentials
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// TokenSourceFunc adapts a function to the TokenSource interface
+type TokenSourceFunc func(ctx context.Context) (*Token, error)
+
+// Token implements TokenSource
+func (f TokenSourceFunc) Token(ctx context.Context) (*Token, error) {
+	return f(ctx)
+}
+
+// staticTokenSource always returns the same token
+type staticTokenSource struct {
+	token *Token
+}
+
+// StaticTokenSource returns a TokenSource that always returns the given value.
+// If the value is a JWT its exp claim is used as the token expiry.
+func StaticTokenSource(value string) TokenSource {
+	return &staticTokenSource{token: &Token{Value: value, Expiry: jwtExpiry(value)}}
+}
+
+// Token implements TokenSource
+func (s *staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// RefreshingTokenSource caches a token and fetches a new one shortly before it expires
+type RefreshingTokenSource struct {
+	fetch  TokenSource
+	window time.Duration
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewRefreshingTokenSource wraps fetch so that tokens are reused until they are
+// within window of their expiry. A zero window uses DefaultTokenRefreshWindow.
+func NewRefreshingTokenSource(fetch TokenSource, window time.Duration) *RefreshingTokenSource {
+	if window <= 0 {
+		window = DefaultTokenRefreshWindow
+	}
+	return &RefreshingTokenSource{
+		fetch:  fetch,
+		window: window,
+	}
+}
+
+// Token implements TokenSource
+func (s *RefreshingTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid(s.window) {
+		return s.token, nil
+	}
+
+	token, err := s.fetch.Token(ctx)
+	if err == nil && token == nil {
+		err = errNoToken
+	}
+	if err != nil {
+		// Keep using the old token while it is still valid
+		if s.token.Valid(0) {
+			return s.token, nil
+		}
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	if token.Expiry.IsZero() {
+		// The fetcher may share its token, so the expiry is set on a copy
+		copied := *token
+		copied.Expiry = jwtExpiry(token.Value)
+		token = &copied
+	}
+
+	s.token = token
+	return token, nil
+}
+
+// tokenCredentials implements credentials.PerRPCCredentials
+type tokenCredentials struct {
+	source        TokenSource
+	allowInsecure bool
+}
+
+// NewTokenCredentials returns per-RPC credentials that attach tokens from source
+// as "authorization: Bearer <token>" metadata. Unless allowInsecure is set, gRPC
+// refuses to send them over a connection without transport security.
+func NewTokenCredentials(source TokenSource, allowInsecure bool) credentials.PerRPCCredentials {
+	return &tokenCredentials{
+		source:        source,
+		allowInsecure: allowInsecure,
+	}
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if !c.allowInsecure {
+		ri, _ := credentials.RequestInfoFromContext(ctx)
+		if err := credentials.CheckSecurityLevel(ri.AuthInfo, credentials.PrivacyAndIntegrity); err != nil {
+			return nil, ErrInsecureCredentials
+		}
+	}
+
+	token, err := c.source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"authorization": "Bearer " + token.Value,
+	}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return !c.allowInsecure
+}
+
+// jwtExpiry returns the exp claim of a JWT, or the zero time if value is not a JWT
+func jwtExpiry(value string) time.Time {
+	claims := jwt.StandardClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(value, &claims); err != nil || claims.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.ExpiresAt, 0)
+}
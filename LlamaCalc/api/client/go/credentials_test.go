@@ -0,0 +1,171 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	client "llamacalc/api/client/go"
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calctest"
+)
+
+func TestInsecureCredentials(t *testing.T) {
+	s := calctest.NewServer(t, calctest.WithJWTAuth("USER"))
+
+	// Tokens are not sent over a plaintext connection unless allowed
+	_, err := s.NewClient(func(config *client.ClientConfig) {
+		config.AllowInsecureCredentials = false
+	})
+	if !errors.Is(err, client.ErrInsecureCredentials) {
+		t.Fatalf("got %v, want ErrInsecureCredentials", err)
+	}
+
+	creds := client.NewTokenCredentials(client.StaticTokenSource("secret"), false)
+	if !creds.RequireTransportSecurity() {
+		t.Error("credentials do not require transport security")
+	}
+	if _, err := creds.GetRequestMetadata(context.Background()); !errors.Is(err, client.ErrInsecureCredentials) {
+		t.Errorf("got %v, want ErrInsecureCredentials", err)
+	}
+
+	creds = client.NewTokenCredentials(client.StaticTokenSource("secret"), true)
+	md, err := creds.GetRequestMetadata(context.Background())
+	if err != nil || md["authorization"] != "Bearer secret" {
+		t.Errorf("got %v, %v", md, err)
+	}
+
+	// The default client of the test server allows them
+	if result, err := s.Client().Add(context.Background(), 1, 2); err != nil || result != 3 {
+		t.Errorf("got %g, %v", result, err)
+	}
+}
+
+// fetcher returns the tokens in order, or err once they are used up
+type fetcher struct {
+	tokens []*client.Token
+	calls  int
+}
+
+func (f *fetcher) Token(ctx context.Context) (*client.Token, error) {
+	f.calls++
+	if len(f.tokens) == 0 {
+		return nil, errors.New("token service unavailable")
+	}
+	token := f.tokens[0]
+	f.tokens = f.tokens[1:]
+	return token, nil
+}
+
+func TestRefreshingTokenSource(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		Name   string
+		Tokens []*client.Token
+		// Want are the token values returned by consecutive calls; "" is an error
+		Want  []string
+		Calls int
+	}{
+		{
+			Name:   "cached until expiry",
+			Tokens: []*client.Token{{Value: "a", Expiry: now.Add(time.Hour)}},
+			Want:   []string{"a", "a", "a"},
+			Calls:  1,
+		},
+		{
+			Name:   "never expires",
+			Tokens: []*client.Token{{Value: "a"}},
+			Want:   []string{"a", "a"},
+			Calls:  1,
+		},
+		{
+			Name: "refreshed before expiry",
+			Tokens: []*client.Token{
+				{Value: "a", Expiry: now.Add(10 * time.Second)},
+				{Value: "b", Expiry: now.Add(time.Hour)},
+			},
+			Want:  []string{"a", "b", "b"},
+			Calls: 2,
+		},
+		{
+			Name:   "old token while still valid",
+			Tokens: []*client.Token{{Value: "a", Expiry: now.Add(10 * time.Second)}},
+			Want:   []string{"a", "a", "a"},
+			Calls:  3,
+		},
+		{
+			Name:   "nil token",
+			Tokens: []*client.Token{nil},
+			Want:   []string{""},
+			Calls:  1,
+		},
+		{
+			Name:   "old token instead of nil token",
+			Tokens: []*client.Token{{Value: "a", Expiry: now.Add(10 * time.Second)}, nil},
+			Want:   []string{"a", "a"},
+			Calls:  2,
+		},
+		{
+			Name:   "no token after expiry",
+			Tokens: []*client.Token{{Value: "a", Expiry: now.Add(-time.Second)}},
+			Want:   []string{"a", ""},
+			Calls:  2,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			f := &fetcher{tokens: tc.Tokens}
+			source := client.NewRefreshingTokenSource(f, 30*time.Second)
+			for i, want := range tc.Want {
+				token, err := source.Token(ctx)
+				switch {
+				case want == "" && err == nil:
+					t.Errorf("call %d: got %q, want an error", i+1, token.Value)
+				case want != "" && (err != nil || token.Value != want):
+					t.Errorf("call %d: got %v, %v, want %q", i+1, token, err, want)
+				}
+			}
+			if f.calls != tc.Calls {
+				t.Errorf("fetched %d tokens, want %d", f.calls, tc.Calls)
+			}
+		})
+	}
+}
+
+func TestRefreshingTokenSourceNoToken(t *testing.T) {
+	source := client.NewRefreshingTokenSource(client.TokenSourceFunc(func(ctx context.Context) (*client.Token, error) {
+		return nil, nil
+	}), 0)
+
+	token, err := source.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "token source returned no token") {
+		t.Errorf("got %v, %v, want an error for the missing token", token, err)
+	}
+}
+
+func TestRefreshingTokenSourceExpiry(t *testing.T) {
+	jwt, err := auth.NewJWTManager("secret", time.Hour).Generate("alice", "USER")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shared := &client.Token{Value: jwt}
+	source := client.NewRefreshingTokenSource(client.TokenSourceFunc(func(ctx context.Context) (*client.Token, error) {
+		return shared, nil
+	}), 0)
+
+	token, err := source.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The expiry is taken from the JWT without changing the fetched token
+	if until := time.Until(token.Expiry); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("got expiry in %v, want in an hour", until)
+	}
+	if !shared.Expiry.IsZero() {
+		t.Errorf("fetched token was changed to expire at %v", shared.Expiry)
+	}
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// resolverScheme is the target scheme handled by the LlamaCalc resolver
+const resolverScheme = "llamacalc"
+
+// DefaultAddressFileInterval is how often an address file is checked for changes
+const DefaultAddressFileInterval = 5 * time.Second
+
+// Load balancing policies supported by the client
+const (
+	RoundRobin   = "round_robin"
+	LeastRequest = "least_request"
+)
+
+// addressResolverBuilder builds resolvers for a static address list or an address file
+type addressResolverBuilder struct {
+	addresses []string
+	file      string
+	interval  time.Duration
+}
+
+// Build implements resolver.Builder
+func (b *addressResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	r := &addressResolver{
+		builder: b,
+		cc:      cc,
+		done:    make(chan struct{}),
+		resolve: make(chan struct{}, 1),
+	}
+
+	if err := r.update(); err != nil {
+		return nil, err
+	}
+
+	if b.file != "" {
+		r.wg.Add(1)
+		go r.watch()
+	}
+
+	return r, nil
+}
+
+// Scheme implements resolver.Builder
+func (b *addressResolverBuilder) Scheme() string {
+	return resolverScheme
+}
+
+// addressResolver pushes the configured addresses to the ClientConn
+type addressResolver struct {
+	builder *addressResolverBuilder
+	cc      resolver.ClientConn
+	// data is the content of the address file last pushed, if loaded
+	data    []byte
+	loaded  bool
+	done    chan struct{}
+	resolve chan struct{}
+	wg      sync.WaitGroup
+}
+
+// ResolveNow implements resolver.Resolver
+func (r *addressResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+	}
+}
+
+// Close implements resolver.Resolver
+func (r *addressResolver) Close() {
+	close(r.done)
+	r.wg.Wait()
+}
+
+// watch polls the address file and pushes new addresses whenever it changes
+func (r *addressResolver) watch() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.builder.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		case <-r.resolve:
+		}
+
+		if err := r.update(); err != nil {
+			r.cc.ReportError(err)
+		}
+	}
+}
+
+// update reads the current address list and sends it to the ClientConn
+func (r *addressResolver) update() error {
+	addresses := r.builder.addresses
+
+	if r.builder.file != "" {
+		// The content is compared rather than the modification time, which
+		// may not change when the file is rewritten quickly
+		data, err := os.ReadFile(r.builder.file)
+		if err != nil {
+			return fmt.Errorf("failed to read address file: %v", err)
+		}
+		if r.loaded && bytes.Equal(data, r.data) {
+			return nil
+		}
+
+		addresses, err = parseAddresses(data)
+		if err != nil {
+			return err
+		}
+		r.data, r.loaded = data, true
+	}
+
+	if len(addresses) == 0 {
+		return fmt.Errorf("no server addresses configured")
+	}
+
+	state := resolver.State{}
+	for _, addr := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr})
+	}
+
+	return r.cc.UpdateState(state)
+}
+
+// parseAddresses parses an address file with one address per line, skipping
+// blank lines and # comments
+func parseAddresses(data []byte) ([]string, error) {
+	var addresses []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			addresses = append(addresses, line)
+		}
+	}
+
+	return addresses, scanner.Err()
+}
+
+// serviceConfig returns the JSON service config selecting the balancing policy
+// and, if enabled, client-side health checking through grpc.health.v1
+func serviceConfig(policy string, healthCheck bool, healthService string) (string, error) {
+	var lb string
+	switch policy {
+	case "", RoundRobin:
+		lb = `{"round_robin":{}}`
+	case LeastRequest:
+		lb = `{"least_request_experimental":{"choiceCount":2}}`
+	default:
+		return "", fmt.Errorf("unsupported load balancing policy %q", policy)
+	}
+
+	if !healthCheck {
+		return fmt.Sprintf(`{"loadBalancingConfig":[%s]}`, lb), nil
+	}
+
+	return fmt.Sprintf(`{"loadBalancingConfig":[%s],"healthCheckConfig":{"serviceName":%q}}`, lb, healthService), nil
+}
@@ -0,0 +1,100 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	client "llamacalc/api/client/go"
+	"llamacalc/pkg/server"
+)
+
+// listen starts a server on a local port and returns its address
+func listen(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := server.NewGRPCServer(&server.Config{
+		MaxRecvMsgSize:   4 * 1024 * 1024,
+		MaxSendMsgSize:   4 * 1024 * 1024,
+		MaxPrecision:     10,
+		MaxDecimalPlaces: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+// unused returns a local address nothing listens on
+func unused(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
+func TestAddressFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "servers")
+	if err := os.WriteFile(file, []byte("# none yet\n"+unused(t)+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := client.DefaultClientConfig()
+	config.AddressFile = file
+	config.AddressFileInterval = 10 * time.Millisecond
+	config.HealthCheckEnabled = false
+	c, err := client.NewLlamaCalcClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	_, err = c.Add(ctx, 1, 2)
+	cancel()
+	if err == nil {
+		t.Fatal("Add succeeded without a server")
+	}
+
+	// The resolver picks up the server once it is listed, even if the file
+	// keeps its modification time
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listen(t)
+	if err := os.WriteFile(file, []byte(addr+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		result, err := c.Add(ctx, 1, 2)
+		cancel()
+		if err == nil {
+			if result != 3 {
+				t.Errorf("got %g, want 3", result)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server in address file not used: %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"time"
 
-	pb "llamacalc/pkg/proto"
+	"llamacalc/pkg/calcstatus"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -62,14 +64,16 @@ func main() {
 		log.Fatalf("unknown operation: %s", *op)
 	}
 
+	// Calculation errors such as division by zero are reported as typed errors
+	var calcErr *calcstatus.Error
+	if errors.As(calcstatus.FromStatus(err), &calcErr) {
+		fmt.Printf("Error: %s (%s)\n", calcErr.Message, calcstatus.Reason(calcErr.Kind))
+		return
+	}
 	if err != nil {
 		log.Fatalf("calculation failed: %v", err)
 	}
 
 	// Print result
-	if resp.StatusCode != 0 {
-		fmt.Printf("Error: %s\n", resp.ErrorMessage)
-	} else {
-		fmt.Printf("Result: %f\n", resp.Result)
-	}
+	fmt.Printf("Result: %f\n", resp.Result)
 }
@@ -14,7 +14,11 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/keepalive"
 
+	"llamacalc/pkg/history"
+	"llamacalc/pkg/library"
+	"llamacalc/pkg/replay"
 	"llamacalc/pkg/server"
+	"llamacalc/pkg/session"
 )
 
 const (
@@ -60,6 +64,13 @@ calculator service built with Go and gRPC.`,
 	serveCmd.Flags().Bool("tls", true, "Enable TLS")
 	serveCmd.Flags().Bool("metrics", true, "Enable Prometheus metrics")
 	serveCmd.Flags().String("log-level", "info", "Log level (debug, info, warn, error)")
+	serveCmd.Flags().String("units", "", "Path to a unit definitions file")
+	serveCmd.Flags().String("rates", "", "Path to an exchange-rate table")
+	serveCmd.Flags().Duration("session-ttl", session.DefaultTTL, "How long unused calculation sessions are kept")
+	serveCmd.Flags().String("library-dir", "", "Directory of user-defined functions; empty keeps them in memory")
+	serveCmd.Flags().String("history-dir", "", "Directory of the calculation history; empty records nothing")
+	serveCmd.Flags().Duration("history-retention", 0, "How long recorded calculations are kept; 0 keeps them all")
+	serveCmd.Flags().String("capture", "", "File to append the requests and responses of unary calls to, for replay; streams are not captured")
 
 	// Add flags for health command
 	healthCmd.Flags().StringP("addr", "a", "localhost:50051", "Server address")
@@ -69,6 +80,7 @@ calculator service built with Go and gRPC.`,
 	// Add commands to root command
 	rootCmd.AddCommand(serveCmd)
 	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(newReplayCommand())
 
 	// Execute
 	if err := rootCmd.Execute(); err != nil {
@@ -83,6 +95,13 @@ func runServer(cmd *cobra.Command, args []string) {
 	tlsEnabled, _ := cmd.Flags().GetBool("tls")
 	metricsEnabled, _ := cmd.Flags().GetBool("metrics")
 	logLevel, _ := cmd.Flags().GetString("log-level")
+	unitsFile, _ := cmd.Flags().GetString("units")
+	ratesFile, _ := cmd.Flags().GetString("rates")
+	sessionTTL, _ := cmd.Flags().GetDuration("session-ttl")
+	libraryDir, _ := cmd.Flags().GetString("library-dir")
+	historyDir, _ := cmd.Flags().GetString("history-dir")
+	historyRetention, _ := cmd.Flags().GetDuration("history-retention")
+	captureFile, _ := cmd.Flags().GetString("capture")
 
 	// Log the startup information
 	log.Printf("Starting LlamaCalc server v%s\n", Version)
@@ -118,6 +137,47 @@ func runServer(cmd *cobra.Command, args []string) {
 		MaxPrecision:         10,
 		MaxDecimalPlaces:     10,
 		OverflowCheckEnabled: true,
+		MetricsEnabled:       metricsEnabled,
+		UnitsFile:            unitsFile,
+		RatesFile:            ratesFile,
+		SessionStore:         session.NewMemoryStore(sessionTTL, 0),
+	}
+	if libraryDir != "" {
+		store, err := library.NewFileStore(libraryDir)
+		if err != nil {
+			log.Fatalf("Failed to open library: %v", err)
+		}
+		config.LibraryStore = store
+	}
+	if historyDir != "" {
+		store, err := history.OpenFileStore(historyDir, history.FileOptions{})
+		if err != nil {
+			log.Fatalf("Failed to open history: %v", err)
+		}
+		defer store.Close()
+		config.HistoryStore = store
+		config.EngineVersion = Version
+
+		if historyRetention > 0 {
+			go compactHistory(ctx, store, historyRetention)
+		}
+	}
+	if captureFile != "" {
+		last, err := replay.LastSeq(captureFile)
+		if err != nil {
+			log.Fatalf("Failed to read capture file: %v", err)
+		}
+		capture, err := os.OpenFile(captureFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			log.Fatalf("Failed to open capture file: %v", err)
+		}
+		defer capture.Close()
+		config.UnaryInterceptors = append(config.UnaryInterceptors, replay.Capture(capture, history.Engine{
+			Version:          Version,
+			MaxPrecision:     config.MaxPrecision,
+			MaxDecimalPlaces: config.MaxDecimalPlaces,
+			OverflowCheck:    config.OverflowCheckEnabled,
+		}, last))
 	}
 
 	// Create and start the server
@@ -178,6 +238,24 @@ func runServer(cmd *cobra.Command, args []string) {
 	log.Println("Server has been gracefully shut down.")
 }
 
+// compactHistory drops the calculations older than retention every hour
+// until ctx is canceled
+func compactHistory(ctx context.Context, store *history.FileStore, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		if err := store.Compact(ctx, time.Now().Add(-retention)); err != nil {
+			log.Printf("Failed to compact history: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func checkHealth(cmd *cobra.Command, args []string) {
 	addr, _ := cmd.Flags().GetString("addr")
 	timeout, _ := cmd.Flags().GetDuration("timeout")
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	client "llamacalc/api/client/go"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/replay"
+)
+
+// calculatorPrefix prefixes the methods of the Calculator service
+var calculatorPrefix = "/" + pb.Calculator_ServiceDesc.ServiceName + "/"
+
+// newReplayCommand creates the replay command
+func newReplayCommand() *cobra.Command {
+	replayCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay recorded calculations and report differences",
+		Long: `Replay runs the calculations of a history directory or a capture file
+against a server, or against the engine of this build if no target is given,
+and reports the results that differ from the recorded ones. It exits with
+status 1 if a result differs by more than --max-ulp units in the last place
+or in anything but numbers.`,
+		Run: runReplay,
+	}
+
+	replayCmd.Flags().String("history", "", "History directory of a server (--history-dir)")
+	replayCmd.Flags().String("capture", "", "Capture file of a server (--capture)")
+	replayCmd.Flags().String("target", "", "Server address; empty replays in process")
+	replayCmd.Flags().Bool("tls", false, "Connect to the target with TLS")
+	replayCmd.Flags().String("ca-cert", "", "CA certificate of the target")
+	replayCmd.Flags().String("token", "", "JWT for the target")
+	replayCmd.Flags().Bool("insecure", false, "Send the token without TLS")
+	replayCmd.Flags().Uint64("max-ulp", 0, "Largest tolerated difference of numbers in units in the last place")
+	replayCmd.Flags().Duration("timeout", 5*time.Second, "Timeout of each calculation")
+	replayCmd.Flags().BoolP("verbose", "v", false, "Also list the calculations that were not replayed")
+
+	return replayCmd
+}
+
+func runReplay(cmd *cobra.Command, args []string) {
+	// Exit only after replayCalculations closed the connection
+	if code := replayCalculations(cmd); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// replayCalculations replays the calculations selected by the flags of cmd
+// and returns the exit status of the command
+func replayCalculations(cmd *cobra.Command) int {
+	historyDir, _ := cmd.Flags().GetString("history")
+	captureFile, _ := cmd.Flags().GetString("capture")
+	targetAddr, _ := cmd.Flags().GetString("target")
+	tlsEnabled, _ := cmd.Flags().GetBool("tls")
+	caCert, _ := cmd.Flags().GetString("ca-cert")
+	token, _ := cmd.Flags().GetString("token")
+	insecure, _ := cmd.Flags().GetBool("insecure")
+	maxULP, _ := cmd.Flags().GetUint64("max-ulp")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+
+	if (historyDir == "") == (captureFile == "") {
+		log.Printf("Exactly one of --history and --capture is required")
+		return 1
+	}
+
+	target := replay.EngineTarget()
+	if targetAddr != "" {
+		config := client.DefaultClientConfig()
+		config.ServerAddress = targetAddr
+		config.TLSEnabled = tlsEnabled
+		config.Insecure = !tlsEnabled
+		config.CACertFile = caCert
+		config.JWTToken = token
+		config.AllowInsecureCredentials = insecure
+		c, err := client.NewLlamaCalcClient(config)
+		if err != nil {
+			log.Printf("Failed to connect to %s: %v", targetAddr, err)
+			return 1
+		}
+		defer c.Close()
+		target = replay.ConnTarget(c.Conn())
+	}
+
+	var replayed, identical, tolerated, different, skipped, otherServices int
+	check := func(r *history.Record) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		res := replay.Replay(ctx, target, r)
+		switch {
+		case errors.Is(res.Err, replay.ErrNotReplayable):
+			skipped++
+			if !strings.HasPrefix(r.Method, calculatorPrefix) {
+				otherServices++
+			}
+			if verbose {
+				fmt.Printf("#%d %s: skipped: %v\n", r.Seq, r.Method, res.Err)
+			}
+			return nil
+		case res.Err != nil:
+			return fmt.Errorf("record %d: %w", r.Seq, res.Err)
+		}
+
+		replayed++
+		switch {
+		case len(res.Differences) == 0:
+			identical++
+			return nil
+		case res.Exceeds(maxULP):
+			different++
+		default:
+			tolerated++
+		}
+		fmt.Printf("#%d %s %s\n", r.Seq, r.Method, r.Request)
+		for _, d := range res.Differences {
+			fmt.Printf("    %v\n", d)
+		}
+		return nil
+	}
+
+	var err error
+	if historyDir != "" {
+		err = history.ReadDir(historyDir, check)
+	} else {
+		err = history.ReadFile(captureFile, check)
+	}
+	if err != nil {
+		log.Printf("Failed to replay: %v", err)
+		return 1
+	}
+
+	fmt.Printf("Replayed %d calculations: %d identical, %d within %d ulp, %d different; %d skipped, %d of them outside %s\n",
+		replayed, identical, tolerated, maxULP, different, skipped, otherServices, pb.Calculator_ServiceDesc.ServiceName)
+	if different > 0 {
+		return 1
+	}
+	return 0
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -37,21 +38,8 @@ func main() {
 	ctx := context.Background()
 
 	// Perform operation
-	var result calc.CalculationResult
-	switch operation {
-	case "add":
-		result = calculator.Add(ctx, a, b)
-	case "subtract":
-		result = calculator.Subtract(ctx, a, b)
-	case "multiply":
-		result = calculator.Multiply(ctx, a, b)
-	case "divide":
-		if b == 0 {
-			fmt.Println("Error: Division by zero")
-			os.Exit(1)
-		}
-		result = calculator.Divide(ctx, a, b)
-	default:
+	result := calculator.Calculate(ctx, operation, a, b)
+	if errors.Is(result.Error, calc.ErrUnknownOperation) {
 		fmt.Println("Unknown operation. Use: add, subtract, multiply, divide")
 		os.Exit(1)
 	}
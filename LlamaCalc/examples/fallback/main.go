@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	client "llamacalc/api/client/go"
+	"llamacalc/pkg/calc"
+)
+
+func main() {
+	// Local calculator used while the service is unavailable
+	local := calc.NewCalculator(10, 10, true)
+
+	// Create a client with a circuit breaker
+	config := client.DefaultClientConfig()
+	config.ServerAddress = getEnv("LLAMACALC_SERVER", "localhost:50051")
+	config.Timeout = time.Second
+	config.CircuitBreaker = client.DefaultCircuitBreakerConfig()
+	config.CircuitBreaker.MinimumCalls = 3
+	config.CircuitBreaker.WindowSize = 5
+	config.CircuitBreaker.OpenTimeout = 5 * time.Second
+	config.CircuitBreaker.OnStateChange = func(target, method string, from, to client.CircuitState) {
+		log.Printf("circuit for %s%s changed from %s to %s", target, method, from, to)
+	}
+
+	c, err := client.NewLlamaCalcClient(config)
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+	defer c.Close()
+
+	// Add numbers, computing locally whenever the remote call fails
+	for i := 0; i < 10; i++ {
+		a, b := float64(i), 2.5
+
+		result, err := c.Add(context.Background(), a, b)
+		switch {
+		case err == nil:
+			fmt.Printf("remote: %g + %g = %g\n", a, b, result)
+		case errors.Is(err, client.ErrCircuitOpen) || client.IsTransientError(err):
+			fallback := local.Add(context.Background(), a, b)
+			if fallback.Error != nil {
+				log.Fatalf("Local addition failed: %v", fallback.Error)
+			}
+			fmt.Printf("local:  %g + %g = %g (%v)\n", a, b, fallback.Value, err)
+		default:
+			log.Fatalf("Addition failed: %v", err)
+		}
+	}
+}
+
+// Helper function to get environment variables with defaults
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
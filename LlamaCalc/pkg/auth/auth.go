@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"io/ioutil"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -40,22 +41,22 @@ const (
 
 // AuthInterceptor is a server interceptor for authentication and authorization
 type AuthInterceptor struct {
-	jwtManager      *JWTManager
-	accessibleRoles map[string][]string
+	jwtManager *JWTManager
+	policy     Policy
 }
 
-// NewAuthInterceptor creates a new auth interceptor
-func NewAuthInterceptor(jwtManager *JWTManager) *AuthInterceptor {
-	accessibleRoles := map[string][]string{
-		"/calculator.Calculator/Add":      {string(RoleAdmin), string(RoleUser), string(RoleGuest)},
-		"/calculator.Calculator/Subtract": {string(RoleAdmin), string(RoleUser), string(RoleGuest)},
-		"/calculator.Calculator/Multiply": {string(RoleAdmin), string(RoleUser)},
-		"/calculator.Calculator/Divide":   {string(RoleAdmin)},
+// NewAuthInterceptor creates a new auth interceptor that enforces policy.
+// A nil policy requires an authenticated caller for every method.
+func NewAuthInterceptor(jwtManager *JWTManager, policy Policy) *AuthInterceptor {
+	if policy == nil {
+		policy = func(string, interface{}) (Role, bool) {
+			return RoleGuest, true
+		}
 	}
 
 	return &AuthInterceptor{
-		jwtManager:      jwtManager,
-		accessibleRoles: accessibleRoles,
+		jwtManager: jwtManager,
+		policy:     policy,
 	}
 }
 
@@ -67,84 +68,120 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		// Check if the method requires authentication
-		if !interceptor.isAccessible(info.FullMethod, RoleGuest) {
-			return nil, status.Errorf(codes.PermissionDenied, "no permission to access this RPC")
+		// Methods without role requirements are publicly accessible
+		required, ok := interceptor.policy(info.FullMethod, req)
+		if !ok {
+			return handler(ctx, req)
 		}
 
-		// Get client role from context (mTLS) or JWT token
-		role, err := interceptor.authorize(ctx, info.FullMethod)
+		// Get client identity from context (mTLS) or JWT token
+		principal, err := interceptor.authenticate(ctx)
 		if err != nil {
 			return nil, err
 		}
 
 		// Check if the role has access to the method
-		if !interceptor.isAccessible(info.FullMethod, role) {
+		if !principal.Role.Allows(required) {
 			return nil, status.Errorf(codes.PermissionDenied, "no permission to access this RPC")
 		}
 
 		// Continue execution of the RPC
-		return handler(ctx, req)
+		return handler(NewContext(ctx, principal), req)
+	}
+}
+
+// Stream returns a server interceptor function to authenticate and authorize
+// streaming RPC. The policy is consulted before the first message is
+// received, so it is called with a nil request.
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Methods without role requirements are publicly accessible
+		required, ok := interceptor.policy(info.FullMethod, nil)
+		if !ok {
+			return handler(srv, stream)
+		}
+
+		// Get client identity from context (mTLS) or JWT token
+		principal, err := interceptor.authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		// Check if the role has access to the method
+		if !principal.Role.Allows(required) {
+			return status.Errorf(codes.PermissionDenied, "no permission to access this RPC")
+		}
+
+		// Continue execution of the RPC
+		return handler(srv, &principalStream{
+			ServerStream: stream,
+			ctx:          NewContext(stream.Context(), principal),
+		})
 	}
 }
 
-// authorize checks whether the client is authorized to call the method
-func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) (Role, error) {
-	// First try to get role from client certificate
-	role, err := getRoleFromCert(ctx)
+// principalStream is a server stream whose context carries the principal
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the principal
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate identifies the client by its certificate or JWT token
+func (interceptor *AuthInterceptor) authenticate(ctx context.Context) (*Principal, error) {
+	// First try to get identity from client certificate
+	principal, err := getPrincipalFromCert(ctx)
 	if err == nil {
-		return role, nil
+		return principal, nil
 	}
 
 	// If cert-based auth fails, try JWT auth
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return RoleDenied, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
 	}
 
 	values := md["authorization"]
 	if len(values) == 0 {
-		return RoleDenied, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
 	}
 
-	accessToken := values[0]
+	accessToken := strings.TrimPrefix(values[0], "Bearer ")
 	claims, err := interceptor.jwtManager.Verify(accessToken)
 	if err != nil {
-		return RoleDenied, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
-	}
-
-	return Role(claims.Role), nil
-}
-
-// isAccessible checks if the role has access to the method
-func (interceptor *AuthInterceptor) isAccessible(method string, role Role) bool {
-	if len(interceptor.accessibleRoles[method]) == 0 {
-		return true // Method is publicly accessible
+		return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
 	}
 
-	for _, r := range interceptor.accessibleRoles[method] {
-		if r == string(role) {
-			return true
-		}
-	}
-
-	return false
+	return &Principal{
+		Name:   claims.Username,
+		Role:   Role(claims.Role),
+		Tenant: claims.Tenant,
+	}, nil
 }
 
-// getRoleFromCert extracts the role from the client certificate
-func getRoleFromCert(ctx context.Context) (Role, error) {
+// getPrincipalFromCert identifies the client by its certificate
+func getPrincipalFromCert(ctx context.Context) (*Principal, error) {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
-		return RoleDenied, errors.New("no peer found")
+		return nil, errors.New("no peer found")
 	}
 
 	mtls, ok := p.AuthInfo.(credentials.TLSInfo)
 	if !ok {
-		return RoleDenied, errors.New("not a TLS connection")
+		return nil, errors.New("not a TLS connection")
 	}
 
 	if len(mtls.State.VerifiedChains) == 0 || len(mtls.State.VerifiedChains[0]) == 0 {
-		return RoleDenied, errors.New("no verified client certificate")
+		return nil, errors.New("no verified client certificate")
 	}
 
 	// Extract OU field from client certificate as role
@@ -163,7 +200,17 @@ func getRoleFromCert(ctx context.Context) (Role, error) {
 		}
 	}
 
-	return role, nil
+	// The organization, if any, is the tenant
+	tenant := ""
+	if len(clientCert.Subject.Organization) > 0 {
+		tenant = clientCert.Subject.Organization[0]
+	}
+
+	return &Principal{
+		Name:   clientCert.Subject.CommonName,
+		Role:   role,
+		Tenant: tenant,
+	}, nil
 }
 
 // LoadTLSCredentials loads TLS credentials for mTLS
@@ -18,6 +18,8 @@ type UserClaims struct {
 	jwt.StandardClaims
 	Username string `json:"username"`
 	Role     string `json:"role"`
+	// Tenant groups users sharing definitions; empty means the user alone
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // NewJWTManager returns a new JWT manager
@@ -27,12 +29,18 @@ func NewJWTManager(secretKey string, tokenDuration time.Duration) *JWTManager {
 
 // Generate generates and signs a new token for a user
 func (manager *JWTManager) Generate(username string, role string) (string, error) {
+	return manager.GenerateForTenant(username, role, "")
+}
+
+// GenerateForTenant generates and signs a new token for a user of a tenant
+func (manager *JWTManager) GenerateForTenant(username, role, tenant string) (string, error) {
 	claims := UserClaims{
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(manager.tokenDuration).Unix(),
 		},
 		Username: username,
 		Role:     role,
+		Tenant:   tenant,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -0,0 +1,57 @@
+package auth
+
+import "context"
+
+// roleRank orders the roles; a role grants the permissions of all roles
+// with a lower rank
+var roleRank = map[Role]int{
+	RoleGuest: 1,
+	RoleUser:  2,
+	RoleAdmin: 3,
+}
+
+// Allows reports whether the role grants the permissions of required. Roles
+// outside the hierarchy only allow themselves.
+func (r Role) Allows(required Role) bool {
+	requiredRank, ok := roleRank[required]
+	if !ok {
+		return r == required
+	}
+	return roleRank[r] >= requiredRank
+}
+
+// Policy returns the least role required to call fullMethod with req. Methods
+// for which ok is false are public.
+type Policy func(fullMethod string, req interface{}) (required Role, ok bool)
+
+// StaticPolicy returns a policy that looks up the required role by method
+func StaticPolicy(roles map[string]Role) Policy {
+	return func(fullMethod string, _ interface{}) (Role, bool) {
+		role, ok := roles[fullMethod]
+		return role, ok
+	}
+}
+
+// Principal is an authenticated caller
+type Principal struct {
+	// Name is the JWT username or the certificate common name
+	Name string
+	Role Role
+	// Tenant is the JWT tenant claim or the certificate organization
+	Tenant string
+}
+
+// principalKey is the context key of the Principal
+type principalKey struct{}
+
+// NewContext returns a context carrying principal
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the caller authenticated by the auth
+// interceptor, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
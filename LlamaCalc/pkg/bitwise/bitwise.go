@@ -0,0 +1,194 @@
+// Package bitwise performs programmer arithmetic on fixed-width integers.
+//
+// Values have one of the 8, 16, 32 and 64-bit signed or unsigned types and
+// are stored as their two's-complement bit pattern. Arithmetic wraps around
+// like in C and reports whether the exact result was out of range for the
+// type. Errors use the sentinels of package calc.
+package bitwise
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"llamacalc/pkg/calc"
+)
+
+// Type is a fixed-width integer type
+type Type struct {
+	// Width is the number of bits: 8, 16, 32 or 64
+	Width int
+	// Signed types interpret the bit pattern as two's complement
+	Signed bool
+}
+
+// Integer types
+var (
+	Int8   = Type{Width: 8, Signed: true}
+	Int16  = Type{Width: 16, Signed: true}
+	Int32  = Type{Width: 32, Signed: true}
+	Int64  = Type{Width: 64, Signed: true}
+	Uint8  = Type{Width: 8}
+	Uint16 = Type{Width: 16}
+	Uint32 = Type{Width: 32}
+	Uint64 = Type{Width: 64}
+)
+
+// Types lists every integer type
+var Types = []Type{Int8, Int16, Int32, Int64, Uint8, Uint16, Uint32, Uint64}
+
+// String returns the Go name of the type, e.g. "uint16"
+func (t Type) String() string {
+	if t.Signed {
+		return fmt.Sprintf("int%d", t.Width)
+	}
+	return fmt.Sprintf("uint%d", t.Width)
+}
+
+// ParseType returns the type with the given Go name, e.g. "int32"
+func ParseType(name string) (Type, error) {
+	for _, t := range Types {
+		if strings.EqualFold(name, t.String()) {
+			return t, nil
+		}
+	}
+	return Type{}, &calc.FieldError{Field: "type", Err: fmt.Errorf("%w: unknown integer type %q", calc.ErrInvalidInput, name)}
+}
+
+// valid reports whether t is one of Types
+func (t Type) valid() bool {
+	switch t.Width {
+	case 8, 16, 32, 64:
+		return true
+	}
+	return false
+}
+
+// mask returns the bits of the type
+func (t Type) mask() uint64 {
+	if t.Width == 64 {
+		return ^uint64(0)
+	}
+	return 1<<t.Width - 1
+}
+
+// Min returns the smallest value of the type
+func (t Type) Min() *big.Int {
+	if !t.Signed {
+		return new(big.Int)
+	}
+	return new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), uint(t.Width-1)))
+}
+
+// Max returns the largest value of the type
+func (t Type) Max() *big.Int {
+	width := t.Width
+	if t.Signed {
+		width--
+	}
+	max := new(big.Int).Lsh(big.NewInt(1), uint(width))
+	return max.Sub(max, big.NewInt(1))
+}
+
+// Value is a value of a fixed-width integer type
+type Value struct {
+	Type Type
+	// Bits is the two's-complement bit pattern; bits above the width of the
+	// type are zero
+	Bits uint64
+}
+
+// New returns the value of type t with the bit pattern bits, truncated to
+// the width of t
+func New(t Type, bits uint64) Value {
+	return Value{Type: t, Bits: bits & t.mask()}
+}
+
+// Parse parses a value of type t. Decimal numbers, which may be negative,
+// must be within the range of t. Hexadecimal (0x), binary (0b) and octal
+// (0o) numbers without a sign are bit patterns that must fit in the width of
+// t, so that 0xff is -1 as an int8.
+func Parse(t Type, s string) (Value, error) {
+	s = strings.TrimSpace(s)
+	digits := strings.TrimLeft(s, "+-")
+	prefixed := len(digits) > 1 && digits[0] == '0' && strings.ContainsRune("xXbBoO", rune(digits[1]))
+
+	if prefixed && digits == s {
+		pattern, err := strconv.ParseUint(s, 0, 64)
+		if err != nil || bits.Len64(pattern) > t.Width {
+			return Value{}, fmt.Errorf("%w: %q is not a bit pattern of %d bits", calc.ErrInvalidInput, s, t.Width)
+		}
+		return New(t, pattern), nil
+	}
+
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok || (!prefixed && strings.HasPrefix(digits, "0") && len(digits) > 1) {
+		return Value{}, fmt.Errorf("%w: %q is not an integer", calc.ErrInvalidInput, s)
+	}
+	if n.Cmp(t.Min()) < 0 || n.Cmp(t.Max()) > 0 {
+		return Value{}, fmt.Errorf("%w: %s is out of range for %v", calc.ErrInvalidInput, s, t)
+	}
+	return FromBig(t, n), nil
+}
+
+// FromBig returns n wrapped around to type t
+func FromBig(t Type, n *big.Int) Value {
+	// The low 64 bits of the two's complement of n
+	low := new(big.Int).And(n, new(big.Int).SetUint64(^uint64(0)))
+	return New(t, low.Uint64())
+}
+
+// Big returns the value as a big integer
+func (v Value) Big() *big.Int {
+	if v.Type.Signed {
+		return big.NewInt(v.Int64())
+	}
+	return new(big.Int).SetUint64(v.Bits)
+}
+
+// Int64 returns the value sign-extended to 64 bits
+func (v Value) Int64() int64 {
+	shift := 64 - v.Type.Width
+	if !v.Type.Signed {
+		return int64(v.Bits)
+	}
+	return int64(v.Bits<<shift) >> shift
+}
+
+// String returns the value in decimal
+func (v Value) String() string {
+	return v.Big().String()
+}
+
+// Format returns the value in base 2, 8, 10 or 16. Bases other than 10
+// format the bit pattern with a 0b, 0o or 0x prefix; binary and hexadecimal
+// are padded to the width of the type.
+func (v Value) Format(base int) string {
+	switch base {
+	case 2:
+		return fmt.Sprintf("0b%0*b", v.Type.Width, v.Bits)
+	case 8:
+		return fmt.Sprintf("0o%o", v.Bits)
+	case 16:
+		return fmt.Sprintf("0x%0*x", v.Type.Width/4, v.Bits)
+	}
+	return v.String()
+}
+
+// Result is the result of an operation
+type Result struct {
+	Value Value
+	// Overflow is set when the exact result was out of range for the type
+	// and Value wrapped around
+	Overflow bool
+}
+
+// wrap returns the result for the exact value n
+func wrap(t Type, n *big.Int) Result {
+	return Result{
+		Value:    FromBig(t, n),
+		Overflow: n.Cmp(t.Min()) < 0 || n.Cmp(t.Max()) > 0,
+	}
+}
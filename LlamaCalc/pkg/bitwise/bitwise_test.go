@@ -0,0 +1,360 @@
+package bitwise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"llamacalc/pkg/bitwise"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+)
+
+// assertField fails unless err is a FieldError for field wrapping want
+func assertField(t *testing.T, err error, field string, want error) {
+	t.Helper()
+	var e *calc.FieldError
+	if !errors.As(err, &e) || e.Field != field || !errors.Is(err, want) {
+		t.Errorf("got %v, want %v for field %s", err, want, field)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		Type  bitwise.Type
+		Input string
+		// Want is the value in decimal, or empty if the input is invalid
+		Want string
+	}{
+		{bitwise.Int8, "0xff", "-1"},
+		{bitwise.Int8, "0x80", "-128"},
+		{bitwise.Int8, "-128", "-128"},
+		{bitwise.Int8, "-0x80", "-128"},
+		{bitwise.Int8, "127", "127"},
+		{bitwise.Int8, "+5", "5"},
+		{bitwise.Int8, "128", ""},
+		{bitwise.Int8, "-129", ""},
+		{bitwise.Int8, "-0x81", ""},
+		{bitwise.Int8, "0x100", ""},
+		{bitwise.Int16, "0x8000", "-32768"},
+		{bitwise.Int16, "0b1111111111111111", "-1"},
+		{bitwise.Int16, "-32769", ""},
+		{bitwise.Int32, "0xffffffff", "-1"},
+		{bitwise.Int32, "0o17777777777", "2147483647"},
+		{bitwise.Int32, "2147483648", ""},
+		{bitwise.Int64, "0x8000000000000000", "-9223372036854775808"},
+		{bitwise.Int64, "-9223372036854775808", "-9223372036854775808"},
+		{bitwise.Int64, "9223372036854775808", ""},
+		{bitwise.Uint8, "0xff", "255"},
+		{bitwise.Uint8, "0b101", "5"},
+		{bitwise.Uint8, "0o17", "15"},
+		{bitwise.Uint8, " 255 ", "255"},
+		{bitwise.Uint8, "256", ""},
+		{bitwise.Uint8, "-1", ""},
+		{bitwise.Uint8, "-0x1", ""},
+		{bitwise.Uint8, "0b100000000", ""},
+		{bitwise.Uint16, "0xffff", "65535"},
+		{bitwise.Uint16, "0x10000", ""},
+		{bitwise.Uint32, "4294967295", "4294967295"},
+		{bitwise.Uint32, "0x100000000", ""},
+		{bitwise.Uint64, "0xffffffffffffffff", "18446744073709551615"},
+		{bitwise.Uint64, "18446744073709551615", "18446744073709551615"},
+		{bitwise.Uint64, "18446744073709551616", ""},
+		{bitwise.Uint64, "0x10000000000000000", ""},
+		{bitwise.Uint64, "0", "0"},
+		{bitwise.Uint64, "012", ""},
+		{bitwise.Uint64, "1.5", ""},
+		{bitwise.Uint64, "", ""},
+	}
+	for _, tc := range tests {
+		got, err := bitwise.Parse(tc.Type, tc.Input)
+		if tc.Want == "" {
+			if !errors.Is(err, calc.ErrInvalidInput) {
+				t.Errorf("Parse(%v, %q) = %v, %v, want an invalid input error", tc.Type, tc.Input, got, err)
+			}
+			continue
+		}
+		if err != nil || got.String() != tc.Want || got.Type != tc.Type {
+			t.Errorf("Parse(%v, %q) = %v, %v, want %s", tc.Type, tc.Input, got, err, tc.Want)
+		}
+	}
+}
+
+func TestParseType(t *testing.T) {
+	for _, want := range bitwise.Types {
+		if got, err := bitwise.ParseType(want.String()); err != nil || got != want {
+			t.Errorf("ParseType(%q) = %v, %v", want.String(), got, err)
+		}
+	}
+	if got, err := bitwise.ParseType("UINT16"); err != nil || got != bitwise.Uint16 {
+		t.Errorf("ParseType(%q) = %v, %v", "UINT16", got, err)
+	}
+	_, err := bitwise.ParseType("int128")
+	assertField(t, err, "type", calc.ErrInvalidInput)
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		Value bitwise.Value
+		Base  int
+		Want  string
+	}{
+		{bitwise.New(bitwise.Int8, 0xff), 10, "-1"},
+		{bitwise.New(bitwise.Int8, 0xff), 16, "0xff"},
+		{bitwise.New(bitwise.Int8, 0xff), 8, "0o377"},
+		{bitwise.New(bitwise.Int8, 0xff), 2, "0b11111111"},
+		{bitwise.New(bitwise.Uint8, 0x1ff), 10, "255"},
+		{bitwise.New(bitwise.Int16, 5), 16, "0x0005"},
+		{bitwise.New(bitwise.Uint16, 5), 2, "0b0000000000000101"},
+		{bitwise.New(bitwise.Int32, 0x80000000), 10, "-2147483648"},
+		{bitwise.New(bitwise.Uint32, 1), 16, "0x00000001"},
+		{bitwise.New(bitwise.Int64, 1<<63), 16, "0x8000000000000000"},
+		{bitwise.New(bitwise.Uint64, 1<<63), 10, "9223372036854775808"},
+		{bitwise.New(bitwise.Uint64, 8), 8, "0o10"},
+	}
+	for _, tc := range tests {
+		if got := tc.Value.Format(tc.Base); got != tc.Want {
+			t.Errorf("%v.Format(%d) = %s, want %s", tc.Value, tc.Base, got, tc.Want)
+		}
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		Type     bitwise.Type
+		Op       string
+		Args     []string
+		Want     string
+		Overflow bool
+	}{
+		// Arithmetic wraps around
+		{bitwise.Int8, "Negate", []string{"-128"}, "-128", true},
+		{bitwise.Int16, "Negate", []string{"-32768"}, "-32768", true},
+		{bitwise.Int32, "Negate", []string{"-2147483648"}, "-2147483648", true},
+		{bitwise.Int64, "Negate", []string{"-9223372036854775808"}, "-9223372036854775808", true},
+		{bitwise.Int8, "Negate", []string{"127"}, "-127", false},
+		{bitwise.Uint8, "Negate", []string{"1"}, "255", true},
+		{bitwise.Uint8, "Negate", []string{"0"}, "0", false},
+		{bitwise.Int8, "Add", []string{"127", "1"}, "-128", true},
+		{bitwise.Int8, "Add", []string{"-128", "-1"}, "127", true},
+		{bitwise.Int64, "Add", []string{"9223372036854775807", "1"}, "-9223372036854775808", true},
+		{bitwise.Uint8, "Add", []string{"255", "1"}, "0", true},
+		{bitwise.Uint16, "Add", []string{"65535", "1"}, "0", true},
+		{bitwise.Uint32, "Add", []string{"4294967295", "1"}, "0", true},
+		{bitwise.Uint64, "Add", []string{"18446744073709551615", "1"}, "0", true},
+		{bitwise.Uint64, "Add", []string{"18446744073709551614", "1"}, "18446744073709551615", false},
+		{bitwise.Uint32, "Subtract", []string{"0", "1"}, "4294967295", true},
+		{bitwise.Int16, "Multiply", []string{"256", "128"}, "-32768", true},
+		{bitwise.Int16, "Multiply", []string{"-256", "128"}, "-32768", false},
+		{bitwise.Int8, "Divide", []string{"-128", "-1"}, "-128", true},
+		{bitwise.Int32, "Divide", []string{"-7", "2"}, "-3", false},
+		{bitwise.Int32, "Mod", []string{"-7", "3"}, "-1", false},
+		{bitwise.Uint8, "Pow", []string{"2", "8"}, "0", true},
+		{bitwise.Uint8, "Pow", []string{"2", "7"}, "128", false},
+		{bitwise.Int32, "Pow", []string{"2", "31"}, "-2147483648", true},
+		{bitwise.Int64, "Pow", []string{"3", "40"}, "-6289078614652622815", true},
+		{bitwise.Uint64, "Pow", []string{"2", "100"}, "0", true},
+		{bitwise.Int8, "Pow", []string{"-1", "65"}, "-1", false},
+
+		// Bit patterns
+		{bitwise.Int8, "Not", []string{"0"}, "-1", false},
+		{bitwise.Uint8, "Not", []string{"0"}, "255", false},
+		{bitwise.Int16, "Not", []string{"0x7fff"}, "-32768", false},
+		{bitwise.Uint64, "Not", []string{"0"}, "18446744073709551615", false},
+		{bitwise.Int8, "And", []string{"-1", "0x0f"}, "15", false},
+		{bitwise.Int8, "Or", []string{"0x80", "1"}, "-127", false},
+		{bitwise.Uint32, "Xor", []string{"0xffffffff", "1"}, "4294967294", false},
+		{bitwise.Int64, "Xor", []string{"-1", "1"}, "-2", false},
+		{bitwise.Int8, "PopCount", []string{"-1"}, "8", false},
+		{bitwise.Int16, "PopCount", []string{"-1"}, "16", false},
+		{bitwise.Int32, "PopCount", []string{"-2147483648"}, "1", false},
+		{bitwise.Int64, "PopCount", []string{"-1"}, "64", false},
+		{bitwise.Uint8, "PopCount", []string{"0"}, "0", false},
+		{bitwise.Uint16, "PopCount", []string{"0xf0f0"}, "8", false},
+		{bitwise.Uint64, "PopCount", []string{"0xffffffffffffffff"}, "64", false},
+		{bitwise.Int8, "ByteSwap", []string{"-2"}, "-2", false},
+		{bitwise.Uint8, "ByteSwap", []string{"0x12"}, "18", false},
+		{bitwise.Int16, "ByteSwap", []string{"0x00ff"}, "-256", false},
+		{bitwise.Uint16, "ByteSwap", []string{"0x1234"}, "13330", false},
+		{bitwise.Int32, "ByteSwap", []string{"0xff"}, "-16777216", false},
+		{bitwise.Uint32, "ByteSwap", []string{"0x12345678"}, "2018915346", false},
+		{bitwise.Int64, "ByteSwap", []string{"0x80"}, "-9223372036854775808", false},
+		{bitwise.Uint64, "ByteSwap", []string{"0x0102030405060708"}, "578437695752307201", false},
+
+		// Shifts
+		{bitwise.Uint8, "Shl", []string{"1", "7"}, "128", false},
+		{bitwise.Uint8, "Shl", []string{"1", "8"}, "0", true},
+		{bitwise.Int8, "Shl", []string{"1", "7"}, "-128", true},
+		{bitwise.Int8, "Shl", []string{"-1", "7"}, "-128", false},
+		{bitwise.Int16, "Shl", []string{"1", "100"}, "0", true},
+		{bitwise.Int16, "Shl", []string{"0", "100"}, "0", false},
+		{bitwise.Uint64, "Shl", []string{"1", "63"}, "9223372036854775808", false},
+		{bitwise.Uint64, "Shl", []string{"1", "64"}, "0", true},
+		{bitwise.Int8, "Shr", []string{"-128", "7"}, "-1", false},
+		{bitwise.Uint8, "Shr", []string{"0x80", "7"}, "1", false},
+		{bitwise.Int32, "Shr", []string{"-1", "100"}, "-1", false},
+		{bitwise.Uint32, "Shr", []string{"0xffffffff", "31"}, "1", false},
+		{bitwise.Int64, "Shr", []string{"0x7fffffffffffffff", "64"}, "0", false},
+		{bitwise.Uint64, "Shr", []string{"0xffffffffffffffff", "64"}, "0", false},
+		{bitwise.Uint8, "Rotl", []string{"0x12", "4"}, "33", false},
+		{bitwise.Int8, "Rotl", []string{"0x81", "1"}, "3", false},
+		{bitwise.Uint16, "Rotr", []string{"1", "1"}, "32768", false},
+		{bitwise.Int32, "Rotr", []string{"1", "33"}, "-2147483648", false},
+		{bitwise.Uint8, "Rotl", []string{"0x12", "100"}, "33", false},
+		{bitwise.Uint64, "Rotl", []string{"1", "0xffffffffffffffff"}, "9223372036854775808", false},
+	}
+	for _, tc := range tests {
+		got, err := bitwise.Calculate(tc.Type, tc.Op, tc.Args...)
+		if err != nil || got.Value.String() != tc.Want || got.Overflow != tc.Overflow || got.Value.Type != tc.Type {
+			t.Errorf("%v %s%v = %v (overflow %v), %v, want %s (overflow %v)",
+				tc.Type, tc.Op, tc.Args, got.Value, got.Overflow, err, tc.Want, tc.Overflow)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for _, typ := range bitwise.Types {
+		v := bitwise.New(typ, 0x8123456789abcdef)
+		width := uint64(typ.Width)
+
+		for _, n := range []uint64{0, width} {
+			for _, op := range []string{"Rotl", "Rotr"} {
+				got, err := bitwise.Apply(typ, op, v, bitwise.New(typ, n))
+				if err != nil || got.Value != v || got.Overflow {
+					t.Errorf("%v %s(%v, %d) = %v, %v, want the value unchanged", typ, op, v, n, got, err)
+				}
+			}
+		}
+
+		// Rotating by one moves the top bit to the bottom
+		left, err := bitwise.Apply(typ, "Rotl", v, bitwise.New(typ, 1))
+		if err != nil || left.Value.Bits&1 != 1 {
+			t.Errorf("%v Rotl(%v, 1) = %v, %v", typ, v, left, err)
+		}
+		right, err := bitwise.Apply(typ, "Rotr", left.Value, bitwise.New(typ, width+1))
+		if err != nil || right.Value != v {
+			t.Errorf("%v Rotr(%v, %d) = %v, %v, want %v", typ, left.Value, width+1, right, err, v)
+		}
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	one := bitwise.New(bitwise.Int8, 1)
+	minus := bitwise.New(bitwise.Int8, 0xff)
+
+	tests := []struct {
+		Name  string
+		Type  bitwise.Type
+		Op    string
+		Args  []bitwise.Value
+		Field string
+		Err   error
+	}{
+		{"unknown type", bitwise.Type{Width: 12}, "Add", []bitwise.Value{one, one}, "type", calc.ErrInvalidInput},
+		{"unknown operation", bitwise.Int8, "Sqrt", []bitwise.Value{one}, "operation", calc.ErrUnknownOperation},
+		{"arity", bitwise.Int8, "Not", []bitwise.Value{one, one}, "args", calc.ErrInvalidInput},
+		{"mixed types", bitwise.Int8, "Add", []bitwise.Value{one, bitwise.New(bitwise.Uint8, 1)}, "b", calc.ErrInvalidInput},
+		{"divide by zero", bitwise.Int8, "Divide", []bitwise.Value{one, bitwise.New(bitwise.Int8, 0)}, "b", calc.ErrDivideByZero},
+		{"modulo zero", bitwise.Int8, "Mod", []bitwise.Value{one, bitwise.New(bitwise.Int8, 0)}, "b", calc.ErrDivideByZero},
+		{"negative exponent", bitwise.Int8, "Pow", []bitwise.Value{one, minus}, "b", calc.ErrInvalidInput},
+		{"negative shift", bitwise.Int8, "Shl", []bitwise.Value{one, minus}, "n", calc.ErrInvalidInput},
+		{"negative right shift", bitwise.Int8, "Shr", []bitwise.Value{one, minus}, "n", calc.ErrInvalidInput},
+		{"negative rotate", bitwise.Int8, "Rotr", []bitwise.Value{one, minus}, "n", calc.ErrInvalidInput},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := bitwise.Apply(tc.Type, tc.Op, tc.Args...)
+			assertField(t, err, tc.Field, tc.Err)
+		})
+	}
+
+	// The same count is a large shift for unsigned types
+	if got, err := bitwise.Apply(bitwise.Uint8, "Shl", bitwise.New(bitwise.Uint8, 1), bitwise.New(bitwise.Uint8, 0xff)); err != nil || !got.Overflow {
+		t.Errorf("got %v, %v, want overflow", got, err)
+	}
+
+	// Parse errors are attributed to the parameter
+	_, err := bitwise.Calculate(bitwise.Uint8, "Add", "1", "-1")
+	assertField(t, err, "b", calc.ErrInvalidInput)
+	_, err = bitwise.Calculate(bitwise.Uint8, "Shl", "0x100", "1")
+	assertField(t, err, "a", calc.ErrInvalidInput)
+	_, err = bitwise.Calculate(bitwise.Uint8, "Not", "1", "2", "x")
+	assertField(t, err, "args", calc.ErrInvalidInput)
+	_, err = bitwise.Calculate(bitwise.Uint8, "Cube", "1")
+	assertField(t, err, "operation", calc.ErrUnknownOperation)
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		Type       bitwise.Type
+		Expression string
+		Want       string
+		Overflow   bool
+	}{
+		{bitwise.Uint8, "~0 + 1", "0", true},
+		{bitwise.Int8, "~0 + 1", "0", false},
+		{bitwise.Int8, "-128", "-128", false},
+		{bitwise.Int8, "-(-128)", "-128", true},
+		{bitwise.Int8, "0xff", "-1", false},
+		{bitwise.Int8, "~0x7f", "-128", false},
+		{bitwise.Int8, "127 + 1 + 0", "-128", true},
+		{bitwise.Uint8, "2 ^ 8", "0", true},
+		{bitwise.Uint8, "rotl(0x12, 0) | rotr(0x12, 8)", "18", false},
+		{bitwise.Int16, "popcount(-1)", "16", false},
+		{bitwise.Uint16, "0xff << 8 | 0x0f", "65295", false},
+		{bitwise.Int32, "(0xf0 | 0b0101) << 2", "980", false},
+		{bitwise.Uint32, "byteswap(0x12345678)", "2018915346", false},
+		{bitwise.Uint32, "0xff & ~0x0f ^ 1", "240", false},
+		{bitwise.Int64, "rotl(1, 64)", "1", false},
+		{bitwise.Int64, "-9223372036854775808 >> 63", "-1", false},
+		{bitwise.Uint64, "0xffffffffffffffff + 1", "0", true},
+		{bitwise.Uint64, "xor(0o777, 0b111)", "504", false},
+	}
+	for _, tc := range tests {
+		got, err := bitwise.Evaluate(context.Background(), tc.Type, tc.Expression)
+		if err != nil || got.Value.String() != tc.Want || got.Overflow != tc.Overflow {
+			t.Errorf("%v %q = %v (overflow %v), %v, want %s (overflow %v)",
+				tc.Type, tc.Expression, got.Value, got.Overflow, err, tc.Want, tc.Overflow)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		Type       bitwise.Type
+		Expression string
+		Offset     int
+		Name       string
+		Err        error
+	}{
+		{bitwise.Uint8, "1 + 0x1ff", 4, "0x1ff", calc.ErrInvalidInput},
+		{bitwise.Uint8, "1 + -1", 5, "-1", calc.ErrInvalidInput},
+		{bitwise.Int8, "(1 + -129)", 6, "-129", calc.ErrInvalidInput},
+		{bitwise.Uint8, "4 / 0", 2, "Divide", calc.ErrDivideByZero},
+		{bitwise.Int16, "7 % (1 - 1)", 2, "Mod", calc.ErrDivideByZero},
+		{bitwise.Int8, "1 << -1", 2, "Shl", calc.ErrInvalidInput},
+		{bitwise.Int32, "2 ^ -1", 2, "Pow", calc.ErrInvalidInput},
+		{bitwise.Int64, "x + 1", 0, "x", calc.ErrInvalidInput},
+		{bitwise.Uint64, "1 + cube(2)", 4, "cube", calc.ErrUnknownOperation},
+		{bitwise.Uint16, "not(1, 2)", 0, "Not", calc.ErrInvalidInput},
+	}
+	for _, tc := range tests {
+		_, err := bitwise.Evaluate(context.Background(), tc.Type, tc.Expression)
+		var e *expr.EvalError
+		if !errors.As(err, &e) || e.Offset != tc.Offset || e.Name != tc.Name || !errors.Is(err, tc.Err) {
+			t.Errorf("%v %q: got %v, want %v in %s at offset %d", tc.Type, tc.Expression, err, tc.Err, tc.Name, tc.Offset)
+		}
+		assertField(t, err, "expression", tc.Err)
+	}
+
+	_, err := bitwise.Evaluate(context.Background(), bitwise.Int8, "1 +")
+	assertField(t, err, "expression", calc.ErrInvalidInput)
+	_, err = bitwise.Evaluate(context.Background(), bitwise.Type{}, "1")
+	assertField(t, err, "type", calc.ErrInvalidInput)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := bitwise.Evaluate(ctx, bitwise.Int8, "1 + 1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,118 @@
+package bitwise
+
+import (
+	"context"
+	"fmt"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+)
+
+// Evaluate evaluates an expression such as "(0xf0 | 0b0101) << 2" on values
+// of type t. Literals are parsed with Parse, operators and functions are the
+// operations of Apply, and the result reports overflow if any step
+// overflowed.
+func Evaluate(ctx context.Context, t Type, expression string) (Result, error) {
+	if !t.valid() {
+		return Result{}, &calc.FieldError{Field: "type", Err: fmt.Errorf("%w: unknown integer type", calc.ErrInvalidInput)}
+	}
+
+	node, err := expr.Parse(expression)
+	if err != nil {
+		return Result{}, &calc.FieldError{Field: "expression", Err: fmt.Errorf("%w: %v", calc.ErrInvalidInput, err)}
+	}
+
+	e := &evaluator{t: t}
+	value, err := e.eval(ctx, node)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return Result{}, ctxErr
+		}
+		return Result{}, &calc.FieldError{Field: "expression", Err: err}
+	}
+
+	return Result{Value: value, Overflow: e.overflow}, nil
+}
+
+// evaluator evaluates an expression and records whether any step overflowed
+type evaluator struct {
+	t        Type
+	overflow bool
+}
+
+// eval evaluates node
+func (e *evaluator) eval(ctx context.Context, node expr.Node) (Value, error) {
+	if err := ctx.Err(); err != nil {
+		return Value{}, err
+	}
+
+	switch n := node.(type) {
+	case *expr.Number:
+		return e.literal(n, "")
+
+	case *expr.Ident:
+		return Value{}, &expr.EvalError{Offset: n.Offset, Name: n.Name, Err: fmt.Errorf("%w: unknown constant %s", calc.ErrInvalidInput, n.Name)}
+
+	case *expr.Unary:
+		// A negative literal such as -128 is parsed whole, as it may only be
+		// in range with its sign
+		if number, ok := n.X.(*expr.Number); ok && n.Op == "-" {
+			return e.literal(number, "-")
+		}
+
+		x, err := e.eval(ctx, n.X)
+		if err != nil {
+			return Value{}, err
+		}
+		switch n.Op {
+		case "+":
+			return x, nil
+		case "-":
+			return e.call(n.Offset, "Negate", x)
+		}
+		return e.call(n.Offset, expr.UnaryOperators[n.Op], x)
+
+	case *expr.Binary:
+		x, err := e.eval(ctx, n.X)
+		if err != nil {
+			return Value{}, err
+		}
+		y, err := e.eval(ctx, n.Y)
+		if err != nil {
+			return Value{}, err
+		}
+		return e.call(n.Offset, expr.Operators[n.Op], x, y)
+
+	case *expr.Call:
+		args := make([]Value, len(n.Args))
+		for i, arg := range n.Args {
+			value, err := e.eval(ctx, arg)
+			if err != nil {
+				return Value{}, err
+			}
+			args[i] = value
+		}
+		return e.call(n.Offset, n.Func, args...)
+	}
+
+	return Value{}, fmt.Errorf("unsupported expression node %T", node)
+}
+
+// literal parses a number with an optional sign
+func (e *evaluator) literal(n *expr.Number, sign string) (Value, error) {
+	value, err := Parse(e.t, sign+n.Text)
+	if err != nil {
+		return Value{}, &expr.EvalError{Offset: n.Offset, Name: sign + n.Text, Err: err}
+	}
+	return value, nil
+}
+
+// call applies an operation and attributes errors to its position
+func (e *evaluator) call(offset int, name string, args ...Value) (Value, error) {
+	result, err := Apply(e.t, name, args...)
+	if err != nil {
+		return Value{}, &expr.EvalError{Offset: offset, Name: Name(name), Err: err}
+	}
+	e.overflow = e.overflow || result.Overflow
+	return result.Value, nil
+}
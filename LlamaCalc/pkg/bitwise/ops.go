@@ -0,0 +1,246 @@
+package bitwise
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"llamacalc/pkg/calc"
+)
+
+// operation is an operation on values of the same type. Shift and rotate
+// counts are passed as values of that type too.
+type operation struct {
+	name   string
+	params []string
+	fn     func(t Type, args []Value) (Result, error)
+}
+
+// exact adapts an operation computed exactly on big integers, whose result
+// wraps around to the type
+func exact(fn func(args []*big.Int) (*big.Int, error)) func(t Type, args []Value) (Result, error) {
+	return func(t Type, args []Value) (Result, error) {
+		ints := make([]*big.Int, len(args))
+		for i, arg := range args {
+			ints[i] = arg.Big()
+		}
+		n, err := fn(ints)
+		if err != nil {
+			return Result{}, err
+		}
+		return wrap(t, n), nil
+	}
+}
+
+// pattern adapts an operation on bit patterns, which cannot overflow
+func pattern(fn func(t Type, args []uint64) uint64) func(t Type, args []Value) (Result, error) {
+	return func(t Type, args []Value) (Result, error) {
+		patterns := make([]uint64, len(args))
+		for i, arg := range args {
+			patterns[i] = arg.Bits
+		}
+		return Result{Value: New(t, fn(t, patterns))}, nil
+	}
+}
+
+// count returns a shift or rotate count, which must not be negative
+func count(v Value) (uint64, error) {
+	n := v.Int64()
+	if v.Type.Signed && n < 0 {
+		return 0, &calc.FieldError{Field: "n", Err: fmt.Errorf("%w: shift count must not be negative", calc.ErrInvalidInput)}
+	}
+	return uint64(n), nil
+}
+
+// shift adapts a shift by a count; counts above 64 shift out every bit like
+// a count of 64
+func shift(fn func(t Type, a Value, n uint) Result) func(t Type, args []Value) (Result, error) {
+	return func(t Type, args []Value) (Result, error) {
+		n, err := count(args[1])
+		if err != nil {
+			return Result{}, err
+		}
+		return fn(t, args[0], uint(min(n, 64))), nil
+	}
+}
+
+// rotate adapts a rotate by a count modulo the width of the type
+func rotate(fn func(t Type, a Value, n uint) Result) func(t Type, args []Value) (Result, error) {
+	return func(t Type, args []Value) (Result, error) {
+		n, err := count(args[1])
+		if err != nil {
+			return Result{}, err
+		}
+		return fn(t, args[0], uint(n%uint64(t.Width))), nil
+	}
+}
+
+// divisor checks that the divisor b is not zero
+func divisor(b *big.Int) error {
+	if b.Sign() == 0 {
+		return &calc.FieldError{Field: "b", Err: calc.ErrDivideByZero}
+	}
+	return nil
+}
+
+// operations are the operations available to Apply and to expressions, by
+// lower-case name. Their names match the functions that expression operators
+// stand for.
+var operations = byName([]*operation{
+	{name: "Add", params: []string{"a", "b"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		return new(big.Int).Add(args[0], args[1]), nil
+	})},
+	{name: "Subtract", params: []string{"a", "b"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		return new(big.Int).Sub(args[0], args[1]), nil
+	})},
+	{name: "Multiply", params: []string{"a", "b"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		return new(big.Int).Mul(args[0], args[1]), nil
+	})},
+	{name: "Divide", params: []string{"a", "b"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		if err := divisor(args[1]); err != nil {
+			return nil, err
+		}
+		return new(big.Int).Quo(args[0], args[1]), nil
+	})},
+	{name: "Mod", params: []string{"a", "b"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		if err := divisor(args[1]); err != nil {
+			return nil, err
+		}
+		return new(big.Int).Rem(args[0], args[1]), nil
+	})},
+	{name: "Negate", params: []string{"a"}, fn: exact(func(args []*big.Int) (*big.Int, error) {
+		return new(big.Int).Neg(args[0]), nil
+	})},
+	{name: "Pow", params: []string{"a", "b"}, fn: func(t Type, args []Value) (Result, error) {
+		a, b := args[0].Big(), args[1].Big()
+		if b.Sign() < 0 {
+			return Result{}, &calc.FieldError{Field: "b", Err: fmt.Errorf("%w: the exponent must not be negative", calc.ErrInvalidInput)}
+		}
+		// |a| >= 2 overflows every type from an exponent of 64 on; the
+		// wrapped value only depends on the low 64 bits
+		if b.Cmp(big.NewInt(64)) >= 0 && a.CmpAbs(big.NewInt(1)) > 0 {
+			modulus := new(big.Int).Lsh(big.NewInt(1), 64)
+			return Result{Value: FromBig(t, new(big.Int).Exp(a, b, modulus)), Overflow: true}, nil
+		}
+		return wrap(t, new(big.Int).Exp(a, b, nil)), nil
+	}},
+
+	{name: "And", params: []string{"a", "b"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return args[0] & args[1]
+	})},
+	{name: "Or", params: []string{"a", "b"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return args[0] | args[1]
+	})},
+	{name: "Xor", params: []string{"a", "b"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return args[0] ^ args[1]
+	})},
+	{name: "Not", params: []string{"a"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return ^args[0]
+	})},
+	{name: "PopCount", params: []string{"a"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return uint64(bits.OnesCount64(args[0]))
+	})},
+	{name: "ByteSwap", params: []string{"a"}, fn: pattern(func(t Type, args []uint64) uint64 {
+		return bits.ReverseBytes64(args[0]) >> (64 - t.Width)
+	})},
+
+	// Shifting left overflows when the exact product a * 2**n is out of
+	// range; shifting right is arithmetic for signed types
+	{name: "Shl", params: []string{"a", "n"}, fn: shift(func(t Type, a Value, n uint) Result {
+		return wrap(t, new(big.Int).Lsh(a.Big(), n))
+	})},
+	{name: "Shr", params: []string{"a", "n"}, fn: shift(func(t Type, a Value, n uint) Result {
+		if t.Signed {
+			return Result{Value: New(t, uint64(a.Int64()>>n))}
+		}
+		return Result{Value: New(t, a.Bits>>n)}
+	})},
+	{name: "Rotl", params: []string{"a", "n"}, fn: rotate(func(t Type, a Value, n uint) Result {
+		return Result{Value: New(t, a.Bits<<n|a.Bits>>(uint(t.Width)-n))}
+	})},
+	{name: "Rotr", params: []string{"a", "n"}, fn: rotate(func(t Type, a Value, n uint) Result {
+		return Result{Value: New(t, a.Bits>>n|a.Bits<<(uint(t.Width)-n))}
+	})},
+})
+
+// byName indexes ops by lower-case name
+func byName(ops []*operation) map[string]*operation {
+	index := make(map[string]*operation, len(ops))
+	for _, op := range ops {
+		index[strings.ToLower(op.name)] = op
+	}
+	return index
+}
+
+// Operations returns the names of the operations in sorted order
+func Operations() []string {
+	names := make([]string, 0, len(operations))
+	for _, op := range operations {
+		names = append(names, op.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Apply performs the named operation on args, which must all have type t.
+// Names are matched case-insensitively.
+func Apply(t Type, name string, args ...Value) (Result, error) {
+	if !t.valid() {
+		return Result{}, &calc.FieldError{Field: "type", Err: fmt.Errorf("%w: unknown integer type", calc.ErrInvalidInput)}
+	}
+
+	op, ok := operations[strings.ToLower(name)]
+	if !ok {
+		return Result{}, &calc.FieldError{Field: "operation", Err: calc.ErrUnknownOperation}
+	}
+	if len(args) != len(op.params) {
+		return Result{}, &calc.FieldError{
+			Field: "args",
+			Err:   fmt.Errorf("%w: %s takes %d arguments, got %d", calc.ErrInvalidInput, op.name, len(op.params), len(args)),
+		}
+	}
+	for i, arg := range args {
+		if arg.Type != t {
+			return Result{}, &calc.FieldError{
+				Field: op.params[i],
+				Err:   fmt.Errorf("%w: %v argument for a %v operation", calc.ErrInvalidInput, arg.Type, t),
+			}
+		}
+	}
+
+	return op.fn(t, args)
+}
+
+// Name returns the display name of the named operation
+func Name(name string) string {
+	if op, ok := operations[strings.ToLower(name)]; ok {
+		return op.name
+	}
+	return name
+}
+
+// Calculate parses args as values of type t with Parse and performs the named
+// operation on them
+func Calculate(t Type, name string, args ...string) (Result, error) {
+	op, ok := operations[strings.ToLower(name)]
+	if !ok || !t.valid() {
+		return Apply(t, name)
+	}
+
+	values := make([]Value, len(args))
+	for i, arg := range args {
+		value, err := Parse(t, arg)
+		if err != nil {
+			field := "args"
+			if i < len(op.params) {
+				field = op.params[i]
+			}
+			return Result{}, &calc.FieldError{Field: field, Err: err}
+		}
+		values[i] = value
+	}
+
+	return Apply(t, name, values...)
+}
@@ -0,0 +1,76 @@
+package calc
+
+import (
+	"context"
+	"math"
+)
+
+// AngleUnit is the unit of angles taken or returned by trigonometric functions
+type AngleUnit int
+
+// Angle units
+const (
+	Radians AngleUnit = iota
+	Degrees
+)
+
+// String implements fmt.Stringer
+func (u AngleUnit) String() string {
+	if u == Degrees {
+		return "degrees"
+	}
+	return "radians"
+}
+
+// Angle describes whether an operation works with angles
+type Angle int
+
+// Angle usages
+const (
+	// NoAngle operations are independent of the angle unit
+	NoAngle Angle = iota
+	// AngleArgs operations take angles, e.g. sin
+	AngleArgs
+	// AngleResult operations return an angle, e.g. asin
+	AngleResult
+)
+
+// angleUnitKey is the context key of the AngleUnit
+type angleUnitKey struct{}
+
+// WithAngleUnit returns a context in which calculations use unit for angles
+func WithAngleUnit(ctx context.Context, unit AngleUnit) context.Context {
+	return context.WithValue(ctx, angleUnitKey{}, unit)
+}
+
+// AngleUnitFromContext returns the angle unit of ctx; the default is Radians
+func AngleUnitFromContext(ctx context.Context) AngleUnit {
+	unit, _ := ctx.Value(angleUnitKey{}).(AngleUnit)
+	return unit
+}
+
+// toRadians converts angles in degrees to radians. Whole turns are removed
+// first so that e.g. sin(360) is exactly 0.
+func toRadians(degrees []float64) []float64 {
+	radians := make([]float64, len(degrees))
+	for i, d := range degrees {
+		radians[i] = angleToRadians(Degrees, d)
+	}
+	return radians
+}
+
+// fromRadians converts an angle in radians to unit
+func fromRadians(unit AngleUnit, radians float64) float64 {
+	if unit == Degrees {
+		return radians * 180 / math.Pi
+	}
+	return radians
+}
+
+// angleToRadians converts an angle in unit to radians
+func angleToRadians(unit AngleUnit, angle float64) float64 {
+	if unit == Degrees {
+		return math.Mod(angle, 360) * math.Pi / 180
+	}
+	return angle
+}
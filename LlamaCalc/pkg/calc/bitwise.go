@@ -0,0 +1,101 @@
+package calc
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MaxExactInteger is the largest integer up to which every integer has an
+// exact float64 representation. Bitwise operations on real numbers accept and
+// return integers of at most this magnitude.
+const MaxExactInteger = 1 << 53
+
+// bitwiseValidate checks that every argument of op is an exactly
+// representable integer and that shift counts are between 0 and 63
+func bitwiseValidate(op *Operation) func(args []float64) error {
+	shift := op.Name == "Shl" || op.Name == "Shr"
+	return func(args []float64) error {
+		for i, x := range args {
+			if !isInteger(x) || x > MaxExactInteger || x < -MaxExactInteger {
+				return &FieldError{
+					Field: op.Param(i),
+					Err:   fmt.Errorf("%w: bitwise operations need integers between -2^53 and 2^53", ErrInvalidInput),
+				}
+			}
+		}
+		if shift && (args[1] < 0 || args[1] > 63) {
+			return &FieldError{Field: "n", Err: fmt.Errorf("%w: shift count must be between 0 and 63", ErrInvalidInput)}
+		}
+		return nil
+	}
+}
+
+// bitwise adapts an operation on two's-complement 64-bit integers. Results
+// beyond MaxExactInteger are reported as overflow or underflow.
+func bitwise(fn func(args []int64) int64) Func {
+	return func(args []float64) (float64, error) {
+		ints := make([]int64, len(args))
+		for i, x := range args {
+			ints[i] = int64(x)
+		}
+
+		result := fn(ints)
+		switch {
+		case result > MaxExactInteger:
+			return 0, ErrOverflow
+		case result < -MaxExactInteger:
+			return 0, ErrUnderflow
+		}
+		return float64(result), nil
+	}
+}
+
+// bitwiseOperations returns the bitwise operations on integer-valued real
+// numbers, which are also available as the operators << >> & | and ~ in
+// expressions. Negative numbers are treated as 64-bit two's complement.
+func bitwiseOperations() []*Operation {
+	ops := []*Operation{
+		{OperationInfo: signature("And", "a", "b"), Func: bitwise(func(args []int64) int64 {
+			return args[0] & args[1]
+		})},
+		{OperationInfo: signature("Or", "a", "b"), Func: bitwise(func(args []int64) int64 {
+			return args[0] | args[1]
+		})},
+		{OperationInfo: signature("Xor", "a", "b"), Func: bitwise(func(args []int64) int64 {
+			return args[0] ^ args[1]
+		})},
+		{OperationInfo: signature("Not", "a"), Func: bitwise(func(args []int64) int64 {
+			return ^args[0]
+		})},
+		{OperationInfo: signature("Shl", "a", "n"), Func: func(args []float64) (float64, error) {
+			// Shifting out significant bits overflows regardless of
+			// MaxExactInteger
+			a, n := int64(args[0]), uint(args[1])
+			magnitude := a
+			if a < 0 {
+				magnitude = ^a
+			}
+			if bits.Len64(uint64(magnitude))+int(n) > 63 {
+				if a < 0 {
+					return 0, ErrUnderflow
+				}
+				return 0, ErrOverflow
+			}
+			return bitwise(func(args []int64) int64 { return a << n })(args)
+		}},
+		{OperationInfo: signature("Shr", "a", "n"), Func: bitwise(func(args []int64) int64 {
+			return args[0] >> uint(args[1])
+		})},
+		{OperationInfo: signature("PopCount", "a"), Func: bitwise(func(args []int64) int64 {
+			return int64(bits.OnesCount64(uint64(args[0])))
+		})},
+	}
+
+	for _, op := range ops {
+		op.Validate = bitwiseValidate(op)
+		op.Role = RoleUser
+		op.Module = "bitwise"
+	}
+
+	return ops
+}
@@ -3,16 +3,143 @@ package calc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
+	"strconv"
 	"time"
 )
 
 // Common errors
 var (
-	ErrDivideByZero = errors.New("division by zero")
-	ErrOverflow     = errors.New("numeric overflow")
-	ErrUnderflow    = errors.New("numeric underflow")
-	ErrInvalidInput = errors.New("invalid input")
+	ErrDivideByZero      = errors.New("division by zero")
+	ErrOverflow          = errors.New("numeric overflow")
+	ErrUnderflow         = errors.New("numeric underflow")
+	ErrInvalidInput      = errors.New("invalid input")
+	ErrDomain            = errors.New("argument outside the domain of the function")
+	ErrSingular          = errors.New("matrix is singular or ill-conditioned")
+	ErrIncompatibleUnits = errors.New("incompatible units")
+	ErrCurrencyMismatch  = errors.New("currency mismatch")
+	ErrNotConverged      = errors.New("iteration did not converge")
+)
+
+// calcErrors are the errors reported to clients with their own error kind
+var calcErrors = []error{ErrInvalidInput, ErrDivideByZero, ErrOverflow, ErrUnderflow, ErrUnknownOperation, ErrDomain, ErrSingular, ErrIncompatibleUnits, ErrCurrencyMismatch, ErrNotConverged}
+
+// FieldError associates an error with the input field that caused it
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// Error implements error
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ConvergenceError reports an iterative method that did not converge
+type ConvergenceError struct {
+	// Iterations is the number of iterations performed
+	Iterations int
+	// Estimate is the last estimate of the solution
+	Estimate float64
+	// Residual is the value of the function at Estimate
+	Residual float64
+}
+
+// Error implements error
+func (e *ConvergenceError) Error() string {
+	return fmt.Sprintf("%v after %d iterations (estimate %.6g, residual %.3g)", ErrNotConverged, e.Iterations, e.Estimate, e.Residual)
+}
+
+// Unwrap returns ErrNotConverged
+func (e *ConvergenceError) Unwrap() error {
+	return ErrNotConverged
+}
+
+// Metadata returns the convergence details for error details
+func (e *ConvergenceError) Metadata() map[string]string {
+	return map[string]string{
+		"iterations": strconv.Itoa(e.Iterations),
+		"estimate":   strconv.FormatFloat(e.Estimate, 'g', -1, 64),
+		"residual":   strconv.FormatFloat(e.Residual, 'g', 6, 64),
+	}
+}
+
+// Default engine settings used by NewDefaultCalculator
+const (
+	DefaultMaxPrecision     = 10
+	DefaultMaxDecimalPlaces = 10
+)
+
+// Engine performs calculations on real numbers. Servers, clients and tools
+// delegate to an Engine instead of implementing arithmetic themselves, so
+// that validation, overflow checking and rounding behave identically
+// everywhere.
+//
+// Engines supporting further modes also implement the interfaces of those
+// modes, e.g. ComplexEngine; users check for them with a type assertion.
+// Calculator implements all of them.
+type Engine interface {
+	// Calculate performs the named two-argument operation, e.g. "Add" or "divide"
+	Calculate(ctx context.Context, op string, a, b float64) CalculationResult
+	// Invoke performs the named operation on any number of arguments
+	Invoke(ctx context.Context, op string, args ...float64) CalculationResult
+	// Evaluate evaluates an arithmetic expression such as "2 * sin(pi / 4)"
+	Evaluate(ctx context.Context, expression string) CalculationResult
+
+	Add(ctx context.Context, a, b float64) CalculationResult
+	Subtract(ctx context.Context, a, b float64) CalculationResult
+	Multiply(ctx context.Context, a, b float64) CalculationResult
+	Divide(ctx context.Context, a, b float64) CalculationResult
+}
+
+// ComplexEngine performs calculations on complex numbers
+type ComplexEngine interface {
+	// Complex performs the named operation on complex numbers
+	Complex(ctx context.Context, op string, args ...complex128) ComplexResult
+}
+
+// RationalEngine performs exact calculations on fractions
+type RationalEngine interface {
+	// Rational performs the named operation exactly on fractions such as "1/3"
+	Rational(ctx context.Context, op string, args ...string) RationalResult
+}
+
+// IntegerEngine performs calculations on integers of any size
+type IntegerEngine interface {
+	// Integer performs the named operation on decimal integers of any size
+	Integer(ctx context.Context, op string, args ...string) IntegerResult
+}
+
+// UnitEngine performs calculations on quantities with units
+type UnitEngine interface {
+	// InvokeUnits performs the named operation on quantities with units
+	InvokeUnits(ctx context.Context, op string, args ...Quantity) CalculationResult
+	// Convert converts a value between units of the same dimension
+	Convert(ctx context.Context, value float64, from, to string) CalculationResult
+}
+
+// MoneyEngine performs calculations on amounts of money
+type MoneyEngine interface {
+	// Money performs the named operation on amounts of money
+	Money(ctx context.Context, op string, amounts []Money, numbers []Decimal, mode RoundingMode) MoneyResult
+	// ConvertCurrency converts an amount of money with the rate table
+	ConvertCurrency(ctx context.Context, amount Money, to string, mode RoundingMode) CurrencyResult
+}
+
+// Calculator implements every mode
+var (
+	_ Engine         = (*Calculator)(nil)
+	_ ComplexEngine  = (*Calculator)(nil)
+	_ RationalEngine = (*Calculator)(nil)
+	_ IntegerEngine  = (*Calculator)(nil)
+	_ UnitEngine     = (*Calculator)(nil)
+	_ MoneyEngine    = (*Calculator)(nil)
 )
 
 // Calculator represents the calculator service
@@ -21,11 +148,22 @@ type Calculator struct {
 	MaxPrecision     int
 	MaxDecimalPlaces int
 	CheckOverflow    bool
+
+	// Registry holds the operations available through Calculate, Invoke,
+	// Complex, Rational and Integer
+	Registry *Registry
+	// Units holds the units available to InvokeUnits and Convert
+	Units *UnitRegistry
+	// Rates holds the exchange rates used by ConvertCurrency, if any
+	Rates *RateTable
 }
 
 // CalculationResult contains the result of a calculation
 type CalculationResult struct {
-	Value     float64
+	Value float64
+	// Unit is the unit of Value for calculations with units; it is empty
+	// for dimensionless results
+	Unit      string
 	Duration  time.Duration
 	Operation string
 	Error     error
@@ -37,80 +175,52 @@ func NewCalculator(maxPrecision, maxDecimalPlaces int, checkOverflow bool) *Calc
 		MaxPrecision:     maxPrecision,
 		MaxDecimalPlaces: maxDecimalPlaces,
 		CheckOverflow:    checkOverflow,
+		Registry:         DefaultRegistry(),
+		Units:            DefaultUnits(),
 	}
 }
 
+// NewDefaultCalculator creates a calculator with the default settings and
+// overflow checking enabled
+func NewDefaultCalculator() *Calculator {
+	return NewCalculator(DefaultMaxPrecision, DefaultMaxDecimalPlaces, true)
+}
+
 // Add performs addition with error handling and metrics
 func (c *Calculator) Add(ctx context.Context, a, b float64) CalculationResult {
-	start := time.Now()
+	return c.Calculate(ctx, "Add", a, b)
+}
 
-	// Validate inputs
-	if !c.validateInput(a) || !c.validateInput(b) {
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Add",
-			Error:     ErrInvalidInput,
-		}
-	}
+// Subtract performs subtraction with error handling and metrics
+func (c *Calculator) Subtract(ctx context.Context, a, b float64) CalculationResult {
+	return c.Calculate(ctx, "Subtract", a, b)
+}
 
-	// Perform calculation
-	result := a + b
-
-	// Check for overflow/underflow
-	if c.CheckOverflow && !c.validateInput(result) {
-		var err error
-		if result > 0 {
-			err = ErrOverflow
-		} else {
-			err = ErrUnderflow
-		}
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Add",
-			Error:     err,
-		}
-	}
+// Multiply performs multiplication with error handling and metrics
+func (c *Calculator) Multiply(ctx context.Context, a, b float64) CalculationResult {
+	return c.Calculate(ctx, "Multiply", a, b)
+}
 
-	// Return result
-	return CalculationResult{
-		Value:     c.roundToPrecision(result),
-		Duration:  time.Since(start),
-		Operation: "Add",
-		Error:     nil,
-	}
+// Divide performs division with error handling and metrics
+func (c *Calculator) Divide(ctx context.Context, a, b float64) CalculationResult {
+	return c.Calculate(ctx, "Divide", a, b)
 }
 
-// Subtract performs subtraction with error handling and metrics
-func (c *Calculator) Subtract(ctx context.Context, a, b float64) CalculationResult {
-	start := time.Now()
+// Calculate performs the named two-argument operation from the registry
+func (c *Calculator) Calculate(ctx context.Context, name string, a, b float64) CalculationResult {
+	return c.Invoke(ctx, name, a, b)
+}
 
-	// Validate inputs
-	if !c.validateInput(a) || !c.validateInput(b) {
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Subtract",
-			Error:     ErrInvalidInput,
-		}
-	}
+// Invoke performs the named operation from the registry on args
+func (c *Calculator) Invoke(ctx context.Context, name string, args ...float64) CalculationResult {
+	start := time.Now()
 
-	// Perform calculation
-	result := a - b
-
-	// Check for overflow/underflow
-	if c.CheckOverflow && !c.validateInput(result) {
-		var err error
-		if result > 0 {
-			err = ErrOverflow
-		} else {
-			err = ErrUnderflow
-		}
+	result, operation, err := c.apply(ctx, name, args)
+	if err != nil {
 		return CalculationResult{
 			Value:     0,
 			Duration:  time.Since(start),
-			Operation: "Subtract",
+			Operation: operation,
 			Error:     err,
 		}
 	}
@@ -119,103 +229,88 @@ func (c *Calculator) Subtract(ctx context.Context, a, b float64) CalculationResu
 	return CalculationResult{
 		Value:     c.roundToPrecision(result),
 		Duration:  time.Since(start),
-		Operation: "Subtract",
+		Operation: operation,
 		Error:     nil,
 	}
 }
 
-// Multiply performs multiplication with error handling and metrics
-func (c *Calculator) Multiply(ctx context.Context, a, b float64) CalculationResult {
-	start := time.Now()
+// apply performs the named operation without rounding the result. It
+// returns the display name of the operation alongside the result.
+func (c *Calculator) apply(ctx context.Context, name string, args []float64) (float64, string, error) {
+	op, ok := c.Registry.Lookup(name)
+	if !ok {
+		return 0, name, &FieldError{Field: "operation", Err: ErrUnknownOperation}
+	}
 
 	// Validate inputs
-	if !c.validateInput(a) || !c.validateInput(b) {
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Multiply",
-			Error:     ErrInvalidInput,
-		}
+	if err := c.validateArgs(op, args); err != nil {
+		return 0, op.Name, err
+	}
+
+	// Stop if the caller has given up
+	if err := ctx.Err(); err != nil {
+		return 0, op.Name, err
 	}
 
 	// Perform calculation
-	result := a * b
-
-	// Check for overflow/underflow
-	if c.CheckOverflow && !c.validateInput(result) {
-		var err error
-		if result > 0 {
-			err = ErrOverflow
-		} else {
-			err = ErrUnderflow
-		}
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Multiply",
-			Error:     err,
-		}
+	unit := AngleUnitFromContext(ctx)
+	if op.Angle == AngleArgs && unit == Degrees {
+		args = toRadians(args)
 	}
 
-	// Return result
-	return CalculationResult{
-		Value:     c.roundToPrecision(result),
-		Duration:  time.Since(start),
-		Operation: "Multiply",
-		Error:     nil,
+	result, err := op.Func(args)
+	if err == nil {
+		err = c.checkResult(result)
 	}
+	if err != nil {
+		return 0, op.Name, err
+	}
+
+	if op.Angle == AngleResult {
+		result = fromRadians(unit, result)
+	}
+
+	return result, op.Name, nil
 }
 
-// Divide performs division with error handling and metrics
-func (c *Calculator) Divide(ctx context.Context, a, b float64) CalculationResult {
-	start := time.Now()
+// checkResult reports overflow or underflow if overflow checking is enabled
+func (c *Calculator) checkResult(result float64) error {
+	if !c.CheckOverflow || c.validateInput(result) {
+		return nil
+	}
+	if result > 0 {
+		return ErrOverflow
+	}
+	return ErrUnderflow
+}
 
-	// Validate inputs
-	if !c.validateInput(a) || !c.validateInput(b) {
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Divide",
-			Error:     ErrInvalidInput,
-		}
+// validateArgs checks the number and values of args, then runs the
+// operation's own validation, and reports the first problem found
+func (c *Calculator) validateArgs(op *Operation, args []float64) error {
+	if err := op.checkArity(len(args)); err != nil {
+		return err
 	}
 
-	// Check for division by zero
-	if b == 0 {
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Divide",
-			Error:     ErrDivideByZero,
+	for i, arg := range args {
+		if !c.validateInput(arg) {
+			return &FieldError{Field: op.Param(i), Err: ErrInvalidInput}
 		}
 	}
 
-	// Perform calculation
-	result := a / b
-
-	// Check for overflow/underflow
-	if c.CheckOverflow && !c.validateInput(result) {
-		var err error
-		if result > 0 {
-			err = ErrOverflow
-		} else {
-			err = ErrUnderflow
-		}
-		return CalculationResult{
-			Value:     0,
-			Duration:  time.Since(start),
-			Operation: "Divide",
-			Error:     err,
-		}
+	if op.Validate == nil {
+		return nil
 	}
 
-	// Return result
-	return CalculationResult{
-		Value:     c.roundToPrecision(result),
-		Duration:  time.Since(start),
-		Operation: "Divide",
-		Error:     nil,
+	err := op.Validate(args)
+	if err == nil {
+		return nil
+	}
+	for _, known := range calcErrors {
+		if errors.Is(err, known) {
+			return err
+		}
 	}
+	return fmt.Errorf("%w: %v", ErrInvalidInput, err)
 }
 
 // validateInput checks if a number is valid (not NaN or Infinity)
@@ -223,8 +318,12 @@ func (c *Calculator) validateInput(value float64) bool {
 	return !math.IsNaN(value) && !math.IsInf(value, 0)
 }
 
-// roundToPrecision rounds a value to the configured precision
+// roundToPrecision rounds a value to the configured precision. Values too
+// large to have the configured decimal places are returned unchanged.
 func (c *Calculator) roundToPrecision(value float64) float64 {
 	multiplier := math.Pow10(c.MaxDecimalPlaces)
+	if math.IsInf(value*multiplier, 0) {
+		return value
+	}
 	return math.Round(value*multiplier) / multiplier
 }
@@ -0,0 +1,196 @@
+package calc
+
+import (
+	"context"
+	"math/cmplx"
+	"time"
+)
+
+// ComplexFunc computes the raw result of a complex operation. Functions that
+// take or return angles read the angle unit from ctx.
+type ComplexFunc func(ctx context.Context, args []complex128) (complex128, error)
+
+// ComplexOperation is a named calculation on complex numbers
+type ComplexOperation struct {
+	OperationInfo
+	// Func computes the result
+	Func ComplexFunc
+}
+
+// registration implements registrable
+func (op *ComplexOperation) registration() (*OperationInfo, bool) {
+	if op == nil {
+		return nil, false
+	}
+	return &op.OperationInfo, op.Func != nil
+}
+
+// ComplexResult contains the result of a complex calculation
+type ComplexResult struct {
+	Value     complex128
+	Duration  time.Duration
+	Operation string
+	Error     error
+}
+
+// Complex performs the named complex operation from the registry on args.
+// Both parts of the arguments must be finite; each part of the result is
+// checked for overflow and rounded like a real result.
+func (c *Calculator) Complex(ctx context.Context, name string, args ...complex128) ComplexResult {
+	start := time.Now()
+
+	result, operation, err := c.applyComplex(ctx, name, args)
+	if err != nil {
+		return ComplexResult{
+			Value:     0,
+			Duration:  time.Since(start),
+			Operation: operation,
+			Error:     err,
+		}
+	}
+
+	return ComplexResult{
+		Value:     complex(c.roundToPrecision(real(result)), c.roundToPrecision(imag(result))),
+		Duration:  time.Since(start),
+		Operation: operation,
+		Error:     nil,
+	}
+}
+
+// applyComplex performs the named complex operation without rounding the result
+func (c *Calculator) applyComplex(ctx context.Context, name string, args []complex128) (complex128, string, error) {
+	op, ok := c.Registry.LookupComplex(name)
+	if !ok {
+		return 0, name, &FieldError{Field: "operation", Err: ErrUnknownOperation}
+	}
+
+	// Validate inputs
+	if err := op.checkArity(len(args)); err != nil {
+		return 0, op.Name, err
+	}
+	for i, arg := range args {
+		if !c.validateInput(real(arg)) {
+			return 0, op.Name, &FieldError{Field: op.Param(i) + ".real", Err: ErrInvalidInput}
+		}
+		if !c.validateInput(imag(arg)) {
+			return 0, op.Name, &FieldError{Field: op.Param(i) + ".imag", Err: ErrInvalidInput}
+		}
+	}
+
+	// Stop if the caller has given up
+	if err := ctx.Err(); err != nil {
+		return 0, op.Name, err
+	}
+
+	// Perform calculation
+	result, err := op.Func(ctx, args)
+	if err == nil {
+		err = c.checkComplexResult(result)
+	}
+	if err != nil {
+		return 0, op.Name, err
+	}
+
+	return result, op.Name, nil
+}
+
+// checkComplexResult checks both parts of result for overflow. A NaN part
+// can only come from an intermediate infinity, e.g. Inf - Inf when
+// multiplying large numbers, so it is reported as overflow.
+func (c *Calculator) checkComplexResult(result complex128) error {
+	if !c.CheckOverflow {
+		return nil
+	}
+	if cmplx.IsNaN(result) {
+		return ErrOverflow
+	}
+	if err := c.checkResult(real(result)); err != nil {
+		return err
+	}
+	return c.checkResult(imag(result))
+}
+
+// unaryComplex adapts a one-argument function that is defined everywhere
+func unaryComplex(fn func(z complex128) complex128) ComplexFunc {
+	return func(ctx context.Context, args []complex128) (complex128, error) {
+		return fn(args[0]), nil
+	}
+}
+
+// binaryComplex adapts a two-argument function
+func binaryComplex(fn func(z, w complex128) (complex128, error)) ComplexFunc {
+	return func(ctx context.Context, args []complex128) (complex128, error) {
+		return fn(args[0], args[1])
+	}
+}
+
+// complexPow returns z**w. Like the real power, zero cannot be raised to a
+// power with a negative real part.
+func complexPow(z, w complex128) (complex128, error) {
+	if z == 0 && w != 0 {
+		switch {
+		case real(w) < 0:
+			return 0, &FieldError{Field: "z", Err: ErrDivideByZero}
+		case real(w) == 0:
+			return 0, domainError("z")
+		}
+	}
+	return cmplx.Pow(z, w), nil
+}
+
+// complexOperations returns the arithmetic, polar and transcendental
+// operations on complex numbers. Polar coordinates are carried in a complex
+// value as modulus + i*argument, with the argument in the angle unit of ctx.
+func complexOperations() []*ComplexOperation {
+	ops := []*ComplexOperation{
+		{OperationInfo: signature("Add", "z", "w"), Func: binaryComplex(func(z, w complex128) (complex128, error) {
+			return z + w, nil
+		})},
+		{OperationInfo: signature("Subtract", "z", "w"), Func: binaryComplex(func(z, w complex128) (complex128, error) {
+			return z - w, nil
+		})},
+		{OperationInfo: signature("Multiply", "z", "w"), Func: binaryComplex(func(z, w complex128) (complex128, error) {
+			return z * w, nil
+		})},
+		{OperationInfo: signature("Divide", "z", "w"), Func: binaryComplex(func(z, w complex128) (complex128, error) {
+			if w == 0 {
+				return 0, &FieldError{Field: "w", Err: ErrDivideByZero}
+			}
+			return z / w, nil
+		})},
+		{OperationInfo: signature("Conjugate", "z"), Func: unaryComplex(cmplx.Conj)},
+		{OperationInfo: signature("Modulus", "z"), Func: unaryComplex(func(z complex128) complex128 {
+			return complex(cmplx.Abs(z), 0)
+		})},
+		{OperationInfo: signature("Argument", "z"), Func: func(ctx context.Context, args []complex128) (complex128, error) {
+			return complex(fromRadians(AngleUnitFromContext(ctx), cmplx.Phase(args[0])), 0), nil
+		}},
+		{OperationInfo: signature("Polar", "z"), Func: func(ctx context.Context, args []complex128) (complex128, error) {
+			r, theta := cmplx.Polar(args[0])
+			return complex(r, fromRadians(AngleUnitFromContext(ctx), theta)), nil
+		}},
+		{OperationInfo: signature("Rect", "polar"), Func: func(ctx context.Context, args []complex128) (complex128, error) {
+			r, theta := real(args[0]), angleToRadians(AngleUnitFromContext(ctx), imag(args[0]))
+			if r < 0 {
+				return 0, domainError("polar.real")
+			}
+			return cmplx.Rect(r, theta), nil
+		}},
+		{OperationInfo: signature("Exp", "z"), Func: unaryComplex(cmplx.Exp)},
+		{OperationInfo: signature("Log", "z"), Func: func(ctx context.Context, args []complex128) (complex128, error) {
+			if args[0] == 0 {
+				return 0, domainError("z")
+			}
+			return cmplx.Log(args[0]), nil
+		}},
+		{OperationInfo: signature("Pow", "z", "w"), Func: binaryComplex(complexPow)},
+		{OperationInfo: signature("Sqrt", "z"), Func: unaryComplex(cmplx.Sqrt)},
+	}
+
+	for _, op := range ops {
+		op.Role = RoleUser
+		op.Module = "complex"
+	}
+
+	return ops
+}
@@ -0,0 +1,903 @@
+// Package conformance contains test vectors that every calculation engine
+// implementation must agree on, and a runner that checks an implementation
+// against them.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/cmplx"
+	"strings"
+	"testing"
+
+	"llamacalc/pkg/calc"
+)
+
+// Vector is a single conformance case
+type Vector struct {
+	Name string
+	Op   string
+	A, B float64
+	// Want is the expected result when Err is nil
+	Want float64
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// Vectors are the cases every implementation must pass. Results assume the
+// default engine settings (calc.DefaultMaxDecimalPlaces decimal places and
+// overflow checking enabled).
+var Vectors = []Vector{
+	{Name: "add integers", Op: "Add", A: 2, B: 3, Want: 5},
+	{Name: "add rounds binary error", Op: "Add", A: 0.1, B: 0.2, Want: 0.3},
+	{Name: "add negatives", Op: "Add", A: -1.5, B: -2.25, Want: -3.75},
+	{Name: "add overflow", Op: "Add", A: math.MaxFloat64, B: math.MaxFloat64, Err: calc.ErrOverflow},
+	{Name: "add underflow", Op: "Add", A: -math.MaxFloat64, B: -math.MaxFloat64, Err: calc.ErrUnderflow},
+	{Name: "add NaN", Op: "Add", A: math.NaN(), B: 1, Err: calc.ErrInvalidInput},
+	{Name: "add infinity", Op: "Add", A: 1, B: math.Inf(1), Err: calc.ErrInvalidInput},
+
+	{Name: "subtract", Op: "Subtract", A: 10, B: 4, Want: 6},
+	{Name: "subtract to negative", Op: "Subtract", A: 1, B: 2.5, Want: -1.5},
+	{Name: "subtract rounds binary error", Op: "Subtract", A: 0.3, B: 0.1, Want: 0.2},
+	{Name: "subtract underflow", Op: "Subtract", A: -math.MaxFloat64, B: math.MaxFloat64, Err: calc.ErrUnderflow},
+	{Name: "subtract negative infinity", Op: "Subtract", A: math.Inf(-1), B: 1, Err: calc.ErrInvalidInput},
+
+	{Name: "multiply", Op: "Multiply", A: 6, B: 7, Want: 42},
+	{Name: "multiply by zero", Op: "Multiply", A: 123.456, B: 0, Want: 0},
+	{Name: "multiply signs", Op: "Multiply", A: -2.5, B: 4, Want: -10},
+	{Name: "multiply overflow", Op: "Multiply", A: 1e308, B: 10, Err: calc.ErrOverflow},
+	{Name: "multiply underflow", Op: "Multiply", A: -1e308, B: 10, Err: calc.ErrUnderflow},
+	{Name: "multiply tiny rounds to zero", Op: "Multiply", A: 1e-200, B: 1e-200, Want: 0},
+
+	{Name: "divide", Op: "Divide", A: 20, B: 5, Want: 4},
+	{Name: "divide repeating", Op: "Divide", A: 1, B: 3, Want: 0.3333333333},
+	{Name: "divide negative", Op: "Divide", A: -9, B: 2, Want: -4.5},
+	{Name: "divide by zero", Op: "Divide", A: 1, B: 0, Err: calc.ErrDivideByZero},
+	{Name: "divide zero by zero", Op: "Divide", A: 0, B: 0, Err: calc.ErrDivideByZero},
+	{Name: "divide overflow", Op: "Divide", A: 1e308, B: 1e-10, Err: calc.ErrOverflow},
+	{Name: "divide NaN", Op: "Divide", A: 1, B: math.NaN(), Err: calc.ErrInvalidInput},
+
+	{Name: "pow", Op: "Pow", A: 2, B: 10, Want: 1024},
+	{Name: "pow negative base integer exponent", Op: "Pow", A: -2, B: 3, Want: -8},
+	{Name: "pow negative base fractional exponent", Op: "Pow", A: -8, B: 1.0 / 3, Err: calc.ErrDomain},
+	{Name: "pow zero to negative power", Op: "Pow", A: 0, B: -1, Err: calc.ErrDivideByZero},
+	{Name: "pow overflow", Op: "Pow", A: 10, B: 400, Err: calc.ErrOverflow},
+	{Name: "nth root", Op: "NthRoot", A: 27, B: 3, Want: 3},
+	{Name: "odd root of negative", Op: "NthRoot", A: -32, B: 5, Want: -2},
+	{Name: "even root of negative", Op: "NthRoot", A: -16, B: 4, Err: calc.ErrDomain},
+	{Name: "log base", Op: "LogBase", A: 8, B: 2, Want: 3},
+	{Name: "log of zero", Op: "LogBase", A: 0, B: 10, Err: calc.ErrDomain},
+	{Name: "log base one", Op: "LogBase", A: 5, B: 1, Err: calc.ErrDomain},
+	{Name: "mod", Op: "Mod", A: 7.5, B: 2, Want: 1.5},
+	{Name: "mod keeps sign of dividend", Op: "Mod", A: -7, B: 3, Want: -1},
+	{Name: "mod by zero", Op: "Mod", A: 1, B: 0, Err: calc.ErrDivideByZero},
+
+	{Name: "case-insensitive name", Op: "add", A: 1, B: 1, Want: 2},
+	{Name: "unknown operation", Op: "Modulo", A: 1, B: 1, Err: calc.ErrUnknownOperation},
+}
+
+// CalculateFunc performs the named operation on an implementation under test
+type CalculateFunc func(ctx context.Context, op string, a, b float64) (float64, error)
+
+// FromEngine adapts a calc.Engine to a CalculateFunc
+func FromEngine(engine calc.Engine) CalculateFunc {
+	return func(ctx context.Context, op string, a, b float64) (float64, error) {
+		result := engine.Calculate(ctx, op, a, b)
+		return result.Value, result.Error
+	}
+}
+
+// Run checks calculate against all Vectors. Implementations that cannot
+// express an operation (e.g. a fixed set of RPCs) should return
+// calc.ErrUnknownOperation for it; such vectors are skipped.
+func Run(t *testing.T, calculate CalculateFunc) {
+	t.Helper()
+
+	for _, v := range Vectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := calculate(context.Background(), v.Op, v.A, v.B)
+			if v.Err != calc.ErrUnknownOperation && errors.Is(err, calc.ErrUnknownOperation) {
+				t.Skipf("%s is not supported", v.Op)
+			}
+
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s(%g, %g): got error %v, want %v", v.Op, v.A, v.B, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s(%g, %g): unexpected error %v", v.Op, v.A, v.B, err)
+			}
+			if got != v.Want {
+				t.Fatalf("%s(%g, %g) = %v, want %v", v.Op, v.A, v.B, got, v.Want)
+			}
+		})
+	}
+}
+
+// ScientificVector is a single conformance case for the scientific
+// operations, which may take one argument
+type ScientificVector struct {
+	Name string
+	Op   string
+	Args []float64
+	// Degrees runs the case with angles in degrees
+	Degrees bool
+	// Want is the expected result when Err is nil
+	Want float64
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// ScientificVectors are the scientific cases every implementation must pass,
+// under the same engine settings as Vectors
+var ScientificVectors = []ScientificVector{
+	{Name: "sqrt", Op: "Sqrt", Args: []float64{2}, Want: 1.4142135624},
+	{Name: "sqrt of negative", Op: "Sqrt", Args: []float64{-1}, Err: calc.ErrDomain},
+	{Name: "sqrt arity", Op: "Sqrt", Args: []float64{4, 2}, Err: calc.ErrInvalidInput},
+	{Name: "ln", Op: "Ln", Args: []float64{math.E}, Want: 1},
+	{Name: "ln of zero", Op: "Ln", Args: []float64{0}, Err: calc.ErrDomain},
+	{Name: "ln of negative", Op: "Ln", Args: []float64{-1}, Err: calc.ErrDomain},
+	{Name: "log10", Op: "Log10", Args: []float64{1000}, Want: 3},
+	{Name: "log10 of zero", Op: "Log10", Args: []float64{0}, Err: calc.ErrDomain},
+	{Name: "acosh", Op: "Acosh", Args: []float64{1}, Want: 0},
+	{Name: "acosh below one", Op: "Acosh", Args: []float64{0.5}, Err: calc.ErrDomain},
+	{Name: "atanh", Op: "Atanh", Args: []float64{0.5}, Want: 0.5493061443},
+	{Name: "atanh of one", Op: "Atanh", Args: []float64{1}, Err: calc.ErrDomain},
+	{Name: "atanh of minus one", Op: "Atanh", Args: []float64{-1}, Err: calc.ErrDomain},
+
+	{Name: "sin", Op: "Sin", Args: []float64{math.Pi / 6}, Want: 0.5},
+	{Name: "tan pole", Op: "Tan", Args: []float64{math.Pi / 2}, Err: calc.ErrDomain},
+	{Name: "asin", Op: "Asin", Args: []float64{1}, Want: 1.5707963268},
+	{Name: "asin out of range", Op: "Asin", Args: []float64{1.5}, Err: calc.ErrDomain},
+	{Name: "sin degrees", Op: "Sin", Args: []float64{30}, Degrees: true, Want: 0.5},
+	{Name: "sin of whole turns", Op: "Sin", Args: []float64{720}, Degrees: true, Want: 0},
+	{Name: "cos degrees", Op: "Cos", Args: []float64{60}, Degrees: true, Want: 0.5},
+	{Name: "tan degrees", Op: "Tan", Args: []float64{45}, Degrees: true, Want: 1},
+	{Name: "tan pole degrees", Op: "Tan", Args: []float64{90}, Degrees: true, Err: calc.ErrDomain},
+	{Name: "tan negative pole degrees", Op: "Tan", Args: []float64{-270}, Degrees: true, Err: calc.ErrDomain},
+	{Name: "asin degrees", Op: "Asin", Args: []float64{0.5}, Degrees: true, Want: 30},
+	{Name: "acos degrees", Op: "Acos", Args: []float64{-1}, Degrees: true, Want: 180},
+	{Name: "atan degrees", Op: "Atan", Args: []float64{1}, Degrees: true, Want: 45},
+	{Name: "acos out of range degrees", Op: "Acos", Args: []float64{2}, Degrees: true, Err: calc.ErrDomain},
+	{Name: "sinh ignores degrees", Op: "Sinh", Args: []float64{0}, Degrees: true, Want: 0},
+}
+
+// ScientificFunc performs the named operation on arguments on an
+// implementation under test
+type ScientificFunc func(ctx context.Context, op string, args ...float64) (float64, error)
+
+// ScientificFromEngine adapts a calc.Engine to a ScientificFunc
+func ScientificFromEngine(engine calc.Engine) ScientificFunc {
+	return func(ctx context.Context, op string, args ...float64) (float64, error) {
+		result := engine.Invoke(ctx, op, args...)
+		return result.Value, result.Error
+	}
+}
+
+// RunScientific checks calculate against all ScientificVectors
+func RunScientific(t *testing.T, calculate ScientificFunc) {
+	t.Helper()
+
+	for _, v := range ScientificVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := context.Background()
+			if v.Degrees {
+				ctx = calc.WithAngleUnit(ctx, calc.Degrees)
+			}
+
+			got, err := calculate(ctx, v.Op, v.Args...)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%v: got error %v, want %v", v.Op, v.Args, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%v: unexpected error %v", v.Op, v.Args, err)
+			}
+			if got != v.Want {
+				t.Fatalf("%s%v = %v, want %v", v.Op, v.Args, got, v.Want)
+			}
+		})
+	}
+}
+
+// ComplexVector is a single conformance case for complex operations
+type ComplexVector struct {
+	Name string
+	Op   string
+	Args []complex128
+	// Degrees runs the case with angles in degrees
+	Degrees bool
+	// Want is the expected result when Err is nil
+	Want complex128
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// ComplexVectors are the complex cases every implementation must pass, under
+// the same engine settings as Vectors
+var ComplexVectors = []ComplexVector{
+	{Name: "complex add", Op: "Add", Args: []complex128{1 + 2i, 3 - 1i}, Want: 4 + 1i},
+	{Name: "complex subtract", Op: "Subtract", Args: []complex128{1 + 2i, 3 - 1i}, Want: -2 + 3i},
+	{Name: "complex multiply", Op: "Multiply", Args: []complex128{1 + 2i, 3 - 1i}, Want: 5 + 5i},
+	{Name: "complex multiply i by i", Op: "Multiply", Args: []complex128{1i, 1i}, Want: -1},
+	{Name: "complex multiply overflow", Op: "Multiply", Args: []complex128{complex(1e308, 0), 10}, Err: calc.ErrOverflow},
+	{Name: "complex multiply imaginary underflow", Op: "Multiply", Args: []complex128{complex(0, -1e308), 10}, Err: calc.ErrUnderflow},
+	{Name: "complex divide", Op: "Divide", Args: []complex128{5 + 5i, 1 + 2i}, Want: 3 - 1i},
+	{Name: "complex divide by zero", Op: "Divide", Args: []complex128{1 + 1i, 0}, Err: calc.ErrDivideByZero},
+	{Name: "complex NaN", Op: "Add", Args: []complex128{complex(0, math.NaN()), 1}, Err: calc.ErrInvalidInput},
+	{Name: "complex infinity", Op: "Add", Args: []complex128{cmplx.Inf(), 1}, Err: calc.ErrInvalidInput},
+	{Name: "conjugate", Op: "Conjugate", Args: []complex128{3 + 4i}, Want: 3 - 4i},
+	{Name: "modulus", Op: "Modulus", Args: []complex128{3 + 4i}, Want: 5},
+	{Name: "argument", Op: "Argument", Args: []complex128{-1 + 1i}, Degrees: true, Want: 135},
+	{Name: "polar", Op: "Polar", Args: []complex128{1i}, Degrees: true, Want: 1 + 90i},
+	{Name: "rect", Op: "Rect", Args: []complex128{2 + 90i}, Degrees: true, Want: 2i},
+	{Name: "rect negative modulus", Op: "Rect", Args: []complex128{-2}, Err: calc.ErrDomain},
+	{Name: "euler identity", Op: "Exp", Args: []complex128{complex(0, math.Pi)}, Want: -1},
+	{Name: "complex exp overflow", Op: "Exp", Args: []complex128{1000}, Err: calc.ErrOverflow},
+	{Name: "complex log of negative", Op: "Log", Args: []complex128{-1}, Want: complex(0, 3.1415926536)},
+	{Name: "complex log of zero", Op: "Log", Args: []complex128{0}, Err: calc.ErrDomain},
+	{Name: "complex pow", Op: "Pow", Args: []complex128{1i, 2}, Want: -1},
+	{Name: "complex pow zero to negative power", Op: "Pow", Args: []complex128{0, -1 + 1i}, Err: calc.ErrDivideByZero},
+	{Name: "complex sqrt of negative", Op: "Sqrt", Args: []complex128{-4}, Want: 2i},
+	{Name: "complex arity", Op: "Conjugate", Args: []complex128{1, 2}, Err: calc.ErrInvalidInput},
+	{Name: "complex unknown operation", Op: "Sin", Args: []complex128{1}, Err: calc.ErrUnknownOperation},
+}
+
+// ComplexFunc performs the named complex operation on an implementation under test
+type ComplexFunc func(ctx context.Context, op string, args ...complex128) (complex128, error)
+
+// ComplexFromEngine adapts a calc.ComplexEngine to a ComplexFunc
+func ComplexFromEngine(engine calc.ComplexEngine) ComplexFunc {
+	return func(ctx context.Context, op string, args ...complex128) (complex128, error) {
+		result := engine.Complex(ctx, op, args...)
+		return result.Value, result.Error
+	}
+}
+
+// RunComplex checks calculate against all ComplexVectors
+func RunComplex(t *testing.T, calculate ComplexFunc) {
+	t.Helper()
+
+	for _, v := range ComplexVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := context.Background()
+			if v.Degrees {
+				ctx = calc.WithAngleUnit(ctx, calc.Degrees)
+			}
+
+			got, err := calculate(ctx, v.Op, v.Args...)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%v: got error %v, want %v", v.Op, v.Args, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%v: unexpected error %v", v.Op, v.Args, err)
+			}
+			if got != v.Want {
+				t.Fatalf("%s%v = %v, want %v", v.Op, v.Args, got, v.Want)
+			}
+		})
+	}
+}
+
+// RationalVector is a single conformance case for rational operations
+type RationalVector struct {
+	Name string
+	Op   string
+	Args []string
+	// Want is the expected exact result in lowest terms, e.g. "1/3", when
+	// Err is nil
+	Want string
+	// Decimal is the expected rounded result when Err is nil
+	Decimal string
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// RationalVectors are the rational cases every implementation must pass,
+// under the same engine settings as Vectors
+var RationalVectors = []RationalVector{
+	{Name: "rational add", Op: "Add", Args: []string{"1/3", "1/6"}, Want: "1/2", Decimal: "0.5"},
+	{Name: "rational add stays exact", Op: "Add", Args: []string{"0.1", "0.2"}, Want: "3/10", Decimal: "0.3"},
+	{Name: "rational subtract", Op: "Subtract", Args: []string{"1", "1/3"}, Want: "2/3", Decimal: "0.6666666667"},
+	{Name: "rational multiply", Op: "Multiply", Args: []string{"-2/3", "3/4"}, Want: "-1/2", Decimal: "-0.5"},
+	{Name: "rational divide", Op: "Divide", Args: []string{"100", "3"}, Want: "100/3", Decimal: "33.3333333333"},
+	{Name: "rational divide by zero", Op: "Divide", Args: []string{"1", "0/5"}, Err: calc.ErrDivideByZero},
+	{Name: "rational mixed number", Op: "Add", Args: []string{"1 1/2", "-2 1/4"}, Want: "-3/4", Decimal: "-0.75"},
+	{Name: "rational tiny rounds to zero", Op: "Divide", Args: []string{"-1", "100000000000"}, Want: "-1/100000000000", Decimal: "0"},
+	{Name: "rational zero denominator", Op: "Add", Args: []string{"1/0", "1"}, Err: calc.ErrDivideByZero},
+	{Name: "rational malformed", Op: "Add", Args: []string{"1/3/4", "1"}, Err: calc.ErrInvalidInput},
+	{Name: "rational exponent notation", Op: "Add", Args: []string{"1e400", "1"}, Err: calc.ErrInvalidInput},
+	{Name: "rational pow", Op: "Pow", Args: []string{"2/3", "-3"}, Want: "27/8", Decimal: "3.375"},
+	{Name: "rational pow fractional exponent", Op: "Pow", Args: []string{"4", "1/2"}, Err: calc.ErrInvalidInput},
+	{Name: "rational pow zero to negative power", Op: "Pow", Args: []string{"0", "-1"}, Err: calc.ErrDivideByZero},
+	{Name: "rational pow overflow", Op: "Pow", Args: []string{"10", "10000"}, Err: calc.ErrOverflow},
+	{Name: "rational pow underflow", Op: "Pow", Args: []string{"-3", "9999"}, Err: calc.ErrUnderflow},
+	{Name: "rational negate", Op: "Negate", Args: []string{"5/2"}, Want: "-5/2", Decimal: "-2.5"},
+	{Name: "rational abs", Op: "Abs", Args: []string{"-5/10"}, Want: "1/2", Decimal: "0.5"},
+	{Name: "rational reciprocal", Op: "Reciprocal", Args: []string{"-0.25"}, Want: "-4", Decimal: "-4"},
+	{Name: "rational reciprocal of zero", Op: "Reciprocal", Args: []string{"0"}, Err: calc.ErrDivideByZero},
+	{Name: "rational arity", Op: "Negate", Args: []string{"1", "2"}, Err: calc.ErrInvalidInput},
+	{Name: "rational unknown operation", Op: "Sqrt", Args: []string{"4"}, Err: calc.ErrUnknownOperation},
+}
+
+// RationalFunc performs the named rational operation on an implementation
+// under test and returns the exact and the rounded result
+type RationalFunc func(ctx context.Context, op string, args ...string) (*big.Rat, string, error)
+
+// RationalFromEngine adapts a calc.RationalEngine to a RationalFunc
+func RationalFromEngine(engine calc.RationalEngine) RationalFunc {
+	return func(ctx context.Context, op string, args ...string) (*big.Rat, string, error) {
+		result := engine.Rational(ctx, op, args...)
+		return result.Value, result.Decimal, result.Error
+	}
+}
+
+// RunRational checks calculate against all RationalVectors
+func RunRational(t *testing.T, calculate RationalFunc) {
+	t.Helper()
+
+	for _, v := range RationalVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, decimal, err := calculate(context.Background(), v.Op, v.Args...)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%q: got error %v, want %v", v.Op, v.Args, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%q: unexpected error %v", v.Op, v.Args, err)
+			}
+			if got.RatString() != v.Want || decimal != v.Decimal {
+				t.Fatalf("%s%q = %s (%s), want %s (%s)", v.Op, v.Args, got.RatString(), decimal, v.Want, v.Decimal)
+			}
+		})
+	}
+}
+
+// IntegerVector is a single conformance case for integer operations
+type IntegerVector struct {
+	Name string
+	Op   string
+	Args []string
+	// Want are the expected decimal results when Err is nil
+	Want []string
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// IntegerVectors are the integer cases every implementation must pass
+var IntegerVectors = []IntegerVector{
+	{Name: "integer add beyond float precision", Op: "Add", Args: []string{"9007199254740993", "1"}, Want: []string{"9007199254740994"}},
+	{Name: "integer subtract", Op: "Subtract", Args: []string{"-5", "18446744073709551616"}, Want: []string{"-18446744073709551621"}},
+	{Name: "integer multiply", Op: "Multiply", Args: []string{"4294967297", "4294967297"}, Want: []string{"18446744082299486209"}},
+	{Name: "integer not decimal", Op: "Add", Args: []string{"1.5", "1"}, Err: calc.ErrInvalidInput},
+	{Name: "divmod floors", Op: "DivMod", Args: []string{"-7", "2"}, Want: []string{"-4", "1"}},
+	{Name: "divmod negative divisor", Op: "DivMod", Args: []string{"7", "-2"}, Want: []string{"-4", "-1"}},
+	{Name: "quorem truncates", Op: "QuoRem", Args: []string{"-7", "2"}, Want: []string{"-3", "-1"}},
+	{Name: "divmod by zero", Op: "DivMod", Args: []string{"1", "0"}, Err: calc.ErrDivideByZero},
+	{Name: "gcd", Op: "GCD", Args: []string{"-12", "18"}, Want: []string{"6"}},
+	{Name: "gcd of zeros", Op: "GCD", Args: []string{"0", "0"}, Want: []string{"0"}},
+	{Name: "lcm", Op: "LCM", Args: []string{"-4", "6"}, Want: []string{"12"}},
+	{Name: "modpow", Op: "ModPow", Args: []string{"4", "13", "497"}, Want: []string{"445"}},
+	{Name: "modpow negative exponent", Op: "ModPow", Args: []string{"3", "-1", "7"}, Want: []string{"5"}},
+	{Name: "modpow without inverse", Op: "ModPow", Args: []string{"2", "-1", "4"}, Err: calc.ErrDomain},
+	{Name: "modpow modulus zero", Op: "ModPow", Args: []string{"2", "3", "0"}, Err: calc.ErrDivideByZero},
+	{Name: "modinverse", Op: "ModInverse", Args: []string{"-3", "11"}, Want: []string{"7"}},
+	{Name: "modinverse without inverse", Op: "ModInverse", Args: []string{"2", "4"}, Err: calc.ErrDomain},
+	{Name: "factorial", Op: "Factorial", Args: []string{"25"}, Want: []string{"15511210043330985984000000"}},
+	{Name: "factorial of zero", Op: "Factorial", Args: []string{"0"}, Want: []string{"1"}},
+	{Name: "factorial of negative", Op: "Factorial", Args: []string{"-1"}, Err: calc.ErrDomain},
+	{Name: "factorial overflow", Op: "Factorial", Args: []string{"10000"}, Err: calc.ErrOverflow},
+	{Name: "binomial", Op: "Binomial", Args: []string{"50", "25"}, Want: []string{"126410606437752"}},
+	{Name: "binomial of large n", Op: "Binomial", Args: []string{"100000000000000000000", "2"}, Want: []string{"4999999999999999999950000000000000000000"}},
+	{Name: "binomial k above n", Op: "Binomial", Args: []string{"5", "7"}, Want: []string{"0"}},
+	{Name: "mersenne prime", Op: "IsPrime", Args: []string{"2305843009213693951"}, Want: []string{"1"}},
+	{Name: "carmichael number", Op: "IsPrime", Args: []string{"561"}, Want: []string{"0"}},
+	{Name: "strong pseudoprime to bases up to 37", Op: "IsPrime", Args: []string{"3317044064679887385961981"}, Want: []string{"0"}},
+	{Name: "factorize", Op: "Factorize", Args: []string{"600851475143"}, Want: []string{"71", "839", "1471", "6857"}},
+	{Name: "factorize fermat number", Op: "Factorize", Args: []string{"18446744073709551617"}, Want: []string{"274177", "67280421310721"}},
+	{Name: "factorize prime power", Op: "Factorize", Args: []string{"1000000000000000000"}, Want: []string{"2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "2", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5", "5"}},
+	{Name: "factorize one", Op: "Factorize", Args: []string{"1"}, Err: calc.ErrDomain},
+	{Name: "integer arity", Op: "GCD", Args: []string{"1"}, Err: calc.ErrInvalidInput},
+	{Name: "integer unknown operation", Op: "Sqrt", Args: []string{"4"}, Err: calc.ErrUnknownOperation},
+}
+
+// IntegerFunc performs the named integer operation on an implementation
+// under test
+type IntegerFunc func(ctx context.Context, op string, args ...string) ([]*big.Int, error)
+
+// IntegerFromEngine adapts a calc.IntegerEngine to an IntegerFunc
+func IntegerFromEngine(engine calc.IntegerEngine) IntegerFunc {
+	return func(ctx context.Context, op string, args ...string) ([]*big.Int, error) {
+		result := engine.Integer(ctx, op, args...)
+		return result.Values, result.Error
+	}
+}
+
+// RunInteger checks calculate against all IntegerVectors
+func RunInteger(t *testing.T, calculate IntegerFunc) {
+	t.Helper()
+
+	for _, v := range IntegerVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := calculate(context.Background(), v.Op, v.Args...)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%q: got error %v, want %v", v.Op, v.Args, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%q: unexpected error %v", v.Op, v.Args, err)
+			}
+			if len(got) != len(v.Want) {
+				t.Fatalf("%s%q = %v, want %v", v.Op, v.Args, got, v.Want)
+			}
+			for i := range got {
+				if got[i].String() != v.Want[i] {
+					t.Fatalf("%s%q = %v, want %v", v.Op, v.Args, got, v.Want)
+				}
+			}
+		})
+	}
+}
+
+// BitwiseVector is a single conformance case for the bitwise operations on
+// integer-valued real numbers
+type BitwiseVector struct {
+	Name string
+	Op   string
+	Args []float64
+	// Expression optionally states the same case with operators
+	Expression string
+	// Want is the expected result when Err is nil
+	Want float64
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// BitwiseVectors are the bitwise cases every implementation must pass, under
+// the same engine settings as Vectors
+var BitwiseVectors = []BitwiseVector{
+	{Name: "and", Op: "And", Args: []float64{12, 10}, Expression: "12 & 10", Want: 8},
+	{Name: "and negative", Op: "And", Args: []float64{-1, 255}, Expression: "-1 & 0xff", Want: 255},
+	{Name: "or", Op: "Or", Args: []float64{12, 3}, Expression: "12 | 3", Want: 15},
+	{Name: "or negative", Op: "Or", Args: []float64{-16, 15}, Expression: "-16 | 0b1111", Want: -1},
+	{Name: "xor", Op: "Xor", Args: []float64{12, 10}, Expression: "xor(12, 10)", Want: 6},
+	{Name: "xor negative", Op: "Xor", Args: []float64{-1, 5}, Want: -6},
+	{Name: "not", Op: "Not", Args: []float64{0}, Expression: "~0", Want: -1},
+	{Name: "not positive", Op: "Not", Args: []float64{5}, Expression: "~5", Want: -6},
+	{Name: "not negative", Op: "Not", Args: []float64{-6}, Expression: "~-6", Want: 5},
+	{Name: "shift left", Op: "Shl", Args: []float64{1, 10}, Expression: "1 << 10", Want: 1024},
+	{Name: "shift left by zero", Op: "Shl", Args: []float64{7, 0}, Expression: "7 << 0", Want: 7},
+	{Name: "shift left negative", Op: "Shl", Args: []float64{-3, 4}, Expression: "-3 << 4", Want: -48},
+	{Name: "shift left to exact limit", Op: "Shl", Args: []float64{1, 53}, Expression: "1 << 53", Want: calc.MaxExactInteger},
+	{Name: "shift left beyond exact limit", Op: "Shl", Args: []float64{3, 52}, Expression: "3 << 52", Err: calc.ErrOverflow},
+	{Name: "shift left out of int64", Op: "Shl", Args: []float64{1, 63}, Expression: "1 << 63", Err: calc.ErrOverflow},
+	{Name: "shift left negative out of range", Op: "Shl", Args: []float64{-1, 63}, Expression: "-1 << 63", Err: calc.ErrUnderflow},
+	{Name: "shift right", Op: "Shr", Args: []float64{1024, 3}, Expression: "1024 >> 3", Want: 128},
+	{Name: "shift right is arithmetic", Op: "Shr", Args: []float64{-16, 2}, Expression: "-16 >> 2", Want: -4},
+	{Name: "shift right negative to minus one", Op: "Shr", Args: []float64{-1, 63}, Expression: "-1 >> 63", Want: -1},
+	{Name: "shift count too large", Op: "Shr", Args: []float64{1, 64}, Expression: "1 >> 64", Err: calc.ErrInvalidInput},
+	{Name: "negative shift count", Op: "Shl", Args: []float64{1, -1}, Expression: "1 << -1", Err: calc.ErrInvalidInput},
+	{Name: "popcount", Op: "PopCount", Args: []float64{255}, Expression: "popcount(0xff)", Want: 8},
+	{Name: "popcount of negative", Op: "PopCount", Args: []float64{-1}, Want: 64},
+	{Name: "popcount of zero", Op: "PopCount", Args: []float64{0}, Want: 0},
+	{Name: "bitwise precedence", Op: "Or", Args: []float64{1, 16}, Expression: "1 | 2 << 3", Want: 17},
+	{Name: "and binds tighter than or", Op: "Or", Args: []float64{2, 8}, Expression: "6 & 3 | 8", Want: 10},
+	{Name: "shift binds looser than add", Op: "Shl", Args: []float64{1, 3}, Expression: "1 << 2 + 1", Want: 8},
+	{Name: "bitwise fraction", Op: "And", Args: []float64{1.5, 1}, Expression: "1.5 & 1", Err: calc.ErrInvalidInput},
+	{Name: "bitwise beyond exact limit", Op: "Or", Args: []float64{1 << 54, 1}, Expression: "0x40000000000000 | 1", Err: calc.ErrInvalidInput},
+	{Name: "bitwise arity", Op: "Not", Args: []float64{1, 2}, Err: calc.ErrInvalidInput},
+}
+
+// BitwiseFunc performs the named operation on an implementation under test
+type BitwiseFunc func(ctx context.Context, op string, args ...float64) (float64, error)
+
+// BitwiseFromEngine adapts a calc.Engine to a BitwiseFunc
+func BitwiseFromEngine(engine calc.Engine) BitwiseFunc {
+	return func(ctx context.Context, op string, args ...float64) (float64, error) {
+		result := engine.Invoke(ctx, op, args...)
+		return result.Value, result.Error
+	}
+}
+
+// RunBitwise checks calculate against all BitwiseVectors
+func RunBitwise(t *testing.T, calculate BitwiseFunc) {
+	t.Helper()
+
+	for _, v := range BitwiseVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := calculate(context.Background(), v.Op, v.Args...)
+			checkBitwise(t, fmt.Sprintf("%s%v", v.Op, v.Args), v, got, err)
+		})
+	}
+}
+
+// ExpressionFunc evaluates an expression on an implementation under test
+type ExpressionFunc func(ctx context.Context, expression string) (float64, error)
+
+// ExpressionFromEngine adapts a calc.Engine to an ExpressionFunc
+func ExpressionFromEngine(engine calc.Engine) ExpressionFunc {
+	return func(ctx context.Context, expression string) (float64, error) {
+		result := engine.Evaluate(ctx, expression)
+		return result.Value, result.Error
+	}
+}
+
+// RunBitwiseExpressions checks evaluate against the BitwiseVectors that
+// state an expression
+func RunBitwiseExpressions(t *testing.T, evaluate ExpressionFunc) {
+	t.Helper()
+
+	for _, v := range BitwiseVectors {
+		v := v
+		if v.Expression == "" {
+			continue
+		}
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := evaluate(context.Background(), v.Expression)
+			checkBitwise(t, fmt.Sprintf("%q", v.Expression), v, got, err)
+		})
+	}
+}
+
+// checkBitwise checks the result of the calculation described by call
+// against v
+func checkBitwise(t *testing.T, call string, v BitwiseVector, got float64, err error) {
+	t.Helper()
+
+	if v.Err != nil {
+		if !errors.Is(err, v.Err) {
+			t.Fatalf("%s: got error %v, want %v", call, err, v.Err)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("%s: unexpected error %v", call, err)
+	}
+	if got != v.Want {
+		t.Fatalf("%s = %v, want %v", call, got, v.Want)
+	}
+}
+
+// UnitVector is a single conformance case for operations on quantities
+type UnitVector struct {
+	Name string
+	Op   string
+	Args []calc.Quantity
+	// Want is the expected result with its unit when Err is nil
+	Want calc.Quantity
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// UnitVectors are the cases with units every implementation must pass,
+// under the same engine settings as Vectors
+var UnitVectors = []UnitVector{
+	{Name: "add converts to first unit", Op: "Add", Args: []calc.Quantity{{Value: 1, Unit: "km"}, {Value: 500, Unit: "m"}}, Want: calc.Quantity{Value: 1.5, Unit: "km"}},
+	{Name: "add meters to seconds", Op: "Add", Args: []calc.Quantity{{Value: 1, Unit: "m"}, {Value: 1, Unit: "s"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "add unit to dimensionless", Op: "Add", Args: []calc.Quantity{{Value: 1, Unit: "m"}, {Value: 1}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "add temperatures", Op: "Add", Args: []calc.Quantity{{Value: 20, Unit: "degC"}, {Value: 50, Unit: "degF"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "add difference to temperature", Op: "Add", Args: []calc.Quantity{{Value: 10, Unit: "degC"}, {Value: 5, Unit: "K"}}, Want: calc.Quantity{Value: 15, Unit: "degC"}},
+	{Name: "add fahrenheit difference", Op: "Add", Args: []calc.Quantity{{Value: 20, Unit: "degC"}, {Value: 9, Unit: "deltaF"}}, Want: calc.Quantity{Value: 25, Unit: "degC"}},
+	{Name: "add temperature to difference", Op: "Add", Args: []calc.Quantity{{Value: 5, Unit: "K"}, {Value: 10, Unit: "degC"}}, Want: calc.Quantity{Value: 15, Unit: "degC"}},
+	{Name: "subtract temperatures", Op: "Subtract", Args: []calc.Quantity{{Value: 20, Unit: "degC"}, {Value: 50, Unit: "degF"}}, Want: calc.Quantity{Value: 10, Unit: "K"}},
+	{Name: "subtract difference from temperature", Op: "Subtract", Args: []calc.Quantity{{Value: 20, Unit: "degF"}, {Value: 10, Unit: "K"}}, Want: calc.Quantity{Value: 2, Unit: "degF"}},
+	{Name: "subtract temperature from difference", Op: "Subtract", Args: []calc.Quantity{{Value: 10, Unit: "K"}, {Value: 20, Unit: "degC"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "mod temperature", Op: "Mod", Args: []calc.Quantity{{Value: 25, Unit: "degC"}, {Value: 10, Unit: "K"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "subtract equivalent units", Op: "Subtract", Args: []calc.Quantity{{Value: 5, Unit: "J"}, {Value: 2, Unit: "N*m"}}, Want: calc.Quantity{Value: 3, Unit: "J"}},
+	{Name: "multiply rate by time", Op: "Multiply", Args: []calc.Quantity{{Value: 100, Unit: "MB/s"}, {Value: 2, Unit: "min"}}, Want: calc.Quantity{Value: 12000, Unit: "MB"}},
+	{Name: "multiply energy by price", Op: "Multiply", Args: []calc.Quantity{{Value: 3, Unit: "kWh"}, {Value: 0.25}}, Want: calc.Quantity{Value: 0.75, Unit: "kWh"}},
+	{Name: "multiply different dimensions", Op: "Multiply", Args: []calc.Quantity{{Value: 2, Unit: "kW"}, {Value: 3, Unit: "h"}}, Want: calc.Quantity{Value: 6, Unit: "kW*h"}},
+	{Name: "multiply offset unit", Op: "Multiply", Args: []calc.Quantity{{Value: 2, Unit: "degC"}, {Value: 2}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "divide to dimensionless", Op: "Divide", Args: []calc.Quantity{{Value: 5, Unit: "m"}, {Value: 1, Unit: "km"}}, Want: calc.Quantity{Value: 0.005}},
+	{Name: "divide size by rate", Op: "Divide", Args: []calc.Quantity{{Value: 1, Unit: "GiB"}, {Value: 8, Unit: "Mbit/s"}}, Want: calc.Quantity{Value: 1073.741824, Unit: "s"}},
+	{Name: "divide by zero with units", Op: "Divide", Args: []calc.Quantity{{Value: 1, Unit: "m"}, {Value: 0, Unit: "s"}}, Err: calc.ErrDivideByZero},
+	{Name: "pow with unit", Op: "Pow", Args: []calc.Quantity{{Value: 3, Unit: "m"}, {Value: 2}}, Want: calc.Quantity{Value: 9, Unit: "m^2"}},
+	{Name: "pow fractional exponent with unit", Op: "Pow", Args: []calc.Quantity{{Value: 4, Unit: "m"}, {Value: 0.5}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "sqrt of area", Op: "Sqrt", Args: []calc.Quantity{{Value: 9, Unit: "m^2"}}, Want: calc.Quantity{Value: 3, Unit: "m"}},
+	{Name: "sqrt of length", Op: "Sqrt", Args: []calc.Quantity{{Value: 9, Unit: "m"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "sin of length", Op: "Sin", Args: []calc.Quantity{{Value: 1, Unit: "m"}}, Err: calc.ErrIncompatibleUnits},
+	{Name: "unknown unit", Op: "Add", Args: []calc.Quantity{{Value: 1, Unit: "parsec"}, {Value: 1, Unit: "m"}}, Err: calc.ErrInvalidInput},
+}
+
+// UnitFunc performs the named operation on quantities on an implementation
+// under test
+type UnitFunc func(ctx context.Context, op string, args ...calc.Quantity) (calc.Quantity, error)
+
+// UnitsFromEngine adapts a calc.UnitEngine to a UnitFunc
+func UnitsFromEngine(engine calc.UnitEngine) UnitFunc {
+	return func(ctx context.Context, op string, args ...calc.Quantity) (calc.Quantity, error) {
+		result := engine.InvokeUnits(ctx, op, args...)
+		return calc.Quantity{Value: result.Value, Unit: result.Unit}, result.Error
+	}
+}
+
+// RunUnits checks invoke against all UnitVectors
+func RunUnits(t *testing.T, invoke UnitFunc) {
+	t.Helper()
+
+	for _, v := range UnitVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := invoke(context.Background(), v.Op, v.Args...)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%v: got error %v, want %v", v.Op, v.Args, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%v: unexpected error %v", v.Op, v.Args, err)
+			}
+			if got != v.Want {
+				t.Fatalf("%s%v = %v, want %v", v.Op, v.Args, got, v.Want)
+			}
+		})
+	}
+}
+
+// ConversionVector is a single conformance case for unit conversions
+type ConversionVector struct {
+	Name     string
+	Value    float64
+	From, To string
+	// Want is the expected result when Err is nil
+	Want float64
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// ConversionVectors are the conversions every implementation must pass,
+// under the same engine settings as Vectors
+var ConversionVectors = []ConversionVector{
+	{Name: "miles to kilometers", Value: 1, From: "mi", To: "km", Want: 1.609344},
+	{Name: "kilowatt hours to joules", Value: 1, From: "kWh", To: "J", Want: 3.6e6},
+	{Name: "binary to decimal prefix", Value: 1, From: "GiB", To: "MB", Want: 1073.741824},
+	{Name: "bits to bytes", Value: 1, From: "Gbit/s", To: "MB/s", Want: 125},
+	{Name: "celsius to fahrenheit", Value: 100, From: "degC", To: "degF", Want: 212},
+	{Name: "fahrenheit to celsius", Value: -40, From: "degF", To: "degC", Want: -40},
+	{Name: "kelvin to celsius", Value: 0, From: "K", To: "degC", Want: -273.15},
+	{Name: "incompatible dimensions", Value: 1, From: "m", To: "s", Err: calc.ErrIncompatibleUnits},
+	{Name: "combined offset unit", Value: 1, From: "degC/s", To: "K/s", Err: calc.ErrInvalidInput},
+	{Name: "conversion NaN", Value: math.NaN(), From: "m", To: "km", Err: calc.ErrInvalidInput},
+}
+
+// ConvertFunc converts a value between units on an implementation under test
+type ConvertFunc func(ctx context.Context, value float64, from, to string) (float64, error)
+
+// ConvertFromEngine adapts a calc.UnitEngine to a ConvertFunc
+func ConvertFromEngine(engine calc.UnitEngine) ConvertFunc {
+	return func(ctx context.Context, value float64, from, to string) (float64, error) {
+		result := engine.Convert(ctx, value, from, to)
+		return result.Value, result.Error
+	}
+}
+
+// RunConversions checks convert against all ConversionVectors
+func RunConversions(t *testing.T, convert ConvertFunc) {
+	t.Helper()
+
+	for _, v := range ConversionVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := convert(context.Background(), v.Value, v.From, v.To)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%g %s in %s: got error %v, want %v", v.Value, v.From, v.To, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%g %s in %s: unexpected error %v", v.Value, v.From, v.To, err)
+			}
+			if got != v.Want {
+				t.Fatalf("%g %s in %s = %v, want %v", v.Value, v.From, v.To, got, v.Want)
+			}
+		})
+	}
+}
+
+// MoneyVector is a single conformance case for operations on money
+type MoneyVector struct {
+	Name string
+	Op   string
+	// Amounts are amounts of money such as "12.50 USD"
+	Amounts []string
+	Numbers []string
+	Mode    calc.RoundingMode
+	// Want are the expected amounts when Err is nil
+	Want []string
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// MoneyVectors are the money cases every implementation must pass
+var MoneyVectors = []MoneyVector{
+	{Name: "money add", Op: "Add", Amounts: []string{"1.5 USD", "2.25 USD"}, Want: []string{"3.75 USD"}},
+	{Name: "money subtract below zero", Op: "Subtract", Amounts: []string{"1 EUR", "2.01 EUR"}, Want: []string{"-1.01 EUR"}},
+	{Name: "money add zero minor units", Op: "Add", Amounts: []string{"1000 JPY", "1 JPY"}, Want: []string{"1001 JPY"}},
+	{Name: "money add three minor units", Op: "Add", Amounts: []string{"0.001 KWD", "1.5 KWD"}, Want: []string{"1.501 KWD"}},
+	{Name: "currency mismatch", Op: "Add", Amounts: []string{"1 USD", "1 EUR"}, Err: calc.ErrCurrencyMismatch},
+	{Name: "multiply half even", Op: "Multiply", Amounts: []string{"10.05 USD"}, Numbers: []string{"0.5"}, Mode: calc.HalfEven, Want: []string{"5.02 USD"}},
+	{Name: "multiply half up", Op: "Multiply", Amounts: []string{"10.05 USD"}, Numbers: []string{"0.5"}, Mode: calc.HalfUp, Want: []string{"5.03 USD"}},
+	{Name: "multiply floor", Op: "Multiply", Amounts: []string{"-10.05 USD"}, Numbers: []string{"0.5"}, Mode: calc.Floor, Want: []string{"-5.03 USD"}},
+	{Name: "multiply down", Op: "Multiply", Amounts: []string{"19.99 EUR"}, Numbers: []string{"0.19"}, Mode: calc.Down, Want: []string{"3.79 EUR"}},
+	{Name: "split remainder", Op: "Split", Amounts: []string{"100 USD"}, Numbers: []string{"3"}, Want: []string{"33.34 USD", "33.33 USD", "33.33 USD"}},
+	{Name: "split negative", Op: "Split", Amounts: []string{"-0.05 USD"}, Numbers: []string{"3"}, Want: []string{"-0.02 USD", "-0.02 USD", "-0.01 USD"}},
+	{Name: "split more parts than minor units", Op: "Split", Amounts: []string{"2 JPY"}, Numbers: []string{"3"}, Want: []string{"1 JPY", "1 JPY", "0 JPY"}},
+	{Name: "split zero parts", Op: "Split", Amounts: []string{"1 USD"}, Numbers: []string{"0"}, Err: calc.ErrInvalidInput},
+	{Name: "split fractional parts", Op: "Split", Amounts: []string{"1 USD"}, Numbers: []string{"1.5"}, Err: calc.ErrInvalidInput},
+	{Name: "allocate largest remainder", Op: "Allocate", Amounts: []string{"0.05 USD"}, Numbers: []string{"3", "7"}, Want: []string{"0.02 USD", "0.03 USD"}},
+	{Name: "allocate percentages", Op: "Allocate", Amounts: []string{"100 EUR"}, Numbers: []string{"33.3", "33.3", "33.4"}, Want: []string{"33.30 EUR", "33.30 EUR", "33.40 EUR"}},
+	{Name: "allocate uneven", Op: "Allocate", Amounts: []string{"10 USD"}, Numbers: []string{"1", "1", "1", "0"}, Want: []string{"3.34 USD", "3.33 USD", "3.33 USD", "0.00 USD"}},
+	{Name: "allocate negative ratio", Op: "Allocate", Amounts: []string{"1 USD"}, Numbers: []string{"1", "-1"}, Err: calc.ErrInvalidInput},
+	{Name: "allocate zero ratios", Op: "Allocate", Amounts: []string{"1 USD"}, Numbers: []string{"0", "0"}, Err: calc.ErrInvalidInput},
+	{Name: "money arity", Op: "Add", Amounts: []string{"1 USD"}, Err: calc.ErrInvalidInput},
+	{Name: "money unknown operation", Op: "Divide", Amounts: []string{"1 USD"}, Numbers: []string{"2"}, Err: calc.ErrUnknownOperation},
+}
+
+// MoneyFunc performs the named money operation on an implementation under
+// test
+type MoneyFunc func(ctx context.Context, op string, amounts []calc.Money, numbers []calc.Decimal, mode calc.RoundingMode) ([]calc.Money, error)
+
+// MoneyFromEngine adapts a calc.MoneyEngine to a MoneyFunc
+func MoneyFromEngine(engine calc.MoneyEngine) MoneyFunc {
+	return func(ctx context.Context, op string, amounts []calc.Money, numbers []calc.Decimal, mode calc.RoundingMode) ([]calc.Money, error) {
+		result := engine.Money(ctx, op, amounts, numbers, mode)
+		return result.Values, result.Error
+	}
+}
+
+// RunMoney checks calculate against all MoneyVectors
+func RunMoney(t *testing.T, calculate MoneyFunc) {
+	t.Helper()
+
+	for _, v := range MoneyVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			amounts := make([]calc.Money, len(v.Amounts))
+			for i, amount := range v.Amounts {
+				amounts[i] = parseMoney(t, amount)
+			}
+			numbers := make([]calc.Decimal, len(v.Numbers))
+			for i, number := range v.Numbers {
+				d, err := calc.ParseDecimal(number)
+				if err != nil {
+					t.Fatalf("invalid vector number %q: %v", number, err)
+				}
+				numbers[i] = d
+			}
+
+			got, err := calculate(context.Background(), v.Op, amounts, numbers, v.Mode)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s%q%q: got error %v, want %v", v.Op, v.Amounts, v.Numbers, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s%q%q: unexpected error %v", v.Op, v.Amounts, v.Numbers, err)
+			}
+			if len(got) != len(v.Want) {
+				t.Fatalf("%s%q%q = %v, want %v", v.Op, v.Amounts, v.Numbers, got, v.Want)
+			}
+			for i := range got {
+				if got[i].String() != parseMoney(t, v.Want[i]).String() {
+					t.Fatalf("%s%q%q = %v, want %v", v.Op, v.Amounts, v.Numbers, got, v.Want)
+				}
+			}
+		})
+	}
+}
+
+// parseMoney parses an amount of money such as "12.50 USD" in a vector
+func parseMoney(t *testing.T, s string) calc.Money {
+	t.Helper()
+
+	amount, code, _ := strings.Cut(s, " ")
+	m, err := calc.ParseMoney(code, amount)
+	if err != nil {
+		t.Fatalf("invalid vector amount %q: %v", s, err)
+	}
+	return m
+}
+
+// RateTable is the exchange-rate table implementations are loaded with for
+// CurrencyVectors, in the format of calc.ParseRateTable
+const RateTable = `{
+  "effective": "2026-10-01",
+  "base": "USD",
+  "rates": {"EUR": "0.92", "JPY": "149.5", "GBP": "0.79", "KWD": "0.3071"}
+}`
+
+// CurrencyVector is a single conformance case for currency conversions
+type CurrencyVector struct {
+	Name string
+	// Amount is an amount of money such as "12.50 USD"
+	Amount string
+	To     string
+	Mode   calc.RoundingMode
+	// Want is the expected amount when Err is nil
+	Want string
+	// Err is the expected sentinel error, matched with errors.Is
+	Err error
+}
+
+// CurrencyVectors are the currency conversions every implementation loaded
+// with RateTable must pass
+var CurrencyVectors = []CurrencyVector{
+	{Name: "from base currency", Amount: "100 USD", To: "EUR", Want: "92.00 EUR"},
+	{Name: "to base currency", Amount: "92 EUR", To: "USD", Want: "100.00 USD"},
+	{Name: "cross rate", Amount: "100 EUR", To: "JPY", Want: "16250 JPY"},
+	{Name: "cross rate rounds half even", Amount: "0.01 USD", To: "JPY", Want: "1 JPY"},
+	{Name: "cross rate rounds down", Amount: "10 GBP", To: "EUR", Mode: calc.Down, Want: "11.64 EUR"},
+	{Name: "cross rate rounds up", Amount: "10 GBP", To: "EUR", Mode: calc.Up, Want: "11.65 EUR"},
+	{Name: "three minor units", Amount: "1000 JPY", To: "KWD", Want: "2.054 KWD"},
+	{Name: "same currency", Amount: "1.23 GBP", To: "gbp", Want: "1.23 GBP"},
+	{Name: "currency without rate", Amount: "1 USD", To: "CHF", Err: calc.ErrInvalidInput},
+	{Name: "unknown currency", Amount: "1 USD", To: "XYZ", Err: calc.ErrInvalidInput},
+}
+
+// CurrencyFunc converts an amount of money on an implementation under test
+type CurrencyFunc func(ctx context.Context, amount calc.Money, to string, mode calc.RoundingMode) (calc.Money, error)
+
+// CurrencyFromEngine adapts a calc.MoneyEngine to a CurrencyFunc
+func CurrencyFromEngine(engine calc.MoneyEngine) CurrencyFunc {
+	return func(ctx context.Context, amount calc.Money, to string, mode calc.RoundingMode) (calc.Money, error) {
+		result := engine.ConvertCurrency(ctx, amount, to, mode)
+		return result.Value, result.Error
+	}
+}
+
+// RunCurrency checks convert against all CurrencyVectors
+func RunCurrency(t *testing.T, convert CurrencyFunc) {
+	t.Helper()
+
+	for _, v := range CurrencyVectors {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			got, err := convert(context.Background(), parseMoney(t, v.Amount), v.To, v.Mode)
+			if v.Err != nil {
+				if !errors.Is(err, v.Err) {
+					t.Fatalf("%s in %s: got error %v, want %v", v.Amount, v.To, err, v.Err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s in %s: unexpected error %v", v.Amount, v.To, err)
+			}
+			if want := parseMoney(t, v.Want); got.String() != want.String() {
+				t.Fatalf("%s in %s = %v, want %v", v.Amount, v.To, got, want)
+			}
+		})
+	}
+}
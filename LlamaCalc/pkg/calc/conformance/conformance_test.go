@@ -0,0 +1,289 @@
+package conformance_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/conformance"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calctest"
+	"llamacalc/pkg/calculator"
+	legacypb "llamacalc/pkg/proto"
+	llamacalcpb "llamacalc/pkg/proto/llamacalc"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/server"
+)
+
+func TestCalculator(t *testing.T) {
+	conformance.Run(t, conformance.FromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestSimpleCalculator(t *testing.T) {
+	c := calculator.NewCalculator()
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		return c.Calculate(op, a, b)
+	})
+}
+
+func TestService(t *testing.T) {
+	s := calculator.NewService(calc.NewDefaultCalculator())
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		req := &pb.CalculationRequest{A: a, B: b}
+
+		var resp *pb.CalculationResponse
+		var err error
+		switch strings.ToLower(op) {
+		case "add":
+			resp, err = s.Add(ctx, req)
+		case "subtract":
+			resp, err = s.Subtract(ctx, req)
+		case "multiply":
+			resp, err = s.Multiply(ctx, req)
+		case "divide":
+			resp, err = s.Divide(ctx, req)
+		default:
+			return 0, fmt.Errorf("%w: %s", calc.ErrUnknownOperation, op)
+		}
+		if err != nil {
+			return 0, calcstatus.FromStatus(err)
+		}
+		return resp.Result, nil
+	})
+}
+
+func TestGRPCServer(t *testing.T) {
+	c := calctest.NewServer(t).Client()
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		switch strings.ToLower(op) {
+		case "add":
+			return c.Add(ctx, a, b)
+		case "subtract":
+			return c.Subtract(ctx, a, b)
+		case "multiply":
+			return c.Multiply(ctx, a, b)
+		case "divide":
+			return c.Divide(ctx, a, b)
+		}
+		return 0, fmt.Errorf("%w: %s", calc.ErrUnknownOperation, op)
+	})
+}
+
+func TestInvoke(t *testing.T) {
+	c := calctest.NewServer(t).Client()
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		return c.Invoke(ctx, op, a, b)
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	c := calctest.NewServer(t).Client()
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		expression := fmt.Sprintf("%s(%s, %s)", op,
+			strconv.FormatFloat(a, 'g', -1, 64), strconv.FormatFloat(b, 'g', -1, 64))
+		return c.Evaluate(ctx, expression)
+	})
+}
+
+func TestScientific(t *testing.T) {
+	conformance.RunScientific(t, conformance.ScientificFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestScientificClient(t *testing.T) {
+	conformance.RunScientific(t, calctest.NewServer(t).Client().Invoke)
+}
+
+func TestComplex(t *testing.T) {
+	conformance.RunComplex(t, conformance.ComplexFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestComplexClient(t *testing.T) {
+	conformance.RunComplex(t, calctest.NewServer(t).Client().Complex)
+}
+
+func TestRational(t *testing.T) {
+	conformance.RunRational(t, conformance.RationalFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestRationalClient(t *testing.T) {
+	client := calctest.NewServer(t).Client()
+	conformance.RunRational(t, func(ctx context.Context, op string, args ...string) (*big.Rat, string, error) {
+		result, err := client.Rational(ctx, op, args...)
+		if err != nil {
+			return nil, "", err
+		}
+		return result.Value, result.Decimal, nil
+	})
+}
+
+func TestInteger(t *testing.T) {
+	conformance.RunInteger(t, conformance.IntegerFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestIntegerClient(t *testing.T) {
+	client := calctest.NewServer(t).Client()
+	conformance.RunInteger(t, func(ctx context.Context, op string, args ...string) ([]*big.Int, error) {
+		values := make([]*big.Int, len(args))
+		for i, arg := range args {
+			value, ok := new(big.Int).SetString(arg, 10)
+			if !ok {
+				return nil, calc.ErrInvalidInput
+			}
+			values[i] = value
+		}
+		return client.Integer(ctx, op, values...)
+	})
+}
+
+func TestBitwise(t *testing.T) {
+	conformance.RunBitwise(t, conformance.BitwiseFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestBitwiseClient(t *testing.T) {
+	conformance.RunBitwise(t, calctest.NewServer(t).Client().Invoke)
+}
+
+func TestBitwiseExpressions(t *testing.T) {
+	conformance.RunBitwiseExpressions(t, conformance.ExpressionFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestBitwiseExpressionsClient(t *testing.T) {
+	conformance.RunBitwiseExpressions(t, calctest.NewServer(t).Client().Evaluate)
+}
+
+func TestUnits(t *testing.T) {
+	conformance.RunUnits(t, conformance.UnitsFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestUnitsClient(t *testing.T) {
+	conformance.RunUnits(t, calctest.NewServer(t).Client().InvokeUnits)
+}
+
+func TestConversions(t *testing.T) {
+	conformance.RunConversions(t, conformance.ConvertFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestConversionsClient(t *testing.T) {
+	conformance.RunConversions(t, calctest.NewServer(t).Client().Convert)
+}
+
+func TestLegacyService(t *testing.T) {
+	conn, err := calctest.NewServer(t).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	c := legacypb.NewCalculatorClient(conn)
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		req := &legacypb.CalculationRequest{A: a, B: b}
+
+		var resp *legacypb.CalculationResponse
+		var err error
+		switch strings.ToLower(op) {
+		case "add":
+			resp, err = c.Add(ctx, req)
+		case "subtract":
+			resp, err = c.Subtract(ctx, req)
+		case "multiply":
+			resp, err = c.Multiply(ctx, req)
+		case "divide":
+			resp, err = c.Divide(ctx, req)
+		default:
+			return 0, fmt.Errorf("%w: %s", calc.ErrUnknownOperation, op)
+		}
+		if err != nil {
+			return 0, calcstatus.FromStatus(err)
+		}
+		if resp.StatusCode != 200 {
+			t.Errorf("%s: got status code %d, want 200", op, resp.StatusCode)
+		}
+		return resp.Result, nil
+	})
+}
+
+func TestUnversionedService(t *testing.T) {
+	conn, err := calctest.NewServer(t).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+
+	c := llamacalcpb.NewCalculatorClient(conn)
+	conformance.Run(t, func(ctx context.Context, op string, a, b float64) (float64, error) {
+		req := &llamacalcpb.CalculationRequest{A: a, B: b}
+
+		var resp *llamacalcpb.CalculationResponse
+		var err error
+		switch strings.ToLower(op) {
+		case "add":
+			resp, err = c.Add(ctx, req)
+		case "subtract":
+			resp, err = c.Subtract(ctx, req)
+		case "multiply":
+			resp, err = c.Multiply(ctx, req)
+		case "divide":
+			resp, err = c.Divide(ctx, req)
+		default:
+			return 0, fmt.Errorf("%w: %s", calc.ErrUnknownOperation, op)
+		}
+		if err != nil {
+			return 0, calcstatus.FromStatus(err)
+		}
+		if resp.StatusCode != 200 {
+			t.Errorf("%s: got status code %d, want 200", op, resp.StatusCode)
+		}
+		return resp.Result, nil
+	})
+
+	resp, err := c.Health(context.Background(), &llamacalcpb.HealthCheckRequest{Service: "llamacalc.Calculator"})
+	if err != nil || resp.Status != llamacalcpb.HealthCheckResponse_SERVING {
+		t.Errorf("got health %v, %v, want SERVING", resp, err)
+	}
+}
+
+func TestMoney(t *testing.T) {
+	conformance.RunMoney(t, conformance.MoneyFromEngine(calc.NewDefaultCalculator()))
+}
+
+func TestMoneyClient(t *testing.T) {
+	conformance.RunMoney(t, calctest.NewServer(t).Client().Money)
+}
+
+func TestCurrency(t *testing.T) {
+	rates, err := calc.ParseRateTable(strings.NewReader(conformance.RateTable))
+	if err != nil {
+		t.Fatalf("failed to parse rates: %v", err)
+	}
+	c := calc.NewDefaultCalculator()
+	c.Rates = rates
+	conformance.RunCurrency(t, conformance.CurrencyFromEngine(c))
+}
+
+func TestCurrencyClient(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(conformance.RateTable), 0o600); err != nil {
+		t.Fatalf("failed to write rates: %v", err)
+	}
+	client := calctest.NewServer(t, calctest.WithServerConfig(func(config *server.Config) {
+		config.RatesFile = path
+	})).Client()
+
+	conformance.RunCurrency(t, func(ctx context.Context, amount calc.Money, to string, mode calc.RoundingMode) (calc.Money, error) {
+		result, err := client.ConvertCurrency(ctx, amount, to, mode)
+		if err != nil {
+			return calc.Money{}, err
+		}
+		if result.Effective.Format(time.DateOnly) != "2026-10-01" {
+			t.Errorf("effective date = %v, want 2026-10-01", result.Effective)
+		}
+		return result.Value, nil
+	})
+}
@@ -0,0 +1,221 @@
+package calc
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MaxDecimalDigits limits the number of digits of parsed decimals
+const MaxDecimalDigits = 100
+
+// RoundingMode tells how decimals are rounded to a number of decimal places
+type RoundingMode int
+
+// Rounding modes
+const (
+	// HalfEven rounds to the nearest value and ties to an even last digit,
+	// as banks do
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest value and ties away from zero, as
+	// spreadsheets do
+	HalfUp
+	// Down rounds towards zero
+	Down
+	// Up rounds away from zero
+	Up
+	// Floor rounds towards negative infinity
+	Floor
+	// Ceiling rounds towards positive infinity
+	Ceiling
+)
+
+// roundingModes are the names of the rounding modes
+var roundingModes = []string{"HalfEven", "HalfUp", "Down", "Up", "Floor", "Ceiling"}
+
+// String returns the name of the rounding mode, e.g. "HalfEven"
+func (m RoundingMode) String() string {
+	if m < 0 || int(m) >= len(roundingModes) {
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+	return roundingModes[m]
+}
+
+// Decimal is an exact decimal number. The zero Decimal is 0.
+type Decimal struct {
+	// coef * 10^-scale is the value
+	coef  *big.Int
+	scale int
+}
+
+// NewDecimal returns coef * 10^-scale for scale >= 0
+func NewDecimal(coef *big.Int, scale int) Decimal {
+	return Decimal{coef: new(big.Int).Set(coef), scale: scale}
+}
+
+// ParseDecimal parses a decimal number such as "-12.50". Exponents are not
+// accepted.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 {
+		return Decimal{}, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidInput, s)
+	}
+
+	whole, fraction, _ := strings.Cut(digits, ".")
+	if whole+fraction == "" || strings.Trim(whole+fraction, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidInput, s)
+	}
+	if len(whole)+len(fraction) > MaxDecimalDigits {
+		return Decimal{}, fmt.Errorf("%w: decimals have at most %d digits", ErrInvalidInput, MaxDecimalDigits)
+	}
+
+	coef, _ := new(big.Int).SetString(whole+fraction, 10)
+	if strings.HasPrefix(s, "-") {
+		coef.Neg(coef)
+	}
+	return Decimal{coef: coef, scale: len(fraction)}, nil
+}
+
+// DecimalFromRat rounds r to scale decimal places
+func DecimalFromRat(r *big.Rat, scale int, mode RoundingMode) Decimal {
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	return Decimal{coef: roundQuo(num, r.Denom(), mode), scale: scale}
+}
+
+// pow10 returns 10^n for n >= 0
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundQuo returns num/den rounded to an integer; den must be positive
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// Compare the discarded fraction with one half
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(den)
+
+	away := false
+	switch mode {
+	case HalfEven:
+		away = cmp > 0 || cmp == 0 && q.Bit(0) == 1
+	case HalfUp:
+		away = cmp >= 0
+	case Up:
+		away = true
+	case Floor:
+		away = num.Sign() < 0
+	case Ceiling:
+		away = num.Sign() > 0
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(num.Sign())))
+	}
+	return q
+}
+
+// int returns the coefficient, which is 0 for the zero Decimal
+func (d Decimal) int() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// Scale returns the number of decimal places
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// Rat returns d as a fraction
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(d.scale))
+}
+
+// Float64 returns the float64 nearest to d
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// Cmp compares d and e and returns -1, 0 or +1
+func (d Decimal) Cmp(e Decimal) int {
+	return d.Rat().Cmp(e.Rat())
+}
+
+// rescale returns the coefficients of d and e at their common scale
+func (d Decimal) rescale(e Decimal) (*big.Int, *big.Int, int) {
+	a, b := new(big.Int).Set(d.int()), new(big.Int).Set(e.int())
+	switch {
+	case d.scale < e.scale:
+		a.Mul(a, pow10(e.scale-d.scale))
+		return a, b, e.scale
+	case d.scale > e.scale:
+		b.Mul(b, pow10(d.scale-e.scale))
+	}
+	return a, b, d.scale
+}
+
+// Add returns d + e
+func (d Decimal) Add(e Decimal) Decimal {
+	a, b, scale := d.rescale(e)
+	return Decimal{coef: a.Add(a, b), scale: scale}
+}
+
+// Sub returns d - e
+func (d Decimal) Sub(e Decimal) Decimal {
+	a, b, scale := d.rescale(e)
+	return Decimal{coef: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns d * e
+func (d Decimal) Mul(e Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.int(), e.int()), scale: d.scale + e.scale}
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Quo returns d / e rounded to scale decimal places
+func (d Decimal) Quo(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if e.Sign() == 0 {
+		return Decimal{}, ErrDivideByZero
+	}
+	return DecimalFromRat(new(big.Rat).Quo(d.Rat(), e.Rat()), scale, mode), nil
+}
+
+// Round returns d rounded to scale decimal places. Rounding to more places
+// than d has appends zeros.
+func (d Decimal) Round(scale int, mode RoundingMode) Decimal {
+	if scale >= d.scale {
+		return Decimal{coef: new(big.Int).Mul(d.int(), pow10(scale-d.scale)), scale: scale}
+	}
+	return Decimal{coef: roundQuo(d.int(), pow10(d.scale-scale), mode), scale: scale}
+}
+
+// String formats d with all of its decimal places, e.g. "-12.50"
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
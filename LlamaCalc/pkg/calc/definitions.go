@@ -0,0 +1,98 @@
+package calc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"llamacalc/pkg/calc/expr"
+)
+
+// MaxCallDepth limits how deeply user-defined functions call each other
+const MaxCallDepth = 16
+
+// UserFunction is a function defined by an expression of its parameters,
+// e.g. margin(p, c) = (p - c)/p
+type UserFunction struct {
+	Name   string
+	Params []string
+	Body   expr.Node
+}
+
+// Definitions are user-defined functions and constants, by lowercase name.
+// Expressions evaluated with a context carrying Definitions can use them
+// like the registered operations and the constants pi and e.
+type Definitions struct {
+	Functions map[string]*UserFunction
+	Constants map[string]float64
+}
+
+// definitionsKey is the context key of the Definitions
+type definitionsKey struct{}
+
+// WithDefinitions returns a context in which expressions can use defs
+func WithDefinitions(ctx context.Context, defs *Definitions) context.Context {
+	return context.WithValue(ctx, definitionsKey{}, defs)
+}
+
+// DefinitionsFromContext returns the definitions of ctx, or nil
+func DefinitionsFromContext(ctx context.Context) *Definitions {
+	defs, _ := ctx.Value(definitionsKey{}).(*Definitions)
+	return defs
+}
+
+// function returns the user-defined function named name
+func (d *Definitions) function(name string) (*UserFunction, bool) {
+	if d == nil {
+		return nil, false
+	}
+	fn, ok := d.Functions[strings.ToLower(name)]
+	return fn, ok
+}
+
+// constant returns the value of the user-defined constant named name
+func (d *Definitions) constant(name string) (float64, bool) {
+	if d == nil {
+		return 0, false
+	}
+	value, ok := d.Constants[strings.ToLower(name)]
+	return value, ok
+}
+
+// callUser evaluates a user-defined function one level deeper than env
+func (env calculatorEnv) callUser(ctx context.Context, fn *UserFunction, args []float64) (float64, error) {
+	if env.depth >= MaxCallDepth {
+		return 0, fmt.Errorf("%w: user-defined functions nested deeper than %d levels", ErrInvalidInput, MaxCallDepth)
+	}
+	if len(args) != len(fn.Params) {
+		return 0, fmt.Errorf("%w: %s expects %d arguments, got %d", ErrInvalidInput, fn.Name, len(fn.Params), len(args))
+	}
+
+	inner := calculatorEnv{c: env.c, defs: env.defs, depth: env.depth + 1}
+	result, err := expr.Eval(ctx, fn.Body, paramsEnv{inner, fn.Params, args})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return 0, err
+		}
+		return 0, fmt.Errorf("in %s: %w", fn.Name, err)
+	}
+	return result, nil
+}
+
+// paramsEnv binds the parameters of a user-defined function. The body of
+// the function sees no other variables.
+type paramsEnv struct {
+	calculatorEnv
+	params []string
+	args   []float64
+}
+
+// Constant implements expr.Env
+func (env paramsEnv) Constant(name string) (float64, error) {
+	for i, param := range env.params {
+		if strings.EqualFold(name, param) {
+			return env.args[i], nil
+		}
+	}
+	return env.calculatorEnv.Constant(name)
+}
@@ -0,0 +1,164 @@
+package calc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"llamacalc/pkg/calc/expr"
+)
+
+// Constants are the named constants available in expressions
+var Constants = map[string]float64{
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+// Evaluate evaluates an arithmetic expression. Operators and functions are
+// the operations of the registry, so every step is validated and checked for
+// overflow like a single operation; only the final result is rounded.
+func (c *Calculator) Evaluate(ctx context.Context, expression string) CalculationResult {
+	return c.EvaluateWith(ctx, expression, nil)
+}
+
+// Variables returns the value of a name in an expression that is not a
+// constant, or an error if the name is unknown
+type Variables func(name string) (float64, error)
+
+// EvaluateWith evaluates an expression like Evaluate, resolving the names
+// that are not constants with variables
+func (c *Calculator) EvaluateWith(ctx context.Context, expression string, variables Variables) CalculationResult {
+	start := time.Now()
+
+	result, err := c.evaluate(ctx, expression, variables)
+	if err != nil {
+		return CalculationResult{
+			Value:     0,
+			Duration:  time.Since(start),
+			Operation: "Evaluate",
+			Error:     err,
+		}
+	}
+
+	return CalculationResult{
+		Value:     c.roundToPrecision(result),
+		Duration:  time.Since(start),
+		Operation: "Evaluate",
+		Error:     nil,
+	}
+}
+
+// evaluate parses and evaluates expression without rounding the result
+func (c *Calculator) evaluate(ctx context.Context, expression string, variables Variables) (float64, error) {
+	node, err := expr.Parse(expression)
+	if err != nil {
+		return 0, &FieldError{Field: "expression", Err: fmt.Errorf("%w: %v", ErrInvalidInput, err)}
+	}
+
+	var env expr.Env = calculatorEnv{c: c, defs: DefinitionsFromContext(ctx)}
+	if variables != nil {
+		env = variablesEnv{calculatorEnv{c: c, defs: DefinitionsFromContext(ctx)}, variables}
+	}
+	result, err := expr.Eval(ctx, node, env)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			return 0, err
+		}
+		return 0, &FieldError{Field: "expression", Err: err}
+	}
+
+	return result, nil
+}
+
+// calculatorEnv resolves expression functions through the calculator and
+// the user-defined functions and constants of defs
+type calculatorEnv struct {
+	c    *Calculator
+	defs *Definitions
+	// depth is the number of user-defined functions being evaluated
+	depth int
+}
+
+// Call implements expr.Env
+func (env calculatorEnv) Call(ctx context.Context, name string, args []float64) (float64, error) {
+	if fn, ok := env.defs.function(name); ok {
+		return env.callUser(ctx, fn, args)
+	}
+	result, _, err := env.c.apply(ctx, name, args)
+	return result, err
+}
+
+// Constant implements expr.Env
+func (env calculatorEnv) Constant(name string) (float64, error) {
+	if value, ok := Constants[strings.ToLower(name)]; ok {
+		return value, nil
+	}
+	if value, ok := env.defs.constant(name); ok {
+		return value, nil
+	}
+	return 0, fmt.Errorf("%w: unknown constant %s", ErrInvalidInput, name)
+}
+
+// Function parses expression as a function of the named variable, such as
+// "x^2 - 2" of "x", for numerical methods that evaluate it many times. The
+// function evaluates the expression like Evaluate, without rounding, and
+// reports errors with the value of the variable.
+func (c *Calculator) Function(expression, variable string) (func(ctx context.Context, x float64) (float64, error), error) {
+	name, err := expr.Parse(variable)
+	if ident, ok := name.(*expr.Ident); err != nil || !ok || ident.Name != variable {
+		return nil, &FieldError{Field: "variable", Err: fmt.Errorf("%w: %q is not a name", ErrInvalidInput, variable)}
+	}
+	if _, ok := Constants[strings.ToLower(variable)]; ok {
+		return nil, &FieldError{Field: "variable", Err: fmt.Errorf("%w: %s is a constant", ErrInvalidInput, variable)}
+	}
+	node, err := expr.Parse(expression)
+	if err != nil {
+		return nil, &FieldError{Field: "expression", Err: fmt.Errorf("%w: %v", ErrInvalidInput, err)}
+	}
+
+	return func(ctx context.Context, x float64) (float64, error) {
+		result, err := expr.Eval(ctx, node, variableEnv{calculatorEnv{c: c, defs: DefinitionsFromContext(ctx)}, variable, x})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+				return 0, err
+			}
+			return 0, fmt.Errorf("at %s = %g: %w", variable, x, err)
+		}
+		return result, nil
+	}, nil
+}
+
+// variableEnv binds a variable in addition to the constants
+type variableEnv struct {
+	calculatorEnv
+	name  string
+	value float64
+}
+
+// Constant implements expr.Env
+func (env variableEnv) Constant(name string) (float64, error) {
+	if strings.EqualFold(name, env.name) {
+		return env.value, nil
+	}
+	return env.calculatorEnv.Constant(name)
+}
+
+// variablesEnv resolves the names that are not constants with variables
+type variablesEnv struct {
+	calculatorEnv
+	variables Variables
+}
+
+// Constant implements expr.Env
+func (env variablesEnv) Constant(name string) (float64, error) {
+	if value, ok := Constants[strings.ToLower(name)]; ok {
+		return value, nil
+	}
+	if value, ok := env.defs.constant(name); ok {
+		return value, nil
+	}
+	return env.variables(name)
+}
@@ -0,0 +1,96 @@
+package expr
+
+import (
+	"context"
+	"fmt"
+)
+
+// Env resolves the functions and constants used by an expression
+type Env interface {
+	// Call applies the named function, or the function of an operator
+	Call(ctx context.Context, name string, args []float64) (float64, error)
+	// Constant returns the value of a named constant
+	Constant(name string) (float64, error)
+}
+
+// EvalError reports a failure while evaluating part of an expression
+type EvalError struct {
+	Offset int
+	Name   string
+	Err    error
+}
+
+// Error implements error
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("%s at position %d: %v", e.Name, e.Offset+1, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *EvalError) Unwrap() error {
+	return e.Err
+}
+
+// Eval evaluates a parsed expression
+func Eval(ctx context.Context, node Node, env Env) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	switch n := node.(type) {
+	case *Number:
+		return n.Value, nil
+
+	case *Ident:
+		value, err := env.Constant(n.Name)
+		if err != nil {
+			return 0, &EvalError{Offset: n.Offset, Name: n.Name, Err: err}
+		}
+		return value, nil
+
+	case *Unary:
+		x, err := Eval(ctx, n.X, env)
+		if err != nil {
+			return 0, err
+		}
+		switch n.Op {
+		case "-":
+			return -x, nil
+		case "+":
+			return x, nil
+		}
+		return call(ctx, env, n.Offset, UnaryOperators[n.Op], []float64{x})
+
+	case *Binary:
+		x, err := Eval(ctx, n.X, env)
+		if err != nil {
+			return 0, err
+		}
+		y, err := Eval(ctx, n.Y, env)
+		if err != nil {
+			return 0, err
+		}
+		return call(ctx, env, n.Offset, Operators[n.Op], []float64{x, y})
+
+	case *Call:
+		args := make([]float64, len(n.Args))
+		for i, arg := range n.Args {
+			value, err := Eval(ctx, arg, env)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = value
+		}
+		return call(ctx, env, n.Offset, n.Func, args)
+	}
+
+	return 0, fmt.Errorf("unsupported expression node %T", node)
+}
+
+// call applies a function and attributes errors to its position
+func call(ctx context.Context, env Env, offset int, name string, args []float64) (float64, error) {
+	value, err := env.Call(ctx, name, args)
+	if err != nil {
+		return 0, &EvalError{Offset: offset, Name: name, Err: err}
+	}
+	return value, nil
+}
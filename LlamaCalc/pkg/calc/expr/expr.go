@@ -0,0 +1,165 @@
+// Package expr parses and evaluates arithmetic expressions such as
+// "2 * sin(pi / 4) ^ 2".
+//
+// Expressions consist of decimal numbers, hexadecimal (0x), binary (0b) and
+// octal (0o) integers, named constants, the binary operators + - * / % and ^
+// (right-associative), the bitwise operators << >> & and |, unary + - and ~,
+// parentheses and function calls such as logbase(8, 2). Operators bind like
+// in C, except ^ which is a power. Operators and functions are resolved
+// through an Env, so an expression can use every operation an engine provides.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Limits on the expressions accepted by Parse
+const (
+	MaxLength = 4096
+	MaxDepth  = 100
+)
+
+// Operators lists the function each binary operator is evaluated with
+var Operators = map[string]string{
+	"+":  "Add",
+	"-":  "Subtract",
+	"*":  "Multiply",
+	"/":  "Divide",
+	"%":  "Mod",
+	"^":  "Pow",
+	"<<": "Shl",
+	">>": "Shr",
+	"&":  "And",
+	"|":  "Or",
+}
+
+// UnaryOperators lists the function each unary operator other than + and -
+// is evaluated with
+var UnaryOperators = map[string]string{
+	"~": "Not",
+}
+
+// Node is a node of a parsed expression
+type Node interface {
+	// Pos is the byte offset of the node in the source
+	Pos() int
+	// String formats the node as an expression
+	String() string
+}
+
+// Number is a numeric literal
+type Number struct {
+	Offset int
+	Value  float64
+	// Text is the literal as written, e.g. "0xff"; evaluators that work on
+	// integers parse it instead of Value, which may be rounded
+	Text string
+}
+
+// Ident is a named constant or variable, e.g. pi, x or $1
+type Ident struct {
+	Offset int
+	Name   string
+}
+
+// Unary is a unary operator applied to an operand
+type Unary struct {
+	Offset int
+	Op     string
+	X      Node
+}
+
+// Binary is an operator applied to two operands
+type Binary struct {
+	Offset int
+	Op     string
+	X, Y   Node
+}
+
+// Call is a function call
+type Call struct {
+	Offset int
+	Func   string
+	Args   []Node
+}
+
+// Pos implements Node
+func (n *Number) Pos() int { return n.Offset }
+
+// Pos implements Node
+func (n *Ident) Pos() int { return n.Offset }
+
+// Pos implements Node
+func (n *Unary) Pos() int { return n.Offset }
+
+// Pos implements Node
+func (n *Binary) Pos() int { return n.Offset }
+
+// Pos implements Node
+func (n *Call) Pos() int { return n.Offset }
+
+// String implements Node
+func (n *Number) String() string {
+	if n.Text != "" {
+		return n.Text
+	}
+	return strconv.FormatFloat(n.Value, 'g', -1, 64)
+}
+
+// String implements Node
+func (n *Ident) String() string { return n.Name }
+
+// String implements Node
+func (n *Unary) String() string { return fmt.Sprintf("(%s%s)", n.Op, n.X) }
+
+// String implements Node
+func (n *Binary) String() string { return fmt.Sprintf("(%s %s %s)", n.X, n.Op, n.Y) }
+
+// String implements Node
+func (n *Call) String() string {
+	s := n.Func + "("
+	for i, arg := range n.Args {
+		if i > 0 {
+			s += ", "
+		}
+		s += arg.String()
+	}
+	return s + ")"
+}
+
+// Functions returns the names of the functions an expression calls,
+// including those of its operators, in order of first use
+func Functions(node Node) []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	var walk func(Node)
+	walk = func(node Node) {
+		switch n := node.(type) {
+		case *Unary:
+			if name, ok := UnaryOperators[n.Op]; ok {
+				add(name)
+			}
+			walk(n.X)
+		case *Binary:
+			add(Operators[n.Op])
+			walk(n.X)
+			walk(n.Y)
+		case *Call:
+			add(n.Func)
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		}
+	}
+	walk(node)
+
+	return names
+}
@@ -0,0 +1,165 @@
+package expr_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"llamacalc/pkg/calc/expr"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		Src  string
+		Want string
+	}{
+		{"1 + 2 * 3", "(1 + (2 * 3))"},
+		{"(1 + 2) * 3", "((1 + 2) * 3)"},
+		{"1 - 2 - 3", "((1 - 2) - 3)"},
+		{"8 / 4 % 3", "((8 / 4) % 3)"},
+		{"2 ^ 3 ^ 2", "(2 ^ (3 ^ 2))"},
+		{"2 * 3 ^ 2", "(2 * (3 ^ 2))"},
+		{"-2 ^ 2", "(-(2 ^ 2))"},
+		{"-2 * 3", "((-2) * 3)"},
+		{"2 * -3", "(2 * (-3))"},
+		{"2 ^ -1", "(2 ^ (-1))"},
+		{"--1", "(-(-1))"},
+		{"+x", "(+x)"},
+		{"~0 + 1", "((~0) + 1)"},
+		{"1 << 2 + 3", "(1 << (2 + 3))"},
+		{"1 | 2 & 3", "(1 | (2 & 3))"},
+		{"a >> 1 & b", "((a >> 1) & b)"},
+		{"logbase(8, 2) * pi", "(logbase(8, 2) * pi)"},
+		{"rand()", "rand()"},
+		{"0xff + $1", "(0xff + $1)"},
+		{"1.5e-3*x", "(1.5e-3 * x)"},
+	}
+	for _, tc := range tests {
+		node, err := expr.Parse(tc.Src)
+		if err != nil {
+			t.Errorf("%q: %v", tc.Src, err)
+			continue
+		}
+		if got := node.String(); got != tc.Want {
+			t.Errorf("%q: got %s, want %s", tc.Src, got, tc.Want)
+		}
+	}
+}
+
+func TestParseDepth(t *testing.T) {
+	nested := func(depth int) string {
+		return strings.Repeat("(", depth) + "1" + strings.Repeat(")", depth)
+	}
+
+	// The whole expression is one level, each parenthesis another
+	if _, err := expr.Parse(nested(expr.MaxDepth - 1)); err != nil {
+		t.Errorf("got %v at the maximum depth", err)
+	}
+	_, err := expr.Parse(nested(expr.MaxDepth))
+	var syntaxErr *expr.SyntaxError
+	if !errors.As(err, &syntaxErr) || syntaxErr.Offset != expr.MaxDepth {
+		t.Errorf("got %v, want a syntax error at position %d", err, expr.MaxDepth+1)
+	}
+	if _, err := expr.Parse(strings.Repeat("-", expr.MaxDepth) + "1"); !errors.As(err, &syntaxErr) {
+		t.Errorf("got %v for nested unary operators, want a syntax error", err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		Src    string
+		Offset int
+	}{
+		{"", 0},
+		{"1 +", 3},
+		{"* 2", 0},
+		{"2 3", 2},
+		{"(1 + 2", 6},
+		{"1 + 2)", 5},
+		{"f(1 2)", 4},
+		{"f(1,)", 4},
+		{"1 # 2", 2},
+		{"0xzz", 0},
+		{"1..2", 0},
+		{strings.Repeat("1", expr.MaxLength+1), expr.MaxLength},
+	}
+	for _, tc := range tests {
+		node, err := expr.Parse(tc.Src)
+		var syntaxErr *expr.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("%q: got %v, %v, want a syntax error", tc.Src, node, err)
+			continue
+		}
+		if syntaxErr.Offset != tc.Offset {
+			t.Errorf("%q: got %v, want offset %d", tc.Src, err, tc.Offset)
+		}
+	}
+}
+
+// testEnv evaluates the arithmetic operators and the constant pi
+type testEnv struct{}
+
+var errUnknown = errors.New("unknown")
+
+func (testEnv) Call(ctx context.Context, name string, args []float64) (float64, error) {
+	switch name {
+	case "Add":
+		return args[0] + args[1], nil
+	case "Subtract":
+		return args[0] - args[1], nil
+	case "Multiply":
+		return args[0] * args[1], nil
+	case "Divide":
+		return args[0] / args[1], nil
+	case "Pow":
+		return math.Pow(args[0], args[1]), nil
+	}
+	return 0, fmt.Errorf("%w function %s", errUnknown, name)
+}
+
+func (testEnv) Constant(name string) (float64, error) {
+	if name == "pi" {
+		return math.Pi, nil
+	}
+	return 0, fmt.Errorf("%w constant %s", errUnknown, name)
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		Src  string
+		Want float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"2 ^ 3 ^ 2", 512},
+		{"-2 ^ 2", -4},
+		{"(-2) ^ 2", 4},
+		{"2 ^ -1", 0.5},
+		{"10 - 4 - 3", 3},
+		{"--3", 3},
+		{"2 * pi", 2 * math.Pi},
+	}
+	for _, tc := range tests {
+		node, err := expr.Parse(tc.Src)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.Src, err)
+		}
+		got, err := expr.Eval(context.Background(), node, testEnv{})
+		if err != nil || got != tc.Want {
+			t.Errorf("%q: got %v, %v, want %v", tc.Src, got, err, tc.Want)
+		}
+	}
+
+	// Errors carry the position of the failing part
+	node, err := expr.Parse("1 + sqrt(e)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = expr.Eval(context.Background(), node, testEnv{})
+	var evalErr *expr.EvalError
+	if !errors.As(err, &evalErr) || !errors.Is(err, errUnknown) || evalErr.Offset != 9 || evalErr.Name != "e" {
+		t.Errorf("got %v, want an error for e at position 10", err)
+	}
+}
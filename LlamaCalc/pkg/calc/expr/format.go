@@ -0,0 +1,92 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// operandPrecedence is the binding power of numbers, constants and calls
+const operandPrecedence = 8
+
+// Format formats an expression with only the parentheses needed to parse it
+// back into the same tree, e.g. "3*x^2 + 2*(x - 1)". Operators of lower
+// precedence than * are surrounded by spaces.
+func Format(node Node) string {
+	var b strings.Builder
+	format(&b, node)
+	return b.String()
+}
+
+// format writes node to b
+func format(b *strings.Builder, node Node) {
+	switch n := node.(type) {
+	case *Number:
+		if n.Value < 0 {
+			// Negative numbers are only created by rewriting expressions
+			b.WriteString("-")
+			b.WriteString(strconv.FormatFloat(-n.Value, 'g', -1, 64))
+			return
+		}
+		b.WriteString(n.String())
+
+	case *Ident:
+		b.WriteString(n.Name)
+
+	case *Unary:
+		b.WriteString(n.Op)
+		operand(b, n.X, bindingPower(n.X) <= unaryPrecedence)
+
+	case *Binary:
+		prec := precedence(n.Op)
+		// ^ is right-associative, the other operators are left-associative
+		left, right := bindingPower(n.X), bindingPower(n.Y)
+		operand(b, n.X, left < prec || left == prec && n.Op == "^")
+		if prec < precedence("*") {
+			b.WriteString(" " + n.Op + " ")
+		} else {
+			b.WriteString(n.Op)
+		}
+		operand(b, n.Y, right < prec || right == prec && n.Op != "^" || right == unaryPrecedence)
+
+	case *Call:
+		b.WriteString(n.Func)
+		b.WriteString("(")
+		for i, arg := range n.Args {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			format(b, arg)
+		}
+		b.WriteString(")")
+
+	default:
+		b.WriteString(node.String())
+	}
+}
+
+// bindingPower returns how tightly the formatted node binds to operators
+// next to it
+func bindingPower(node Node) int {
+	switch n := node.(type) {
+	case *Number:
+		if n.Value < 0 {
+			return unaryPrecedence
+		}
+	case *Unary:
+		return unaryPrecedence
+	case *Binary:
+		return precedence(n.Op)
+	}
+	return operandPrecedence
+}
+
+// operand writes an operand, in parentheses if parens is set
+func operand(b *strings.Builder, node Node, parens bool) {
+	if parens {
+		b.WriteString("(")
+	}
+	format(b, node)
+	if parens {
+		b.WriteString(")")
+	}
+}
@@ -0,0 +1,319 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SyntaxError reports an expression that cannot be parsed
+type SyntaxError struct {
+	Offset int
+	Msg    string
+}
+
+// Error implements error
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at position %d: %s", e.Offset+1, e.Msg)
+}
+
+// Parse parses an expression
+func Parse(src string) (Node, error) {
+	if len(src) > MaxLength {
+		return nil, &SyntaxError{Offset: MaxLength, Msg: fmt.Sprintf("expression longer than %d bytes", MaxLength)}
+	}
+
+	p := &parser{src: src}
+	p.next()
+
+	node, err := p.parseExpr(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+
+	return node, nil
+}
+
+// tokenKind is the kind of a lexical token
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+	tokLParen
+	tokRParen
+	tokComma
+	tokInvalid
+)
+
+// token is a lexical token
+type token struct {
+	kind   tokenKind
+	offset int
+	text   string
+}
+
+// String describes the token in error messages
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// parser is a precedence climbing parser
+type parser struct {
+	src   string
+	pos   int
+	tok   token
+	depth int
+}
+
+// precedence returns the binding power of a binary operator
+func precedence(op string) int {
+	switch op {
+	case "|":
+		return 1
+	case "&":
+		return 2
+	case "<<", ">>":
+		return 3
+	case "+", "-":
+		return 4
+	case "*", "/", "%":
+		return 5
+	case "^":
+		return 7
+	}
+	return 0
+}
+
+// unaryPrecedence binds unary operators tighter than * but looser than ^,
+// so that -2^2 is -(2^2)
+const unaryPrecedence = 6
+
+// parseExpr parses operators binding tighter than minPrec
+func (p *parser) parseExpr(minPrec int) (Node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > MaxDepth {
+		return nil, p.errorf("expression nested deeper than %d levels", MaxDepth)
+	}
+
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.tok.kind == tokOp {
+		op := p.tok.text
+		prec := precedence(op)
+		if prec <= minPrec {
+			break
+		}
+		offset := p.tok.offset
+		p.next()
+
+		// ^ is right-associative
+		next := prec
+		if op == "^" {
+			next = prec - 1
+		}
+
+		y, err := p.parseExpr(next)
+		if err != nil {
+			return nil, err
+		}
+		x = &Binary{Offset: offset, Op: op, X: x, Y: y}
+	}
+
+	return x, nil
+}
+
+// parseUnary parses an operand with optional unary operator
+func (p *parser) parseUnary() (Node, error) {
+	if p.tok.kind == tokOp && (p.tok.text == "-" || p.tok.text == "+" || p.tok.text == "~") {
+		op := p.tok.text
+		offset := p.tok.offset
+		p.next()
+
+		x, err := p.parseExpr(unaryPrecedence)
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Offset: offset, Op: op, X: x}, nil
+	}
+
+	return p.parseOperand()
+}
+
+// parseOperand parses a number, constant, call or parenthesized expression
+func (p *parser) parseOperand() (Node, error) {
+	tok := p.tok
+
+	switch tok.kind {
+	case tokNumber:
+		p.next()
+		value, err := parseNumber(tok.text)
+		if err != nil {
+			return nil, &SyntaxError{Offset: tok.offset, Msg: fmt.Sprintf("invalid number %q", tok.text)}
+		}
+		return &Number{Offset: tok.offset, Value: value, Text: tok.text}, nil
+
+	case tokIdent:
+		p.next()
+		if p.tok.kind != tokLParen {
+			return &Ident{Offset: tok.offset, Name: tok.text}, nil
+		}
+		p.next()
+
+		call := &Call{Offset: tok.offset, Func: tok.text}
+		if p.tok.kind == tokRParen {
+			p.next()
+			return call, nil
+		}
+		for {
+			arg, err := p.parseExpr(0)
+			if err != nil {
+				return nil, err
+			}
+			call.Args = append(call.Args, arg)
+
+			if p.tok.kind == tokComma {
+				p.next()
+				continue
+			}
+			if p.tok.kind != tokRParen {
+				return nil, p.errorf("expected \",\" or \")\", found %s", p.tok)
+			}
+			p.next()
+			return call, nil
+		}
+
+	case tokLParen:
+		p.next()
+		x, err := p.parseExpr(0)
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, p.errorf("expected \")\", found %s", p.tok)
+		}
+		p.next()
+		return x, nil
+	}
+
+	return nil, p.errorf("unexpected %s", tok)
+}
+
+// errorf reports a syntax error at the current token
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: p.tok.offset, Msg: fmt.Sprintf(format, args...)}
+}
+
+// next advances to the next token
+func (p *parser) next() {
+	for p.pos < len(p.src) && isSpace(p.src[p.pos]) {
+		p.pos++
+	}
+
+	start := p.pos
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokEOF, offset: start}
+		return
+	}
+
+	c := p.src[p.pos]
+	switch {
+	case isDigit(c) || c == '.':
+		p.scanNumber()
+		p.tok = token{kind: tokNumber, offset: start, text: p.src[start:p.pos]}
+	case isLetter(c):
+		for p.pos < len(p.src) && (isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok = token{kind: tokIdent, offset: start, text: p.src[start:p.pos]}
+	case c == '$' && p.pos+1 < len(p.src) && isDigit(p.src[p.pos+1]):
+		// $1, $2, ... name previous results, e.g. in sessions
+		p.pos++
+		for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+			p.pos++
+		}
+		p.tok = token{kind: tokIdent, offset: start, text: p.src[start:p.pos]}
+	case (c == '<' || c == '>') && p.pos+1 < len(p.src) && p.src[p.pos+1] == c:
+		p.pos += 2
+		p.tok = token{kind: tokOp, offset: start, text: p.src[start:p.pos]}
+	case precedence(string(c)) > 0 || c == '~':
+		p.pos++
+		p.tok = token{kind: tokOp, offset: start, text: string(c)}
+	case c == '(':
+		p.pos++
+		p.tok = token{kind: tokLParen, offset: start, text: "("}
+	case c == ')':
+		p.pos++
+		p.tok = token{kind: tokRParen, offset: start, text: ")"}
+	case c == ',':
+		p.pos++
+		p.tok = token{kind: tokComma, offset: start, text: ","}
+	default:
+		p.pos++
+		p.tok = token{kind: tokInvalid, offset: start, text: string(c)}
+	}
+}
+
+// parseNumber parses a decimal number or a prefixed integer
+func parseNumber(text string) (float64, error) {
+	if isPrefixed(text, 0) {
+		n, err := strconv.ParseUint(text, 0, 64)
+		return float64(n), err
+	}
+	return strconv.ParseFloat(text, 64)
+}
+
+// isPrefixed reports whether src has an integer base prefix at pos
+func isPrefixed(src string, pos int) bool {
+	if pos+1 >= len(src) || src[pos] != '0' {
+		return false
+	}
+	switch src[pos+1] {
+	case 'x', 'X', 'b', 'B', 'o', 'O':
+		return true
+	}
+	return false
+}
+
+// scanNumber advances over a hexadecimal, binary or octal integer, or a
+// decimal number with optional exponent
+func (p *parser) scanNumber() {
+	if isPrefixed(p.src, p.pos) {
+		p.pos += 2
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || isLetter(p.src[p.pos])) {
+			p.pos++
+		}
+		return
+	}
+
+	for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+		p.pos++
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		end := p.pos + 1
+		if end < len(p.src) && (p.src[end] == '+' || p.src[end] == '-') {
+			end++
+		}
+		if end < len(p.src) && isDigit(p.src[end]) {
+			p.pos = end
+			for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+				p.pos++
+			}
+		}
+	}
+}
+
+func isSpace(c byte) bool  { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' }
@@ -0,0 +1,501 @@
+package calc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Limits of integer operations
+const (
+	// MaxIntegerBits bounds the size of integer operands and results;
+	// larger results are reported as overflow
+	MaxIntegerBits = 8192
+	// MaxIntegerWork bounds the time an integer operation may run when the
+	// caller's context has no earlier deadline
+	MaxIntegerWork = 10 * time.Second
+)
+
+// IntegerFunc computes the results of an integer operation. Functions must
+// not modify their arguments, and long-running functions must stop with the
+// context error once ctx is done.
+type IntegerFunc func(ctx context.Context, args []*big.Int) ([]*big.Int, error)
+
+// IntegerOperation is a named calculation on integers of any size
+type IntegerOperation struct {
+	OperationInfo
+	// Func computes the results
+	Func IntegerFunc
+}
+
+// registration implements registrable
+func (op *IntegerOperation) registration() (*OperationInfo, bool) {
+	if op == nil {
+		return nil, false
+	}
+	return &op.OperationInfo, op.Func != nil
+}
+
+// IntegerResult contains the result of an integer calculation. Most
+// operations have a single result; DivMod and QuoRem return the quotient and
+// the remainder, and Factorize returns the prime factors.
+type IntegerResult struct {
+	Values    []*big.Int
+	Duration  time.Duration
+	Operation string
+	Error     error
+}
+
+// integerPattern matches decimal integers
+var integerPattern = regexp.MustCompile(`^[+-]?\d+$`)
+
+// ParseInteger parses a decimal integer such as "-9007199254740993"
+func ParseInteger(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > MaxIntegerBits || !integerPattern.MatchString(s) {
+		return nil, fmt.Errorf("%w: %.20q is not a decimal integer of at most %d bits", ErrInvalidInput, s, MaxIntegerBits)
+	}
+
+	n, _ := new(big.Int).SetString(s, 10)
+	if n.BitLen() > MaxIntegerBits {
+		return nil, fmt.Errorf("%w: %.20q is not a decimal integer of at most %d bits", ErrInvalidInput, s, MaxIntegerBits)
+	}
+	return n, nil
+}
+
+// Integer performs the named integer operation from the registry on args,
+// which are parsed with ParseInteger. The operation stops when ctx is done
+// or after MaxIntegerWork, whichever comes first.
+func (c *Calculator) Integer(ctx context.Context, name string, args ...string) IntegerResult {
+	start := time.Now()
+
+	ctx, cancel := context.WithTimeout(ctx, MaxIntegerWork)
+	defer cancel()
+
+	results, operation, err := c.applyInteger(ctx, name, args)
+	if err != nil {
+		return IntegerResult{
+			Duration:  time.Since(start),
+			Operation: operation,
+			Error:     err,
+		}
+	}
+
+	return IntegerResult{
+		Values:    results,
+		Duration:  time.Since(start),
+		Operation: operation,
+		Error:     nil,
+	}
+}
+
+// applyInteger parses the arguments and performs the named integer operation
+func (c *Calculator) applyInteger(ctx context.Context, name string, args []string) ([]*big.Int, string, error) {
+	op, ok := c.Registry.LookupInteger(name)
+	if !ok {
+		return nil, name, &FieldError{Field: "operation", Err: ErrUnknownOperation}
+	}
+
+	// Validate inputs
+	if err := op.checkArity(len(args)); err != nil {
+		return nil, op.Name, err
+	}
+	values := make([]*big.Int, len(args))
+	for i, arg := range args {
+		value, err := ParseInteger(arg)
+		if err != nil {
+			return nil, op.Name, &FieldError{Field: op.Param(i), Err: err}
+		}
+		values[i] = value
+	}
+
+	// Perform calculation
+	results, err := op.Func(ctx, values)
+	if err != nil {
+		return nil, op.Name, err
+	}
+	for _, result := range results {
+		if err := checkInteger(result); err != nil {
+			return nil, op.Name, err
+		}
+	}
+
+	return results, op.Name, nil
+}
+
+// checkInteger reports overflow or underflow if n exceeds MaxIntegerBits
+func checkInteger(n *big.Int) error {
+	if n.BitLen() <= MaxIntegerBits {
+		return nil
+	}
+	if n.Sign() < 0 {
+		return ErrUnderflow
+	}
+	return ErrOverflow
+}
+
+// stopped reports the context error of an operation that did not finish
+func stopped(ctx context.Context, name string) error {
+	return fmt.Errorf("%s stopped before it finished: %w", name, ctx.Err())
+}
+
+// binaryInteger adapts a two-argument function with a single result
+func binaryInteger(fn func(a, b *big.Int) (*big.Int, error)) IntegerFunc {
+	return func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+		result, err := fn(args[0], args[1])
+		if err != nil {
+			return nil, err
+		}
+		return []*big.Int{result}, nil
+	}
+}
+
+// modulus checks that m can be used as a modulus
+func modulus(field string, m *big.Int) error {
+	switch m.Sign() {
+	case 0:
+		return &FieldError{Field: field, Err: ErrDivideByZero}
+	case -1:
+		return &FieldError{Field: field, Err: fmt.Errorf("%w: the modulus must be positive", ErrInvalidInput)}
+	}
+	return nil
+}
+
+// divMod returns the quotient rounded towards negative infinity and the
+// remainder, which has the sign of the divisor
+func divMod(a, b *big.Int) []*big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	if r.Sign() != 0 && r.Sign() != b.Sign() {
+		q.Sub(q, big.NewInt(1))
+		r.Add(r, b)
+	}
+	return []*big.Int{q, r}
+}
+
+// modInverse returns the inverse of a modulo m
+func modInverse(a, m *big.Int) (*big.Int, error) {
+	if err := modulus("m", m); err != nil {
+		return nil, err
+	}
+	if m.Cmp(big.NewInt(1)) == 0 {
+		return new(big.Int), nil
+	}
+
+	inv := new(big.Int).ModInverse(new(big.Int).Mod(a, m), m)
+	if inv == nil {
+		return nil, &FieldError{Field: "a", Err: fmt.Errorf("%w: %v has no inverse modulo %v", ErrDomain, a, m)}
+	}
+	return inv, nil
+}
+
+// modPow returns a**e mod m. Negative exponents raise the inverse of a.
+func modPow(a, e, m *big.Int) (*big.Int, error) {
+	if err := modulus("m", m); err != nil {
+		return nil, err
+	}
+
+	if e.Sign() < 0 {
+		inv, err := modInverse(a, m)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).Exp(inv, new(big.Int).Neg(e), m), nil
+	}
+	return new(big.Int).Exp(a, e, m), nil
+}
+
+// factorial returns n!
+func factorial(ctx context.Context, n *big.Int) (*big.Int, error) {
+	if n.Sign() < 0 {
+		return nil, domainError("n")
+	}
+	// n! > 2**n for n >= 4
+	if n.Cmp(big.NewInt(MaxIntegerBits)) > 0 {
+		return nil, ErrOverflow
+	}
+
+	result := big.NewInt(1)
+	for i := int64(2); i <= n.Int64(); i++ {
+		result.Mul(result, big.NewInt(i))
+		if result.BitLen() > MaxIntegerBits {
+			return nil, ErrOverflow
+		}
+		if ctx.Err() != nil {
+			return nil, stopped(ctx, "Factorial")
+		}
+	}
+	return result, nil
+}
+
+// binomial returns the binomial coefficient n choose k, which is 0 unless
+// 0 <= k <= n
+func binomial(ctx context.Context, n, k *big.Int) (*big.Int, error) {
+	if n.Sign() < 0 {
+		return nil, domainError("n")
+	}
+	if k.Sign() < 0 || k.Cmp(n) > 0 {
+		return new(big.Int), nil
+	}
+	if rest := new(big.Int).Sub(n, k); rest.Cmp(k) < 0 {
+		k = rest
+	}
+	// C(n, k) >= 2**k for n >= 2k
+	if k.Cmp(big.NewInt(MaxIntegerBits)) > 0 {
+		return nil, ErrOverflow
+	}
+
+	// C(n, i) = C(n, i-1) * (n-i+1) / i is exact at every step
+	result := big.NewInt(1)
+	factor := new(big.Int)
+	for i := int64(1); i <= k.Int64(); i++ {
+		factor.Sub(n, big.NewInt(i-1))
+		result.Mul(result, factor)
+		result.Quo(result, big.NewInt(i))
+		if result.BitLen() > MaxIntegerBits {
+			return nil, ErrOverflow
+		}
+		if ctx.Err() != nil {
+			return nil, stopped(ctx, "Binomial")
+		}
+	}
+	return result, nil
+}
+
+// primeBases are the Miller–Rabin bases used by isPrime; together they
+// decide primality exactly for n < 3.3e24
+var primeBases = []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53, 59, 61, 67, 71}
+
+// isPrime tests n with trial division by small primes, Miller–Rabin with
+// primeBases, and for larger n the Baillie–PSW test of math/big, for which
+// no composite passing it is known
+func isPrime(ctx context.Context, n *big.Int) (bool, error) {
+	if n.Cmp(big.NewInt(2)) < 0 {
+		return false, nil
+	}
+	for _, p := range primeBases {
+		if n.Cmp(big.NewInt(p)) == 0 {
+			return true, nil
+		}
+		if new(big.Int).Mod(n, big.NewInt(p)).Sign() == 0 {
+			return false, nil
+		}
+	}
+
+	// n - 1 = d * 2**s with d odd
+	one := big.NewInt(1)
+	nm1 := new(big.Int).Sub(n, one)
+	s := nm1.TrailingZeroBits()
+	d := new(big.Int).Rsh(nm1, s)
+
+	x := new(big.Int)
+bases:
+	for _, base := range primeBases {
+		if ctx.Err() != nil {
+			return false, stopped(ctx, "IsPrime")
+		}
+
+		x.Exp(big.NewInt(base), d, n)
+		if x.Cmp(one) == 0 || x.Cmp(nm1) == 0 {
+			continue
+		}
+		for i := uint(1); i < s; i++ {
+			x.Mul(x, x).Mod(x, n)
+			if x.Cmp(nm1) == 0 {
+				continue bases
+			}
+		}
+		return false, nil
+	}
+
+	if n.BitLen() <= 64 {
+		return true, nil
+	}
+	if ctx.Err() != nil {
+		return false, stopped(ctx, "IsPrime")
+	}
+	return n.ProbablyPrime(0), nil
+}
+
+// factorize returns the prime factors of n >= 2 in ascending order, with
+// repetitions. Small factors are found by trial division and the others
+// with Pollard's rho method in Brent's variant.
+func factorize(ctx context.Context, n *big.Int) ([]*big.Int, error) {
+	if n.Cmp(big.NewInt(2)) < 0 {
+		return nil, &FieldError{Field: "n", Err: fmt.Errorf("%w: only integers from 2 on have a prime factorization", ErrDomain)}
+	}
+
+	var factors []*big.Int
+	rest := new(big.Int).Set(n)
+	q, r := new(big.Int), new(big.Int)
+	for p := int64(2); p < 1000; p++ {
+		for bp := big.NewInt(p); ; {
+			q.QuoRem(rest, bp, r)
+			if r.Sign() != 0 {
+				break
+			}
+			factors = append(factors, bp)
+			rest.Set(q)
+		}
+	}
+
+	pending := []*big.Int{rest}
+	for len(pending) > 0 {
+		m := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if m.Cmp(big.NewInt(1)) == 0 {
+			continue
+		}
+
+		prime, err := isPrime(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if prime {
+			factors = append(factors, m)
+			continue
+		}
+
+		d, err := pollardRho(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, d, new(big.Int).Quo(m, d))
+	}
+
+	sort.Slice(factors, func(i, j int) bool { return factors[i].Cmp(factors[j]) < 0 })
+	return factors, nil
+}
+
+// pollardRho returns a non-trivial divisor of the composite n, trying the
+// polynomials x**2 + c for c = 1, 2, ... until one succeeds
+func pollardRho(ctx context.Context, n *big.Int) (*big.Int, error) {
+	const batch = 128
+
+	one := big.NewInt(1)
+	f := func(x, c *big.Int) { x.Mul(x, x).Add(x, c).Mod(x, n) }
+	diff := new(big.Int)
+
+	for c := big.NewInt(1); ; c.Add(c, one) {
+		y, x, ys := big.NewInt(2), new(big.Int), new(big.Int)
+		g, q := big.NewInt(1), big.NewInt(1)
+
+		for r := 1; g.Cmp(one) == 0; r *= 2 {
+			x.Set(y)
+			for i := 0; i < r; i++ {
+				if i%batch == 0 && ctx.Err() != nil {
+					return nil, stopped(ctx, "Factorize")
+				}
+				f(y, c)
+			}
+			for k := 0; k < r && g.Cmp(one) == 0; k += batch {
+				if ctx.Err() != nil {
+					return nil, stopped(ctx, "Factorize")
+				}
+				ys.Set(y)
+				for i := 0; i < batch && i < r-k; i++ {
+					f(y, c)
+					q.Mul(q, diff.Sub(x, y).Abs(diff)).Mod(q, n)
+				}
+				g.GCD(nil, nil, q, n)
+			}
+		}
+
+		// The batch overshot; retrace it one step at a time
+		if g.Cmp(n) == 0 {
+			for {
+				f(ys, c)
+				g.GCD(nil, nil, diff.Sub(x, ys).Abs(diff), n)
+				if g.Cmp(one) != 0 {
+					break
+				}
+			}
+		}
+		if g.Cmp(n) != 0 {
+			return g, nil
+		}
+	}
+}
+
+// integerOperations returns the operations on integers of any size
+func integerOperations() []*IntegerOperation {
+	ops := []*IntegerOperation{
+		{OperationInfo: signature("Add", "a", "b"), Func: binaryInteger(func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Add(a, b), nil
+		})},
+		{OperationInfo: signature("Subtract", "a", "b"), Func: binaryInteger(func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Sub(a, b), nil
+		})},
+		{OperationInfo: signature("Multiply", "a", "b"), Func: binaryInteger(func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).Mul(a, b), nil
+		})},
+		{OperationInfo: signature("DivMod", "a", "b"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			if args[1].Sign() == 0 {
+				return nil, &FieldError{Field: "b", Err: ErrDivideByZero}
+			}
+			return divMod(args[0], args[1]), nil
+		}},
+		{OperationInfo: signature("QuoRem", "a", "b"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			if args[1].Sign() == 0 {
+				return nil, &FieldError{Field: "b", Err: ErrDivideByZero}
+			}
+			q, r := new(big.Int).QuoRem(args[0], args[1], new(big.Int))
+			return []*big.Int{q, r}, nil
+		}},
+		{OperationInfo: signature("GCD", "a", "b"), Func: binaryInteger(func(a, b *big.Int) (*big.Int, error) {
+			return new(big.Int).GCD(nil, nil, a, b), nil
+		})},
+		{OperationInfo: signature("LCM", "a", "b"), Func: binaryInteger(func(a, b *big.Int) (*big.Int, error) {
+			if a.Sign() == 0 || b.Sign() == 0 {
+				return new(big.Int), nil
+			}
+			lcm := new(big.Int).Quo(a, new(big.Int).GCD(nil, nil, a, b))
+			return lcm.Mul(lcm, b).Abs(lcm), nil
+		})},
+		{OperationInfo: signature("ModPow", "a", "e", "m"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			result, err := modPow(args[0], args[1], args[2])
+			if err != nil {
+				return nil, err
+			}
+			return []*big.Int{result}, nil
+		}},
+		{OperationInfo: signature("ModInverse", "a", "m"), Func: binaryInteger(modInverse)},
+		{OperationInfo: signature("Factorial", "n"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			result, err := factorial(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return []*big.Int{result}, nil
+		}},
+		{OperationInfo: signature("Binomial", "n", "k"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			result, err := binomial(ctx, args[0], args[1])
+			if err != nil {
+				return nil, err
+			}
+			return []*big.Int{result}, nil
+		}},
+		{OperationInfo: signature("IsPrime", "n"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			prime, err := isPrime(ctx, args[0])
+			if err != nil {
+				return nil, err
+			}
+			if prime {
+				return []*big.Int{big.NewInt(1)}, nil
+			}
+			return []*big.Int{big.NewInt(0)}, nil
+		}},
+		{OperationInfo: signature("Factorize", "n"), Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			return factorize(ctx, args[0])
+		}},
+	}
+
+	for _, op := range ops {
+		op.Role = RoleUser
+		op.Module = "integer"
+	}
+
+	return ops
+}
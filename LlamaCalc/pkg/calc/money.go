@@ -0,0 +1,287 @@
+package calc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxSplitParts limits the number of parts of Allocate and Split
+const MaxSplitParts = 10000
+
+// Currency is an ISO 4217 currency
+type Currency struct {
+	// Code is the alphabetic code, e.g. "EUR"
+	Code string
+	// Digits is the number of minor units, e.g. 2 for cents
+	Digits int
+}
+
+// currencyDigits are the minor units of the supported ISO 4217 currencies
+var currencyDigits = map[string]int{
+	"AED": 2, "ARS": 2, "AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2, "CAD": 2,
+	"CHF": 2, "CLP": 0, "CNY": 2, "COP": 2, "CZK": 2, "DKK": 2, "EGP": 2,
+	"EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2,
+	"IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0, "KWD": 3, "LYD": 3,
+	"MXN": 2, "MYR": 2, "NGN": 2, "NOK": 2, "NZD": 2, "OMR": 3, "PHP": 2,
+	"PKR": 2, "PLN": 2, "PYG": 0, "RON": 2, "SAR": 2, "SEK": 2, "SGD": 2,
+	"THB": 2, "TND": 3, "TRY": 2, "TWD": 2, "UAH": 2, "UGX": 0, "USD": 2,
+	"VND": 0, "XAF": 0, "XOF": 0, "ZAR": 2,
+}
+
+// LookupCurrency returns the currency with an ISO 4217 code such as "usd"
+func LookupCurrency(code string) (Currency, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	digits, ok := currencyDigits[code]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: unknown currency %q", ErrInvalidInput, code)
+	}
+	return Currency{Code: code, Digits: digits}, nil
+}
+
+// Money is an amount in a currency. The amount always has the number of
+// decimal places of the currency.
+type Money struct {
+	Currency Currency
+	Amount   Decimal
+}
+
+// ParseMoney parses an amount such as "12.50" in the currency with the
+// given code. Amounts with more decimal places than the currency has minor
+// units are rejected, unless the extra places are zeros.
+func ParseMoney(code, amount string) (Money, error) {
+	currency, err := LookupCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+	value, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	rounded := value.Round(currency.Digits, Down)
+	if rounded.Cmp(value) != 0 {
+		return Money{}, fmt.Errorf("%w: %s has %d decimal places, got %s", ErrInvalidInput, currency.Code, currency.Digits, amount)
+	}
+	return Money{Currency: currency, Amount: rounded}, nil
+}
+
+// String formats m as e.g. "12.50 USD"
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency.Code
+}
+
+// minor returns the amount in minor units, e.g. cents
+func (m Money) minor() *big.Int {
+	return m.Amount.Round(m.Currency.Digits, Down).int()
+}
+
+// fromMinor returns an amount in minor units of currency
+func fromMinor(currency Currency, units *big.Int) Money {
+	return Money{Currency: currency, Amount: NewDecimal(units, currency.Digits)}
+}
+
+// sameCurrency checks that b is in the currency of a
+func sameCurrency(a, b Money) error {
+	if a.Currency != b.Currency {
+		return fmt.Errorf("%w: cannot combine %s with %s", ErrCurrencyMismatch, a.Currency.Code, b.Currency.Code)
+	}
+	return nil
+}
+
+// Add returns m + n, which must be in the same currency
+func (m Money) Add(n Money) (Money, error) {
+	if err := sameCurrency(m, n); err != nil {
+		return Money{}, err
+	}
+	return Money{Currency: m.Currency, Amount: m.Amount.Add(n.Amount)}, nil
+}
+
+// Sub returns m - n, which must be in the same currency
+func (m Money) Sub(n Money) (Money, error) {
+	if err := sameCurrency(m, n); err != nil {
+		return Money{}, err
+	}
+	return Money{Currency: m.Currency, Amount: m.Amount.Sub(n.Amount)}, nil
+}
+
+// Mul returns m * factor rounded to the minor units of the currency
+func (m Money) Mul(factor Decimal, mode RoundingMode) Money {
+	return Money{Currency: m.Currency, Amount: m.Amount.Mul(factor).Round(m.Currency.Digits, mode)}
+}
+
+// Allocate distributes m in proportion to ratios, which must not be
+// negative and must not all be zero. The parts add up to m exactly: each
+// part is rounded towards zero to minor units, and the remaining minor
+// units go one each to the parts with the largest rounding loss, earlier
+// parts first.
+func (m Money) Allocate(ratios []Decimal) ([]Money, error) {
+	if len(ratios) == 0 || len(ratios) > MaxSplitParts {
+		return nil, fmt.Errorf("%w: allocations have between 1 and %d parts", ErrInvalidInput, MaxSplitParts)
+	}
+
+	total := new(big.Rat)
+	for i, ratio := range ratios {
+		if ratio.Sign() < 0 {
+			return nil, &FieldError{Field: fmt.Sprintf("ratios[%d]", i), Err: fmt.Errorf("%w: ratios must not be negative", ErrInvalidInput)}
+		}
+		total.Add(total, ratio.Rat())
+	}
+	if total.Sign() == 0 {
+		return nil, &FieldError{Field: "ratios", Err: fmt.Errorf("%w: ratios must not all be zero", ErrInvalidInput)}
+	}
+
+	units := m.minor()
+	remaining := new(big.Int).Abs(units)
+	shares := make([]*big.Int, len(ratios))
+	losses := make([]*big.Rat, len(ratios))
+	for i, ratio := range ratios {
+		exact := new(big.Rat).SetInt(new(big.Int).Abs(units))
+		exact.Mul(exact, ratio.Rat()).Quo(exact, total)
+		shares[i] = new(big.Int).Quo(exact.Num(), exact.Denom())
+		losses[i] = exact.Sub(exact, new(big.Rat).SetInt(shares[i]))
+		remaining.Sub(remaining, shares[i])
+	}
+
+	// Fewer minor units remain than there are parts with a loss
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return losses[order[a]].Cmp(losses[order[b]]) > 0
+	})
+	for _, i := range order[:remaining.Int64()] {
+		shares[i].Add(shares[i], big.NewInt(1))
+	}
+
+	parts := make([]Money, len(shares))
+	for i, share := range shares {
+		if units.Sign() < 0 {
+			share.Neg(share)
+		}
+		parts[i] = fromMinor(m.Currency, share)
+	}
+	return parts, nil
+}
+
+// Split divides m into n parts that differ by at most one minor unit and
+// add up to m exactly; earlier parts get the larger amounts
+func (m Money) Split(n int) ([]Money, error) {
+	if n < 1 || n > MaxSplitParts {
+		return nil, &FieldError{Field: "n", Err: fmt.Errorf("%w: splits have between 1 and %d parts", ErrInvalidInput, MaxSplitParts)}
+	}
+	ratios := make([]Decimal, n)
+	for i := range ratios {
+		ratios[i] = NewDecimal(big.NewInt(1), 0)
+	}
+	return m.Allocate(ratios)
+}
+
+// moneyOperation is an operation on amounts of money and decimal numbers
+type moneyOperation struct {
+	name string
+	// amounts and numbers are the numbers of arguments of each kind; a
+	// Variadic number of numbers means at least one
+	amounts, numbers int
+	fn               func(amounts []Money, numbers []Decimal, mode RoundingMode) ([]Money, error)
+}
+
+// moneyOperations are the operations of Calculator.Money by lower-case name
+var moneyOperations = map[string]*moneyOperation{
+	"add": {name: "Add", amounts: 2, fn: func(amounts []Money, _ []Decimal, _ RoundingMode) ([]Money, error) {
+		sum, err := amounts[0].Add(amounts[1])
+		return []Money{sum}, err
+	}},
+	"subtract": {name: "Subtract", amounts: 2, fn: func(amounts []Money, _ []Decimal, _ RoundingMode) ([]Money, error) {
+		difference, err := amounts[0].Sub(amounts[1])
+		return []Money{difference}, err
+	}},
+	"multiply": {name: "Multiply", amounts: 1, numbers: 1, fn: func(amounts []Money, numbers []Decimal, mode RoundingMode) ([]Money, error) {
+		return []Money{amounts[0].Mul(numbers[0], mode)}, nil
+	}},
+	"allocate": {name: "Allocate", amounts: 1, numbers: Variadic, fn: func(amounts []Money, numbers []Decimal, _ RoundingMode) ([]Money, error) {
+		return amounts[0].Allocate(numbers)
+	}},
+	"split": {name: "Split", amounts: 1, numbers: 1, fn: func(amounts []Money, numbers []Decimal, _ RoundingMode) ([]Money, error) {
+		n := numbers[0]
+		if n.Round(0, Down).Cmp(n) != 0 || n.Cmp(NewDecimal(big.NewInt(MaxSplitParts), 0)) > 0 {
+			return nil, &FieldError{Field: "n", Err: fmt.Errorf("%w: splits have between 1 and %d parts", ErrInvalidInput, MaxSplitParts)}
+		}
+		return amounts[0].Split(int(n.Round(0, Down).int().Int64()))
+	}},
+}
+
+// MoneyResult contains the result of a money calculation
+type MoneyResult struct {
+	// Values holds one amount, or the parts of Allocate and Split
+	Values    []Money
+	Duration  time.Duration
+	Operation string
+	Error     error
+}
+
+// Money performs the named operation on amounts of money: Add and Subtract
+// of two amounts in the same currency, Multiply of an amount by a number,
+// rounded with mode, Allocate of an amount in proportion to ratios, and
+// Split of an amount into a number of equal parts
+func (c *Calculator) Money(ctx context.Context, name string, amounts []Money, numbers []Decimal, mode RoundingMode) MoneyResult {
+	start := time.Now()
+
+	op, ok := moneyOperations[strings.ToLower(name)]
+	if !ok {
+		return MoneyResult{
+			Duration:  time.Since(start),
+			Operation: name,
+			Error:     &FieldError{Field: "operation", Err: ErrUnknownOperation},
+		}
+	}
+
+	values, err := op.apply(ctx, amounts, numbers, mode)
+	return MoneyResult{
+		Values:    values,
+		Duration:  time.Since(start),
+		Operation: op.name,
+		Error:     err,
+	}
+}
+
+// apply checks the number of arguments and performs the operation unless
+// ctx is done
+func (op *moneyOperation) apply(ctx context.Context, amounts []Money, numbers []Decimal, mode RoundingMode) ([]Money, error) {
+	if len(amounts) != op.amounts {
+		return nil, &FieldError{
+			Field: "amounts",
+			Err:   fmt.Errorf("%w: %s takes %d amounts, got %d", ErrInvalidInput, op.name, op.amounts, len(amounts)),
+		}
+	}
+	if op.numbers == Variadic && len(numbers) == 0 || op.numbers != Variadic && len(numbers) != op.numbers {
+		return nil, &FieldError{
+			Field: "numbers",
+			Err:   fmt.Errorf("%w: %s takes %s, got %d", ErrInvalidInput, op.name, numberCount(op.numbers), len(numbers)),
+		}
+	}
+	for i := 1; i < len(amounts); i++ {
+		if err := sameCurrency(amounts[0], amounts[i]); err != nil {
+			return nil, &FieldError{Field: fmt.Sprintf("amounts[%d]", i), Err: err}
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return op.fn(amounts, numbers, mode)
+}
+
+// numberCount describes the number of numbers an operation takes
+func numberCount(n int) string {
+	switch n {
+	case Variadic:
+		return "at least one number"
+	case 1:
+		return "one number"
+	}
+	return fmt.Sprintf("%d numbers", n)
+}
@@ -0,0 +1,316 @@
+package calc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Quantity is a number with an optional unit such as "km" or "MB/s"
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// UnitRule describes how an operation treats the units of its arguments
+type UnitRule int
+
+// Unit rules
+const (
+	// UnitsDimensionless operations take and return dimensionless numbers,
+	// e.g. Sin
+	UnitsDimensionless UnitRule = iota
+	// UnitsSame operations take arguments of one dimension, which are
+	// converted to the unit of the first argument, and return that unit,
+	// e.g. Mod. Units with an offset are only allowed in operations of one
+	// argument.
+	UnitsSame
+	// UnitsProduct operations multiply the units of their two arguments
+	UnitsProduct
+	// UnitsQuotient operations divide the unit of the first argument by the
+	// unit of the second
+	UnitsQuotient
+	// UnitsPower operations raise the unit of the first argument to the
+	// second, which must be a dimensionless integer
+	UnitsPower
+	// UnitsRoot operations take the square root of the unit of the first
+	// argument, or the root given by a dimensionless second argument
+	UnitsRoot
+	// UnitsSum operations add arguments like UnitsSame, except that an
+	// absolute temperature such as degC can only be added to a difference,
+	// given in a unit without an offset such as K, and the result is
+	// absolute
+	UnitsSum
+	// UnitsDifference operations subtract arguments like UnitsSum, except
+	// that the difference of two absolute temperatures is a difference in
+	// the unit they are defined in, e.g. K
+	UnitsDifference
+)
+
+// InvokeUnits performs the named operation from the registry on quantities.
+// The arguments are converted according to the UnitRule of the operation,
+// which rejects e.g. adding meters to seconds with ErrIncompatibleUnits, and
+// the result carries the derived unit.
+func (c *Calculator) InvokeUnits(ctx context.Context, name string, args ...Quantity) CalculationResult {
+	start := time.Now()
+
+	result, unit, operation, err := c.applyUnits(ctx, name, args)
+	if err != nil {
+		return CalculationResult{
+			Duration:  time.Since(start),
+			Operation: operation,
+			Error:     err,
+		}
+	}
+
+	return CalculationResult{
+		Value:     c.roundToPrecision(result),
+		Unit:      unit,
+		Duration:  time.Since(start),
+		Operation: operation,
+	}
+}
+
+// applyUnits performs the named operation on quantities without rounding
+// the result. It returns the unit and the display name of the operation
+// alongside the result.
+func (c *Calculator) applyUnits(ctx context.Context, name string, args []Quantity) (float64, string, string, error) {
+	op, ok := c.Registry.Lookup(name)
+	if !ok {
+		return 0, "", name, &FieldError{Field: "operation", Err: ErrUnknownOperation}
+	}
+	if err := op.checkArity(len(args)); err != nil {
+		return 0, "", op.Name, err
+	}
+
+	values := make([]float64, len(args))
+	units := make([]Unit, len(args))
+	for i, arg := range args {
+		unit, err := c.Units.Parse(arg.Unit)
+		if err != nil {
+			return 0, "", op.Name, &FieldError{Field: op.Param(i), Err: err}
+		}
+		values[i], units[i] = arg.Value, unit
+	}
+
+	unit, scale, err := deriveUnit(op, values, units)
+	if err != nil {
+		return 0, "", op.Name, err
+	}
+
+	result, _, err := c.apply(ctx, op.Name, values)
+	if err != nil {
+		return 0, "", op.Name, err
+	}
+	if scale != 1 {
+		result *= scale
+		if err := c.checkResult(result); err != nil {
+			return 0, "", op.Name, err
+		}
+	}
+
+	return result, unit.String(), op.Name, nil
+}
+
+// deriveUnit converts values in place to the units op computes with, and
+// returns the unit of the result and the factor by which the result must be
+// scaled
+func deriveUnit(op *Operation, values []float64, units []Unit) (Unit, float64, error) {
+	switch op.Units {
+	case UnitsSum, UnitsDifference:
+		if len(units) == 2 && (units[0].offset() != 0 || units[1].offset() != 0) {
+			return deriveAffine(op, values, units)
+		}
+		fallthrough
+
+	case UnitsSame:
+		if len(units) > 1 {
+			if err := checkOffsets(op, units); err != nil {
+				return Unit{}, 0, err
+			}
+		}
+		for i := 1; i < len(units); i++ {
+			value, err := convertUnit(values[i], units[i], units[0])
+			if err != nil {
+				return Unit{}, 0, &FieldError{Field: op.Param(i), Err: err}
+			}
+			values[i] = value
+		}
+		return units[0], 1, nil
+
+	case UnitsProduct, UnitsQuotient:
+		if err := checkOffsets(op, units); err != nil {
+			return Unit{}, 0, err
+		}
+		power := 1
+		if op.Units == UnitsQuotient {
+			power = -1
+		}
+		unit, scale := units[0].mul(units[1], power)
+		return unit, scale, nil
+
+	case UnitsPower, UnitsRoot:
+		if err := checkOffsets(op, units[:1]); err != nil {
+			return Unit{}, 0, err
+		}
+		if err := toDimensionless(op, values, units, 1); err != nil {
+			return Unit{}, 0, err
+		}
+		if len(units[0].terms) == 0 {
+			return Unit{}, 1, nil
+		}
+
+		n := 2.0
+		if len(values) > 1 {
+			n = values[1]
+		}
+		if !isInteger(n) || n == 0 || math.Abs(n) > math.MaxInt32 {
+			return Unit{}, 0, &FieldError{
+				Field: op.Param(1),
+				Err:   fmt.Errorf("%w: %v is not an integer, as needed for a quantity in %v", ErrIncompatibleUnits, n, units[0]),
+			}
+		}
+
+		if op.Units == UnitsPower {
+			unit, _ := Unit{}.mul(units[0], int(n))
+			return unit, 1, nil
+		}
+		unit, ok := units[0].root(int(n))
+		if !ok {
+			return Unit{}, 0, &FieldError{
+				Field: op.Param(0),
+				Err:   fmt.Errorf("%w: %v has no root of order %v", ErrIncompatibleUnits, units[0], n),
+			}
+		}
+		return unit, 1, nil
+	}
+
+	if err := toDimensionless(op, values, units, 0); err != nil {
+		return Unit{}, 0, err
+	}
+	return Unit{}, 1, nil
+}
+
+// deriveAffine derives the unit of the sum or difference of two arguments,
+// at least one of which is an absolute temperature with an offset. Such a
+// temperature only changes by a difference, and two of them only have a
+// difference.
+func deriveAffine(op *Operation, values []float64, units []Unit) (Unit, float64, error) {
+	a, b := units[0], units[1]
+	switch {
+	case a.offset() != 0 && b.offset() != 0:
+		if op.Units == UnitsSum {
+			return Unit{}, 0, &FieldError{
+				Field: op.Param(1),
+				Err:   fmt.Errorf("%w: cannot add the absolute temperatures %s and %s", ErrIncompatibleUnits, a, b),
+			}
+		}
+		difference := a.difference()
+		for i, unit := range units {
+			value, err := convertUnit(values[i], unit, difference)
+			if err != nil {
+				return Unit{}, 0, &FieldError{Field: op.Param(i), Err: err}
+			}
+			values[i] = value
+		}
+		return difference, 1, nil
+
+	case a.offset() != 0:
+		value, err := convertDifference(values[1], b, a)
+		if err != nil {
+			return Unit{}, 0, &FieldError{Field: op.Param(1), Err: err}
+		}
+		values[1] = value
+		return a, 1, nil
+
+	case op.Units == UnitsSum:
+		value, err := convertDifference(values[0], a, b)
+		if err != nil {
+			return Unit{}, 0, &FieldError{Field: op.Param(0), Err: err}
+		}
+		values[0] = value
+		return b, 1, nil
+	}
+
+	return Unit{}, 0, &FieldError{
+		Field: op.Param(1),
+		Err:   fmt.Errorf("%w: cannot subtract the absolute temperature %s from a difference", ErrIncompatibleUnits, b),
+	}
+}
+
+// toDimensionless converts the values from index first on to plain numbers,
+// which fails for units with a dimension
+func toDimensionless(op *Operation, values []float64, units []Unit, first int) error {
+	for i := first; i < len(values); i++ {
+		value, err := convertUnit(values[i], units[i], Unit{})
+		if err != nil {
+			return &FieldError{Field: op.Param(i), Err: err}
+		}
+		values[i] = value
+	}
+	return nil
+}
+
+// checkOffsets rejects units with an offset, such as degC, in operations
+// that multiply units
+func checkOffsets(op *Operation, units []Unit) error {
+	for i, unit := range units {
+		if unit.offset() != 0 {
+			return &FieldError{
+				Field: op.Param(i),
+				Err:   fmt.Errorf("%w: %s has an offset and can only be added, subtracted or converted", ErrIncompatibleUnits, unit),
+			}
+		}
+	}
+	return nil
+}
+
+// Convert converts value from one unit to another of the same dimension
+func (c *Calculator) Convert(ctx context.Context, value float64, from, to string) CalculationResult {
+	start := time.Now()
+
+	result, unit, err := c.convert(ctx, value, from, to)
+	if err != nil {
+		return CalculationResult{
+			Duration:  time.Since(start),
+			Operation: "Convert",
+			Error:     err,
+		}
+	}
+
+	return CalculationResult{
+		Value:     c.roundToPrecision(result),
+		Unit:      unit,
+		Duration:  time.Since(start),
+		Operation: "Convert",
+	}
+}
+
+// convert converts value between units without rounding the result
+func (c *Calculator) convert(ctx context.Context, value float64, from, to string) (float64, string, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, "", err
+	}
+	if !c.validateInput(value) {
+		return 0, "", &FieldError{Field: "value", Err: ErrInvalidInput}
+	}
+	fromUnit, err := c.Units.Parse(from)
+	if err != nil {
+		return 0, "", &FieldError{Field: "from", Err: err}
+	}
+	toUnit, err := c.Units.Parse(to)
+	if err != nil {
+		return 0, "", &FieldError{Field: "to", Err: err}
+	}
+
+	result, err := convertUnit(value, fromUnit, toUnit)
+	if err != nil {
+		return 0, "", &FieldError{Field: "to", Err: err}
+	}
+	if err := c.checkResult(result); err != nil {
+		return 0, "", err
+	}
+
+	return result, toUnit.String(), nil
+}
@@ -0,0 +1,176 @@
+package calc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+)
+
+// RateTable is a snapshot of exchange rates on an effective date
+type RateTable struct {
+	// Effective is the date the rates apply to
+	Effective time.Time
+	// Base is the currency the rates are quoted against
+	Base Currency
+	// Rates are the units of each currency per unit of Base, by code
+	Rates map[string]Decimal
+}
+
+// rateFile is the JSON format of a rate table file
+type rateFile struct {
+	Effective string            `json:"effective"`
+	Base      string            `json:"base"`
+	Rates     map[string]string `json:"rates"`
+}
+
+// LoadRateTable reads a rate table from a JSON file
+func LoadRateTable(path string) (*RateTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	table, err := ParseRateTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return table, nil
+}
+
+// ParseRateTable reads a rate table in JSON such as
+//
+//	{
+//	  "effective": "2026-10-01",
+//	  "base": "USD",
+//	  "rates": {"EUR": "0.92", "JPY": "149.5"}
+//	}
+//
+// Rates are decimal strings so that they are read exactly.
+func ParseRateTable(r io.Reader) (*RateTable, error) {
+	var file rateFile
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	effective, err := time.Parse(time.DateOnly, file.Effective)
+	if err != nil {
+		return nil, fmt.Errorf("%w: effective date %q is not YYYY-MM-DD", ErrInvalidInput, file.Effective)
+	}
+	base, err := LookupCurrency(file.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &RateTable{
+		Effective: effective,
+		Base:      base,
+		Rates:     map[string]Decimal{base.Code: NewDecimal(big.NewInt(1), 0)},
+	}
+	codes := make([]string, 0, len(file.Rates))
+	for code := range file.Rates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		currency, err := LookupCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+		rate, err := ParseDecimal(file.Rates[code])
+		if err != nil {
+			return nil, fmt.Errorf("rate of %s: %w", currency.Code, err)
+		}
+		if rate.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: rate of %s must be positive", ErrInvalidInput, currency.Code)
+		}
+		table.Rates[currency.Code] = rate
+	}
+	return table, nil
+}
+
+// Rate returns the exact number of units of to per unit of from
+func (t *RateTable) Rate(from, to Currency) (*big.Rat, error) {
+	fromRate, ok := t.Rates[from.Code]
+	if !ok {
+		return nil, fmt.Errorf("%w: no rate for %s", ErrInvalidInput, from.Code)
+	}
+	toRate, ok := t.Rates[to.Code]
+	if !ok {
+		return nil, fmt.Errorf("%w: no rate for %s", ErrInvalidInput, to.Code)
+	}
+	return new(big.Rat).Quo(toRate.Rat(), fromRate.Rat()), nil
+}
+
+// CurrencyResult contains the result of a currency conversion
+type CurrencyResult struct {
+	Value Money
+	// Rate is the exact rate applied, in units of the target currency per
+	// unit of the source currency
+	Rate *big.Rat
+	// RateDecimal is Rate rounded to MaxDecimalPlaces like a real result
+	RateDecimal string
+	// Effective is the date of the rate table
+	Effective time.Time
+	Duration  time.Duration
+	Operation string
+	Error     error
+}
+
+// ConvertCurrency converts amount to the currency with code to using the
+// rate table, rounding to the minor units of the target currency with mode
+func (c *Calculator) ConvertCurrency(ctx context.Context, amount Money, to string, mode RoundingMode) CurrencyResult {
+	start := time.Now()
+
+	value, rate, err := c.convertCurrency(ctx, amount, to, mode)
+	if err != nil {
+		return CurrencyResult{
+			Duration:  time.Since(start),
+			Operation: "ConvertCurrency",
+			Error:     err,
+		}
+	}
+
+	return CurrencyResult{
+		Value:       value,
+		Rate:        rate,
+		RateDecimal: c.RationalDecimal(rate),
+		Effective:   c.Rates.Effective,
+		Duration:    time.Since(start),
+		Operation:   "ConvertCurrency",
+	}
+}
+
+// convertCurrency converts amount and returns the rate applied
+func (c *Calculator) convertCurrency(ctx context.Context, amount Money, to string, mode RoundingMode) (Money, *big.Rat, error) {
+	if err := ctx.Err(); err != nil {
+		return Money{}, nil, err
+	}
+	if c.Rates == nil {
+		return Money{}, nil, fmt.Errorf("%w: no exchange rate table is loaded", ErrInvalidInput)
+	}
+	target, err := LookupCurrency(to)
+	if err != nil {
+		return Money{}, nil, &FieldError{Field: "to", Err: err}
+	}
+	if _, ok := c.Rates.Rates[amount.Currency.Code]; !ok {
+		return Money{}, nil, &FieldError{
+			Field: "amount",
+			Err:   fmt.Errorf("%w: no rate for %s", ErrInvalidInput, amount.Currency.Code),
+		}
+	}
+	rate, err := c.Rates.Rate(amount.Currency, target)
+	if err != nil {
+		return Money{}, nil, &FieldError{Field: "to", Err: err}
+	}
+
+	value := new(big.Rat).Mul(amount.Amount.Rat(), rate)
+	return Money{Currency: target, Amount: DecimalFromRat(value, target.Digits, mode)}, rate, nil
+}
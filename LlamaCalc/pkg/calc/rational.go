@@ -0,0 +1,290 @@
+package calc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxRationalBits bounds the size of the numerator and denominator of
+// rational operands and results. Larger results are reported as overflow,
+// so that repeated operations cannot grow numbers without limit.
+const MaxRationalBits = 4096
+
+// RationalFunc computes the exact result of a rational operation. Functions
+// must not modify their arguments.
+type RationalFunc func(ctx context.Context, args []*big.Rat) (*big.Rat, error)
+
+// RationalOperation is a named calculation on exact fractions
+type RationalOperation struct {
+	OperationInfo
+	// Func computes the result
+	Func RationalFunc
+}
+
+// registration implements registrable
+func (op *RationalOperation) registration() (*OperationInfo, bool) {
+	if op == nil {
+		return nil, false
+	}
+	return &op.OperationInfo, op.Func != nil
+}
+
+// RationalResult contains the result of a rational calculation
+type RationalResult struct {
+	// Value is the exact result in lowest terms
+	Value *big.Rat
+	// Decimal is Value rounded to MaxDecimalPlaces like a real result,
+	// without trailing zeros
+	Decimal   string
+	Duration  time.Duration
+	Operation string
+	Error     error
+}
+
+// rationalPattern matches integers ("-3"), fractions ("1/3"), mixed numbers
+// ("1 1/2") and decimals ("0.25")
+var rationalPattern = regexp.MustCompile(`^([+-]?)(?:(\d+)|(\d+)/(\d+)|(\d+) +(\d+)/(\d+)|(\d*\.\d+|\d+\.\d*))$`)
+
+// ParseRational parses an exact number given as an integer ("-3"), a
+// fraction ("1/3"), a mixed number ("-1 1/2") or a decimal ("0.25"). A
+// fraction with a zero denominator is reported as ErrDivideByZero.
+func ParseRational(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > MaxRationalBits {
+		return nil, fmt.Errorf("%w: number is too long", ErrInvalidInput)
+	}
+
+	m := rationalPattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("%w: %q is not an integer, fraction, mixed number or decimal", ErrInvalidInput, s)
+	}
+
+	r := new(big.Rat)
+	switch {
+	case m[2] != "":
+		r.SetString(m[2])
+	case m[3] != "":
+		if err := setFraction(r, m[3], m[4]); err != nil {
+			return nil, err
+		}
+	case m[5] != "":
+		if err := setFraction(r, m[6], m[7]); err != nil {
+			return nil, err
+		}
+		whole, _ := new(big.Rat).SetString(m[5])
+		r.Add(r, whole)
+	default:
+		r.SetString(m[8])
+	}
+	if m[1] == "-" {
+		r.Neg(r)
+	}
+
+	if !ratFits(r) {
+		return nil, fmt.Errorf("%w: number exceeds %d bits", ErrInvalidInput, MaxRationalBits)
+	}
+	return r, nil
+}
+
+// setFraction sets r to num/den
+func setFraction(r *big.Rat, num, den string) error {
+	d, _ := new(big.Int).SetString(den, 10)
+	if d.Sign() == 0 {
+		return ErrDivideByZero
+	}
+	n, _ := new(big.Int).SetString(num, 10)
+	r.SetFrac(n, d)
+	return nil
+}
+
+// ratFits reports whether the numerator and denominator of r are within
+// MaxRationalBits
+func ratFits(r *big.Rat) bool {
+	return r.Num().BitLen() <= MaxRationalBits && r.Denom().BitLen() <= MaxRationalBits
+}
+
+// FormatMixed formats r as a mixed number such as "-3 1/2". Integers are
+// formatted without a fraction and proper fractions without a whole part.
+func FormatMixed(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	whole, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if whole.Sign() == 0 {
+		return r.RatString()
+	}
+	return fmt.Sprintf("%s %s/%s", whole, rem.Abs(rem), r.Denom())
+}
+
+// RationalDecimal rounds r to MaxDecimalPlaces decimal places, halves away
+// from zero like real results, and formats it without trailing zeros
+func (c *Calculator) RationalDecimal(r *big.Rat) string {
+	s := r.FloatString(c.MaxDecimalPlaces)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// Rational performs the named rational operation from the registry on args,
+// which are parsed with ParseRational. The result is exact; it is also
+// rounded to a decimal like a real result.
+func (c *Calculator) Rational(ctx context.Context, name string, args ...string) RationalResult {
+	start := time.Now()
+
+	result, operation, err := c.applyRational(ctx, name, args)
+	if err != nil {
+		return RationalResult{
+			Duration:  time.Since(start),
+			Operation: operation,
+			Error:     err,
+		}
+	}
+
+	return RationalResult{
+		Value:     result,
+		Decimal:   c.RationalDecimal(result),
+		Duration:  time.Since(start),
+		Operation: operation,
+		Error:     nil,
+	}
+}
+
+// applyRational parses the arguments and performs the named rational operation
+func (c *Calculator) applyRational(ctx context.Context, name string, args []string) (*big.Rat, string, error) {
+	op, ok := c.Registry.LookupRational(name)
+	if !ok {
+		return nil, name, &FieldError{Field: "operation", Err: ErrUnknownOperation}
+	}
+
+	// Validate inputs
+	if err := op.checkArity(len(args)); err != nil {
+		return nil, op.Name, err
+	}
+	values := make([]*big.Rat, len(args))
+	for i, arg := range args {
+		value, err := ParseRational(arg)
+		if err != nil {
+			return nil, op.Name, &FieldError{Field: op.Param(i), Err: err}
+		}
+		values[i] = value
+	}
+
+	// Stop if the caller has given up
+	if err := ctx.Err(); err != nil {
+		return nil, op.Name, err
+	}
+
+	// Perform calculation
+	result, err := op.Func(ctx, values)
+	if err != nil {
+		return nil, op.Name, err
+	}
+	if !ratFits(result) {
+		if result.Sign() < 0 {
+			return nil, op.Name, ErrUnderflow
+		}
+		return nil, op.Name, ErrOverflow
+	}
+
+	return result, op.Name, nil
+}
+
+// binaryRational adapts a two-argument function
+func binaryRational(fn func(a, b *big.Rat) (*big.Rat, error)) RationalFunc {
+	return func(ctx context.Context, args []*big.Rat) (*big.Rat, error) {
+		return fn(args[0], args[1])
+	}
+}
+
+// unaryRational adapts a one-argument function that is defined everywhere
+func unaryRational(fn func(z, a *big.Rat) *big.Rat) RationalFunc {
+	return func(ctx context.Context, args []*big.Rat) (*big.Rat, error) {
+		return fn(new(big.Rat), args[0]), nil
+	}
+}
+
+// rationalPow returns a**b for an integer exponent b. Results that would
+// exceed MaxRationalBits are reported before they are computed.
+func rationalPow(a, b *big.Rat) (*big.Rat, error) {
+	if !b.IsInt() {
+		return nil, &FieldError{
+			Field: "b",
+			Err:   fmt.Errorf("%w: the exponent of an exact power must be an integer", ErrInvalidInput),
+		}
+	}
+	if a.Sign() == 0 {
+		if b.Sign() < 0 {
+			return nil, &FieldError{Field: "a", Err: ErrDivideByZero}
+		}
+		if b.Sign() == 0 {
+			return new(big.Rat).SetInt64(1), nil
+		}
+		return new(big.Rat), nil
+	}
+
+	// |num|**n and den**n have about n times the bits of num and den
+	n := new(big.Int).Abs(b.Num())
+	bits := a.Num().BitLen()
+	if d := a.Denom().BitLen(); d > bits {
+		bits = d
+	}
+	if bits > 1 && (!n.IsInt64() || n.Int64() > MaxRationalBits || int64(bits-1)*n.Int64() > MaxRationalBits) {
+		if a.Sign() < 0 && n.Bit(0) == 1 {
+			return nil, ErrUnderflow
+		}
+		return nil, ErrOverflow
+	}
+
+	num := new(big.Int).Exp(a.Num(), n, nil)
+	den := new(big.Int).Exp(a.Denom(), n, nil)
+	if b.Sign() < 0 {
+		num, den = den, num
+	}
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+// rationalOperations returns the exact arithmetic operations on fractions
+func rationalOperations() []*RationalOperation {
+	ops := []*RationalOperation{
+		{OperationInfo: signature("Add", "a", "b"), Func: binaryRational(func(a, b *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Add(a, b), nil
+		})},
+		{OperationInfo: signature("Subtract", "a", "b"), Func: binaryRational(func(a, b *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Sub(a, b), nil
+		})},
+		{OperationInfo: signature("Multiply", "a", "b"), Func: binaryRational(func(a, b *big.Rat) (*big.Rat, error) {
+			return new(big.Rat).Mul(a, b), nil
+		})},
+		{OperationInfo: signature("Divide", "a", "b"), Func: binaryRational(func(a, b *big.Rat) (*big.Rat, error) {
+			if b.Sign() == 0 {
+				return nil, &FieldError{Field: "b", Err: ErrDivideByZero}
+			}
+			return new(big.Rat).Quo(a, b), nil
+		})},
+		{OperationInfo: signature("Pow", "a", "b"), Func: binaryRational(rationalPow)},
+		{OperationInfo: signature("Negate", "a"), Func: unaryRational((*big.Rat).Neg)},
+		{OperationInfo: signature("Abs", "a"), Func: unaryRational((*big.Rat).Abs)},
+		{OperationInfo: signature("Reciprocal", "a"), Func: func(ctx context.Context, args []*big.Rat) (*big.Rat, error) {
+			if args[0].Sign() == 0 {
+				return nil, &FieldError{Field: "a", Err: ErrDivideByZero}
+			}
+			return new(big.Rat).Inv(args[0]), nil
+		}},
+	}
+
+	for _, op := range ops {
+		op.Role = RoleUser
+		op.Module = "rational"
+	}
+
+	return ops
+}
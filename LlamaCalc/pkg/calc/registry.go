@@ -0,0 +1,305 @@
+package calc
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry errors
+var (
+	ErrUnknownOperation   = errors.New("unknown operation")
+	ErrDuplicateOperation = errors.New("operation already registered")
+)
+
+// Variadic is the arity of operations that accept any number of arguments
+const Variadic = -1
+
+// Roles an operation can require. They match the roles of package auth;
+// each role also grants the operations of the roles below it.
+const (
+	RoleGuest = "GUEST"
+	RoleUser  = "USER"
+	RoleAdmin = "ADMIN"
+)
+
+// DefaultModule is the metric module of operations that do not set one
+const DefaultModule = "custom"
+
+// Func computes the raw result of an operation. Input validation, overflow
+// checking and rounding are applied by the Calculator around it.
+type Func func(args []float64) (float64, error)
+
+// BinaryFunc computes the raw result of a two-argument operation
+type BinaryFunc func(a, b float64) (float64, error)
+
+// Binary adapts a BinaryFunc to a Func
+func Binary(fn BinaryFunc) Func {
+	return func(args []float64) (float64, error) {
+		return fn(args[0], args[1])
+	}
+}
+
+// OperationInfo describes an operation of any mode: how it is called, who
+// may call it and how it is labelled in metrics
+type OperationInfo struct {
+	// Name is the display name reported in results, e.g. "Add"
+	Name string
+	// Arity is the number of arguments; only real operations may be Variadic
+	Arity int
+	// Params optionally names the arguments in errors; unnamed arguments are
+	// reported as "args[i]"
+	Params []string
+	// Role is the least role allowed to invoke the operation when RBAC is
+	// enabled; empty allows any authenticated caller
+	Role string
+	// Metric is the "operation" metric label; it defaults to the upper-case name
+	Metric string
+	// Module is the "module" metric label; it defaults to DefaultModule
+	Module string
+}
+
+// Param returns the name of argument i used in errors
+func (op *OperationInfo) Param(i int) string {
+	if i < len(op.Params) {
+		return op.Params[i]
+	}
+	return fmt.Sprintf("args[%d]", i)
+}
+
+// MetricLabels returns the operation and module metric labels
+func (op *OperationInfo) MetricLabels() (operation, module string) {
+	operation, module = op.Metric, op.Module
+	if operation == "" {
+		operation = strings.ToUpper(op.Name)
+	}
+	if module == "" {
+		module = DefaultModule
+	}
+	return operation, module
+}
+
+// signature describes an operation taking the named parameters
+func signature(name string, params ...string) OperationInfo {
+	return OperationInfo{Name: name, Arity: len(params), Params: params}
+}
+
+// checkArity checks that the operation takes n arguments
+func (op *OperationInfo) checkArity(n int) error {
+	if op.Arity != Variadic && n != op.Arity {
+		return &FieldError{
+			Field: "args",
+			Err:   fmt.Errorf("%w: %s takes %d arguments, got %d", ErrInvalidInput, op.Name, op.Arity, n),
+		}
+	}
+	return nil
+}
+
+// Operation is a named calculation on real numbers known to a Registry
+type Operation struct {
+	OperationInfo
+	// Angle tells whether arguments or result are angles, which are
+	// converted when the caller works in degrees
+	Angle Angle
+	// Units tells how InvokeUnits converts the units of the arguments and
+	// derives the unit of the result
+	Units UnitRule
+	// Validate optionally checks the arguments after the generic checks for
+	// arity and finite values. Errors not wrapping a calc error are reported
+	// as ErrInvalidInput.
+	Validate func(args []float64) error
+	// Func computes the result
+	Func Func
+}
+
+// registration implements registrable
+func (op *Operation) registration() (*OperationInfo, bool) {
+	if op == nil {
+		return nil, false
+	}
+	return &op.OperationInfo, op.Func != nil
+}
+
+// registrable is implemented by the operations of every mode
+type registrable interface {
+	// registration returns the description of the operation and whether it
+	// has a function; the operation may be nil
+	registration() (*OperationInfo, bool)
+}
+
+// Registry holds the operations an engine can perform. Names are matched
+// case-insensitively. Real, complex, rational and integer operations have
+// separate namespaces.
+type Registry struct {
+	mu          sync.RWMutex
+	ops         map[string]*Operation
+	complexOps  map[string]*ComplexOperation
+	rationalOps map[string]*RationalOperation
+	integerOps  map[string]*IntegerOperation
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		ops:         make(map[string]*Operation),
+		complexOps:  make(map[string]*ComplexOperation),
+		rationalOps: make(map[string]*RationalOperation),
+		integerOps:  make(map[string]*IntegerOperation),
+	}
+}
+
+// DefaultRegistry creates a registry with the built-in operations
+func DefaultRegistry() *Registry {
+	r := NewRegistry()
+	if err := r.Register(builtinOperations()...); err != nil {
+		panic(err)
+	}
+	if err := r.Register(scientificOperations()...); err != nil {
+		panic(err)
+	}
+	if err := r.Register(bitwiseOperations()...); err != nil {
+		panic(err)
+	}
+	if err := r.RegisterComplex(complexOperations()...); err != nil {
+		panic(err)
+	}
+	if err := r.RegisterRational(rationalOperations()...); err != nil {
+		panic(err)
+	}
+	if err := r.RegisterInteger(integerOperations()...); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds operations to the registry. Nothing is registered if any of
+// them is invalid or already registered.
+func (r *Registry) Register(ops ...*Operation) error {
+	return register(r, r.ops, true, ops)
+}
+
+// Lookup returns the operation registered under name
+func (r *Registry) Lookup(name string) (*Operation, bool) {
+	return lookup(r, r.ops, name)
+}
+
+// RegisterComplex adds complex operations to the registry like Register
+func (r *Registry) RegisterComplex(ops ...*ComplexOperation) error {
+	return register(r, r.complexOps, false, ops)
+}
+
+// LookupComplex returns the complex operation registered under name
+func (r *Registry) LookupComplex(name string) (*ComplexOperation, bool) {
+	return lookup(r, r.complexOps, name)
+}
+
+// RegisterRational adds rational operations to the registry like Register
+func (r *Registry) RegisterRational(ops ...*RationalOperation) error {
+	return register(r, r.rationalOps, false, ops)
+}
+
+// LookupRational returns the rational operation registered under name
+func (r *Registry) LookupRational(name string) (*RationalOperation, bool) {
+	return lookup(r, r.rationalOps, name)
+}
+
+// RegisterInteger adds integer operations to the registry like Register
+func (r *Registry) RegisterInteger(ops ...*IntegerOperation) error {
+	return register(r, r.integerOps, false, ops)
+}
+
+// LookupInteger returns the integer operation registered under name
+func (r *Registry) LookupInteger(name string) (*IntegerOperation, bool) {
+	return lookup(r, r.integerOps, name)
+}
+
+// register adds ops to one namespace of r if all of them are valid and not
+// yet registered. Only the operations of namespaces allowing variadic ones
+// may be Variadic.
+func register[T registrable](r *Registry, namespace map[string]T, variadic bool, ops []T) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make(map[string]bool, len(ops))
+	for _, op := range ops {
+		info, hasFunc := op.registration()
+		if info == nil || info.Name == "" || !hasFunc {
+			return fmt.Errorf("%w: operation needs a name and a function", ErrInvalidInput)
+		}
+		if info.Arity == 0 || info.Arity < Variadic || info.Arity == Variadic && !variadic {
+			return fmt.Errorf("%w: operation %s has invalid arity %d", ErrInvalidInput, info.Name, info.Arity)
+		}
+		if info.Arity != Variadic && len(info.Params) > info.Arity {
+			return fmt.Errorf("%w: operation %s names more parameters than it takes", ErrInvalidInput, info.Name)
+		}
+
+		key := strings.ToLower(info.Name)
+		if _, ok := namespace[key]; ok || keys[key] {
+			return fmt.Errorf("%w: %s", ErrDuplicateOperation, info.Name)
+		}
+		keys[key] = true
+	}
+
+	for _, op := range ops {
+		info, _ := op.registration()
+		namespace[strings.ToLower(info.Name)] = op
+	}
+
+	return nil
+}
+
+// lookup returns the operation registered under name in one namespace of r
+func lookup[T any](r *Registry, namespace map[string]T, name string) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	op, ok := namespace[strings.ToLower(name)]
+	return op, ok
+}
+
+// Names returns the display names of all operations in sorted order
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.ops))
+	for _, op := range r.ops {
+		names = append(names, op.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// builtinOperations returns the four basic arithmetic operations
+func builtinOperations() []*Operation {
+	return []*Operation{
+		{
+			OperationInfo: OperationInfo{Name: "Add", Arity: 2, Params: []string{"a", "b"}, Role: RoleGuest, Module: "arithmetic"},
+			Units:         UnitsSum,
+			Func:          Binary(func(a, b float64) (float64, error) { return a + b, nil }),
+		},
+		{
+			OperationInfo: OperationInfo{Name: "Subtract", Arity: 2, Params: []string{"a", "b"}, Role: RoleGuest, Module: "arithmetic"},
+			Units:         UnitsDifference,
+			Func:          Binary(func(a, b float64) (float64, error) { return a - b, nil }),
+		},
+		{
+			OperationInfo: OperationInfo{Name: "Multiply", Arity: 2, Params: []string{"a", "b"}, Role: RoleUser, Module: "arithmetic"},
+			Units:         UnitsProduct,
+			Func:          Binary(func(a, b float64) (float64, error) { return a * b, nil }),
+		},
+		{
+			OperationInfo: OperationInfo{Name: "Divide", Arity: 2, Params: []string{"a", "b"}, Role: RoleAdmin, Module: "arithmetic"},
+			Units:         UnitsQuotient,
+			Func: Binary(func(a, b float64) (float64, error) {
+				if b == 0 {
+					return 0, &FieldError{Field: "b", Err: ErrDivideByZero}
+				}
+				return a / b, nil
+			}),
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package calc_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"llamacalc/pkg/calc"
+)
+
+func TestRegister(t *testing.T) {
+	float := func(info calc.OperationInfo) *calc.Operation {
+		return &calc.Operation{OperationInfo: info, Func: func(args []float64) (float64, error) { return 0, nil }}
+	}
+	integer := func(info calc.OperationInfo) *calc.IntegerOperation {
+		return &calc.IntegerOperation{OperationInfo: info, Func: func(ctx context.Context, args []*big.Int) ([]*big.Int, error) {
+			return args, nil
+		}}
+	}
+
+	tests := []struct {
+		Name     string
+		Register func(r *calc.Registry) error
+		Want     error
+	}{
+		{"real", func(r *calc.Registry) error {
+			return r.Register(float(calc.OperationInfo{Name: "Sum", Arity: calc.Variadic}))
+		}, nil},
+		{"integer", func(r *calc.Registry) error {
+			return r.RegisterInteger(integer(calc.OperationInfo{Name: "Same", Arity: 1}))
+		}, nil},
+		{"duplicate integer", func(r *calc.Registry) error {
+			return r.RegisterInteger(integer(calc.OperationInfo{Name: "add", Arity: 2}))
+		}, calc.ErrDuplicateOperation},
+		{"duplicate", func(r *calc.Registry) error {
+			return r.Register(float(calc.OperationInfo{Name: "ADD", Arity: 2}))
+		}, calc.ErrDuplicateOperation},
+		{"duplicate in call", func(r *calc.Registry) error {
+			return r.Register(float(calc.OperationInfo{Name: "Twice", Arity: 1}), float(calc.OperationInfo{Name: "twice", Arity: 1}))
+		}, calc.ErrDuplicateOperation},
+		{"nil", func(r *calc.Registry) error {
+			return r.RegisterComplex(nil)
+		}, calc.ErrInvalidInput},
+		{"no function", func(r *calc.Registry) error {
+			return r.RegisterRational(&calc.RationalOperation{OperationInfo: calc.OperationInfo{Name: "None", Arity: 1}})
+		}, calc.ErrInvalidInput},
+		{"no arguments", func(r *calc.Registry) error {
+			return r.Register(float(calc.OperationInfo{Name: "Zero"}))
+		}, calc.ErrInvalidInput},
+		{"variadic integer", func(r *calc.Registry) error {
+			return r.RegisterInteger(integer(calc.OperationInfo{Name: "Many", Arity: calc.Variadic}))
+		}, calc.ErrInvalidInput},
+		{"too many parameters", func(r *calc.Registry) error {
+			return r.Register(float(calc.OperationInfo{Name: "Neg", Arity: 1, Params: []string{"x", "y"}}))
+		}, calc.ErrInvalidInput},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			// Real and integer operations have separate namespaces
+			r := calc.NewRegistry()
+			if err := r.Register(float(calc.OperationInfo{Name: "Add", Arity: 2})); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.RegisterInteger(integer(calc.OperationInfo{Name: "Add", Arity: 2})); err != nil {
+				t.Fatal(err)
+			}
+
+			before := len(r.Names())
+			err := tc.Register(r)
+			if !errors.Is(err, tc.Want) || (err == nil) != (tc.Want == nil) {
+				t.Fatalf("got %v, want %v", err, tc.Want)
+			}
+			// Nothing is registered if an operation is rejected
+			if err != nil && len(r.Names()) != before {
+				t.Errorf("got %v after a failed registration", r.Names())
+			}
+		})
+	}
+}
+
+func TestOperationInfo(t *testing.T) {
+	r := calc.DefaultRegistry()
+	op, ok := r.LookupComplex("MULTIPLY")
+	if !ok {
+		t.Fatal("complex Multiply is not registered")
+	}
+	if op.Param(1) != "w" || op.Param(2) != "args[2]" {
+		t.Errorf("got parameters %q and %q", op.Param(1), op.Param(2))
+	}
+	if operation, module := op.MetricLabels(); operation != "MULTIPLY" || module != "complex" {
+		t.Errorf("got metric labels %s and %s", operation, module)
+	}
+
+	custom := &calc.OperationInfo{Name: "Hypot"}
+	if operation, module := custom.MetricLabels(); operation != "HYPOT" || module != calc.DefaultModule {
+		t.Errorf("got metric labels %s and %s", operation, module)
+	}
+}
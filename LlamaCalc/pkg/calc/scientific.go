@@ -0,0 +1,152 @@
+package calc
+
+import "math"
+
+// Unary adapts a one-argument function to a Func
+func Unary(fn func(x float64) (float64, error)) Func {
+	return func(args []float64) (float64, error) {
+		return fn(args[0])
+	}
+}
+
+// total adapts a one-argument function that is defined everywhere
+func total(fn func(x float64) float64) Func {
+	return func(args []float64) (float64, error) {
+		return fn(args[0]), nil
+	}
+}
+
+// domainError reports that field is outside the domain of the function
+func domainError(field string) error {
+	return &FieldError{Field: field, Err: ErrDomain}
+}
+
+// isInteger reports whether x is a whole number
+func isInteger(x float64) bool {
+	return x == math.Trunc(x)
+}
+
+// scientificOperations returns powers, roots, logarithms, trigonometric and
+// hyperbolic functions and rounding helpers. Arguments outside a function's
+// domain are reported as ErrDomain instead of producing NaN.
+func scientificOperations() []*Operation {
+	ops := []*Operation{
+		{OperationInfo: signature("Pow", "x", "y"), Units: UnitsPower, Func: Binary(pow)},
+		{OperationInfo: signature("Sqrt", "x"), Units: UnitsRoot, Func: Unary(func(x float64) (float64, error) {
+			if x < 0 {
+				return 0, domainError("x")
+			}
+			return math.Sqrt(x), nil
+		})},
+		{OperationInfo: signature("NthRoot", "x", "n"), Units: UnitsRoot, Func: Binary(nthRoot)},
+		{OperationInfo: signature("Exp", "x"), Func: total(math.Exp)},
+		{OperationInfo: signature("Ln", "x"), Func: logarithm(math.Log)},
+		{OperationInfo: signature("Log10", "x"), Func: logarithm(math.Log10)},
+		{OperationInfo: signature("LogBase", "x", "base"), Func: Binary(func(x, base float64) (float64, error) {
+			if x <= 0 {
+				return 0, domainError("x")
+			}
+			if base <= 0 || base == 1 {
+				return 0, domainError("base")
+			}
+			return math.Log(x) / math.Log(base), nil
+		})},
+
+		{OperationInfo: signature("Sin", "x"), Angle: AngleArgs, Func: total(math.Sin)},
+		{OperationInfo: signature("Cos", "x"), Angle: AngleArgs, Func: total(math.Cos)},
+		{OperationInfo: signature("Tan", "x"), Angle: AngleArgs, Func: Unary(func(x float64) (float64, error) {
+			// Odd multiples of a right angle are poles; allow for the error
+			// of representing them in radians
+			if math.Abs(math.Cos(x)) < 1e-15 {
+				return 0, domainError("x")
+			}
+			return math.Tan(x), nil
+		})},
+		{OperationInfo: signature("Asin", "x"), Angle: AngleResult, Func: Unary(func(x float64) (float64, error) {
+			if x < -1 || x > 1 {
+				return 0, domainError("x")
+			}
+			return math.Asin(x), nil
+		})},
+		{OperationInfo: signature("Acos", "x"), Angle: AngleResult, Func: Unary(func(x float64) (float64, error) {
+			if x < -1 || x > 1 {
+				return 0, domainError("x")
+			}
+			return math.Acos(x), nil
+		})},
+		{OperationInfo: signature("Atan", "x"), Angle: AngleResult, Func: total(math.Atan)},
+
+		{OperationInfo: signature("Sinh", "x"), Func: total(math.Sinh)},
+		{OperationInfo: signature("Cosh", "x"), Func: total(math.Cosh)},
+		{OperationInfo: signature("Tanh", "x"), Func: total(math.Tanh)},
+		{OperationInfo: signature("Asinh", "x"), Func: total(math.Asinh)},
+		{OperationInfo: signature("Acosh", "x"), Func: Unary(func(x float64) (float64, error) {
+			if x < 1 {
+				return 0, domainError("x")
+			}
+			return math.Acosh(x), nil
+		})},
+		{OperationInfo: signature("Atanh", "x"), Func: Unary(func(x float64) (float64, error) {
+			if x <= -1 || x >= 1 {
+				return 0, domainError("x")
+			}
+			return math.Atanh(x), nil
+		})},
+
+		{OperationInfo: signature("Abs", "x"), Units: UnitsSame, Func: total(math.Abs)},
+		{OperationInfo: signature("Floor", "x"), Units: UnitsSame, Func: total(math.Floor)},
+		{OperationInfo: signature("Ceil", "x"), Units: UnitsSame, Func: total(math.Ceil)},
+		{OperationInfo: signature("Mod", "a", "b"), Units: UnitsSame, Func: Binary(func(a, b float64) (float64, error) {
+			if b == 0 {
+				return 0, &FieldError{Field: "b", Err: ErrDivideByZero}
+			}
+			return math.Mod(a, b), nil
+		})},
+	}
+
+	for _, op := range ops {
+		op.Role = RoleUser
+		op.Module = "scientific"
+	}
+
+	return ops
+}
+
+// pow raises x to the power y
+func pow(x, y float64) (float64, error) {
+	if x == 0 && y < 0 {
+		return 0, &FieldError{Field: "x", Err: ErrDivideByZero}
+	}
+	if x < 0 && !isInteger(y) {
+		return 0, domainError("x")
+	}
+	return math.Pow(x, y), nil
+}
+
+// nthRoot returns the real n-th root of x. Odd roots of negative numbers are
+// negative; even roots of negative numbers are undefined.
+func nthRoot(x, n float64) (float64, error) {
+	if n == 0 {
+		return 0, domainError("n")
+	}
+	if x >= 0 {
+		if x == 0 && n < 0 {
+			return 0, &FieldError{Field: "x", Err: ErrDivideByZero}
+		}
+		return math.Pow(x, 1/n), nil
+	}
+	if !isInteger(n) || math.Mod(n, 2) == 0 {
+		return 0, domainError("x")
+	}
+	return -math.Pow(-x, 1/n), nil
+}
+
+// logarithm adapts a logarithm, which is only defined for positive numbers
+func logarithm(fn func(x float64) float64) Func {
+	return Unary(func(x float64) (float64, error) {
+		if x <= 0 {
+			return 0, domainError("x")
+		}
+		return fn(x), nil
+	})
+}
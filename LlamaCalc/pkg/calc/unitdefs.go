@@ -0,0 +1,83 @@
+package calc
+
+// builtinUnits are the definitions loaded by DefaultUnits, in the format of
+// UnitRegistry.Load
+const builtinUnits = `
+# Base units
+base m length
+base kg mass
+base s time
+base K temperature
+base B information
+
+# SI prefixes
+prefix y 1e-24
+prefix z 1e-21
+prefix a 1e-18
+prefix f 1e-15
+prefix p 1e-12
+prefix n 1e-9
+prefix u 1e-6
+prefix µ 1e-6
+prefix m 1e-3
+prefix c 1e-2
+prefix d 1e-1
+prefix da 1e1
+prefix h 1e2
+prefix k 1e3
+prefix M 1e6
+prefix G 1e9
+prefix T 1e12
+prefix P 1e15
+prefix E 1e18
+prefix Z 1e21
+prefix Y 1e24
+
+# Binary prefixes
+prefix Ki 1024
+prefix Mi 1048576
+prefix Gi 1073741824
+prefix Ti 1099511627776
+prefix Pi 1125899906842624
+prefix Ei 1152921504606846976
+
+# Length
+in = 0.0254 m
+ft = 0.3048 m
+yd = 0.9144 m
+mi = 1609.344 m
+nmi = 1852 m
+
+# Mass
+g = 1e-3 kg
+t = 1000 kg
+lb = 0.45359237 kg
+oz = 0.028349523125 kg
+
+# Time
+min = 60 s
+h = 3600 s
+d = 86400 s
+wk = 604800 s
+Hz = 1/s
+
+# Data size
+bit = 0.125 B
+b = bit
+
+# Force, energy and power
+N = kg*m/s^2
+J = N*m
+W = J/s
+Wh = 3600 J
+cal = 4.184 J
+eV = 1.602176634e-19 J
+BTU = 1055.05585262 J
+
+# Temperature
+degC = K + 273.15
+degF = 5/9 K + 459.67
+# Temperature differences, which can be added to degC and degF
+deltaC = K
+deltaF = 5/9 K
+`
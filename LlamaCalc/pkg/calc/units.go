@@ -0,0 +1,581 @@
+package calc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Dimension is a product of powers of base dimensions, such as length/time
+// for speeds. An empty Dimension is dimensionless.
+type Dimension map[string]int
+
+// String formats the dimension like a unit, e.g. "length/time^2"
+func (d Dimension) String() string {
+	if len(d) == 0 {
+		return "dimensionless"
+	}
+	names := make([]string, 0, len(d))
+	for name := range d {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	powers := make([]int, len(names))
+	for i, name := range names {
+		powers[i] = d[name]
+	}
+	return formatPowers(names, powers)
+}
+
+// equal reports whether d and other are the same dimension
+func (d Dimension) equal(other Dimension) bool {
+	if len(d) != len(other) {
+		return false
+	}
+	for name, power := range d {
+		if other[name] != power {
+			return false
+		}
+	}
+	return true
+}
+
+// formatPowers formats a product of powers such as "kg*m^2/s^2"
+func formatPowers(names []string, powers []int) string {
+	var num, den strings.Builder
+	for i, name := range names {
+		switch p := powers[i]; {
+		case p > 0:
+			if num.Len() > 0 {
+				num.WriteByte('*')
+			}
+			num.WriteString(name)
+			if p != 1 {
+				fmt.Fprintf(&num, "^%d", p)
+			}
+		case p < 0:
+			den.WriteByte('/')
+			den.WriteString(name)
+			if p != -1 {
+				fmt.Fprintf(&den, "^%d", -p)
+			}
+		}
+	}
+	if num.Len() == 0 && den.Len() > 0 {
+		num.WriteByte('1')
+	}
+	return num.String() + den.String()
+}
+
+// unitDef is a named unit. A value v in the unit is v*factor+offset in the
+// base units of its dimension; only temperatures have an offset. Differences
+// of a unit with an offset are given in the unit it is defined in terms of.
+type unitDef struct {
+	symbol     string
+	factor     float64
+	offset     float64
+	dimension  Dimension
+	difference Unit
+}
+
+// unitTerm is a named unit raised to a power
+type unitTerm struct {
+	def   *unitDef
+	power int
+}
+
+// Unit is a product of powers of named units, such as "kW*h" or "m/s^2".
+// The zero Unit is dimensionless.
+type Unit struct {
+	terms []unitTerm
+}
+
+// String returns the unit in canonical form; it is empty for the zero Unit
+func (u Unit) String() string {
+	names := make([]string, len(u.terms))
+	powers := make([]int, len(u.terms))
+	for i, t := range u.terms {
+		names[i], powers[i] = t.def.symbol, t.power
+	}
+	return formatPowers(names, powers)
+}
+
+// Dimension returns the dimension of the unit
+func (u Unit) Dimension() Dimension {
+	d := Dimension{}
+	for _, t := range u.terms {
+		for name, power := range t.def.dimension {
+			d[name] += power * t.power
+			if d[name] == 0 {
+				delete(d, name)
+			}
+		}
+	}
+	return d
+}
+
+// factor returns the value of one unit in base units
+func (u Unit) factor() float64 {
+	f := 1.0
+	for _, t := range u.terms {
+		f *= math.Pow(t.def.factor, float64(t.power))
+	}
+	return f
+}
+
+// offset returns the offset of a unit such as degC, which cannot be
+// combined with other units
+func (u Unit) offset() float64 {
+	if len(u.terms) != 1 {
+		return 0
+	}
+	return u.terms[0].def.offset
+}
+
+// difference returns the unit of differences of a unit with an offset, e.g.
+// K for degC, and otherwise the unit itself
+func (u Unit) difference() Unit {
+	if u.offset() == 0 {
+		return u
+	}
+	return u.terms[0].def.difference
+}
+
+// describe names the unit and its dimension in errors
+func (u Unit) describe() string {
+	if len(u.terms) == 0 {
+		return "a dimensionless number"
+	}
+	return fmt.Sprintf("%v (%v)", u, u.Dimension())
+}
+
+// mul returns u * v^power and the factor by which the product of the values
+// must be scaled. Units of v with the dimension of a unit of u are converted
+// to it, so that m/s * min is m.
+func (u Unit) mul(v Unit, power int) (Unit, float64) {
+	terms := append([]unitTerm(nil), u.terms...)
+	scale := 1.0
+
+	for _, t := range v.terms {
+		p := t.power * power
+		i := findTerm(terms, t.def)
+		switch {
+		case i < 0:
+			terms = append(terms, unitTerm{def: t.def, power: p})
+			continue
+		case terms[i].def.symbol != t.def.symbol:
+			scale *= math.Pow(t.def.factor/terms[i].def.factor, float64(p))
+		}
+		terms[i].power += p
+	}
+
+	return Unit{terms: terms}.nonzero(), scale
+}
+
+// add multiplies u by def^power without converting between units, so that
+// "km/m" stays as it is
+func (u *Unit) add(def *unitDef, power int) {
+	for i := range u.terms {
+		if u.terms[i].def.symbol == def.symbol {
+			u.terms[i].power += power
+			return
+		}
+	}
+	u.terms = append(u.terms, unitTerm{def: def, power: power})
+}
+
+// nonzero returns u without the terms of power zero
+func (u Unit) nonzero() Unit {
+	result := Unit{}
+	for _, t := range u.terms {
+		if t.power != 0 {
+			result.terms = append(result.terms, t)
+		}
+	}
+	return result
+}
+
+// findTerm returns the index of the term with the symbol of def, or else
+// with its dimension, or -1
+func findTerm(terms []unitTerm, def *unitDef) int {
+	for i, t := range terms {
+		if t.def.symbol == def.symbol {
+			return i
+		}
+	}
+	for i, t := range terms {
+		if t.def.dimension.equal(def.dimension) {
+			return i
+		}
+	}
+	return -1
+}
+
+// root returns the nth root of u if every power is divisible by n
+func (u Unit) root(n int) (Unit, bool) {
+	result := Unit{terms: make([]unitTerm, len(u.terms))}
+	for i, t := range u.terms {
+		if t.power%n != 0 {
+			return Unit{}, false
+		}
+		result.terms[i] = unitTerm{def: t.def, power: t.power / n}
+	}
+	return result, true
+}
+
+// convertUnit converts value from one unit to another of the same dimension
+func convertUnit(value float64, from, to Unit) (float64, error) {
+	if !from.Dimension().equal(to.Dimension()) {
+		return 0, fmt.Errorf("%w: cannot convert %s to %s", ErrIncompatibleUnits, from.describe(), to.describe())
+	}
+	base := value*from.factor() + from.offset()
+	return (base - to.offset()) / to.factor(), nil
+}
+
+// convertDifference converts a difference of values from one unit to another
+// of the same dimension, ignoring their offsets, so that a difference of 9
+// degF is 5 degC
+func convertDifference(value float64, from, to Unit) (float64, error) {
+	if !from.Dimension().equal(to.Dimension()) {
+		return 0, fmt.Errorf("%w: cannot convert %s to %s", ErrIncompatibleUnits, from.describe(), to.describe())
+	}
+	return value * from.factor() / to.factor(), nil
+}
+
+// UnitRegistry holds the units known to an engine. Symbols are
+// case-sensitive, as "mB" and "MB" are different units.
+type UnitRegistry struct {
+	mu       sync.RWMutex
+	units    map[string]*unitDef
+	prefixes map[string]float64
+	// bases are the base units by dimension
+	bases map[string]string
+}
+
+// NewUnitRegistry creates an empty unit registry
+func NewUnitRegistry() *UnitRegistry {
+	return &UnitRegistry{
+		units:    make(map[string]*unitDef),
+		prefixes: make(map[string]float64),
+		bases:    make(map[string]string),
+	}
+}
+
+// DefaultUnits returns a unit registry with SI and binary prefixes and the
+// built-in units of length, mass, time, data size, energy and temperature
+func DefaultUnits() *UnitRegistry {
+	r := NewUnitRegistry()
+	if err := r.Load(strings.NewReader(builtinUnits)); err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// LoadFile adds the units of a definitions file, see Load
+func (r *UnitRegistry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := r.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Load adds the units of a definitions file. Each line is one of
+//
+//	base m length          the base unit of a new dimension
+//	prefix k 1e3           a prefix that may precede any unit without offset
+//	mi = 1609.344 m        a unit defined as a multiple of a unit expression
+//	degF = 5/9 K + 459.67  a unit with an offset: v degF is (v + 459.67) * 5/9 K
+//
+// Text after # is a comment. Units may be defined in terms of units defined
+// on earlier lines. Nothing is added if any line is invalid.
+func (r *UnitRegistry) Load(rd io.Reader) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next := r.clone()
+	scanner := bufio.NewScanner(rd)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		if err := next.define(text); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	r.units, r.prefixes, r.bases = next.units, next.prefixes, next.bases
+	return nil
+}
+
+// clone copies the definitions of r
+func (r *UnitRegistry) clone() *UnitRegistry {
+	c := NewUnitRegistry()
+	for symbol, def := range r.units {
+		c.units[symbol] = def
+	}
+	for prefix, factor := range r.prefixes {
+		c.prefixes[prefix] = factor
+	}
+	for dimension, symbol := range r.bases {
+		c.bases[dimension] = symbol
+	}
+	return c
+}
+
+// define adds the definition on one line of a definitions file
+func (r *UnitRegistry) define(text string) error {
+	fields := strings.Fields(text)
+
+	switch {
+	case fields[0] == "base" && len(fields) == 3:
+		symbol, dimension := fields[1], fields[2]
+		if err := r.checkSymbol(symbol); err != nil {
+			return err
+		}
+		if !validSymbol(dimension) {
+			return fmt.Errorf("%w: invalid dimension %q", ErrInvalidInput, dimension)
+		}
+		if base, ok := r.bases[dimension]; ok {
+			return fmt.Errorf("%w: dimension %s already has the base unit %s", ErrInvalidInput, dimension, base)
+		}
+		r.bases[dimension] = symbol
+		r.units[symbol] = &unitDef{symbol: symbol, factor: 1, dimension: Dimension{dimension: 1}}
+		return nil
+
+	case fields[0] == "prefix" && len(fields) == 3:
+		prefix := fields[1]
+		factor, err := parseFactor(fields[2])
+		if err != nil || !validSymbol(prefix) {
+			return fmt.Errorf("%w: invalid prefix %q", ErrInvalidInput, text)
+		}
+		if _, ok := r.prefixes[prefix]; ok {
+			return fmt.Errorf("%w: prefix %s is already defined", ErrInvalidInput, prefix)
+		}
+		r.prefixes[prefix] = factor
+		return nil
+	}
+
+	symbol, definition, ok := strings.Cut(text, "=")
+	if !ok {
+		return fmt.Errorf("%w: expected base, prefix or a unit definition, got %q", ErrInvalidInput, text)
+	}
+	symbol = strings.TrimSpace(symbol)
+	if err := r.checkSymbol(symbol); err != nil {
+		return err
+	}
+
+	def, err := r.parseDefinition(definition)
+	if err != nil {
+		return fmt.Errorf("%s: %w", symbol, err)
+	}
+	def.symbol = symbol
+	r.units[symbol] = def
+	return nil
+}
+
+// parseDefinition parses "[factor] [unit] [+ offset]", where the offset is in
+// the new unit
+func (r *UnitRegistry) parseDefinition(definition string) (*unitDef, error) {
+	// The offset follows a plus sign that is not part of an exponent
+	var offsetText string
+	hasOffset := false
+	for i := 0; i < len(definition); i++ {
+		if definition[i] == '+' && (i == 0 || !strings.ContainsRune("eE", rune(definition[i-1]))) {
+			definition, offsetText, hasOffset = definition[:i], definition[i+1:], true
+			break
+		}
+	}
+
+	factor := 1.0
+	expression := strings.TrimSpace(definition)
+	if number, rest, _ := strings.Cut(expression, " "); number != "" {
+		if f, err := parseFactor(number); err == nil {
+			factor, expression = f, strings.TrimSpace(rest)
+		}
+	}
+
+	unit, err := r.parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	if unit.offset() != 0 {
+		return nil, fmt.Errorf("%w: cannot define a unit in terms of %s, which has an offset", ErrInvalidInput, unit)
+	}
+
+	offset := 0.0
+	if hasOffset {
+		offset, err = strconv.ParseFloat(strings.TrimSpace(offsetText), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid offset %q", ErrInvalidInput, offsetText)
+		}
+	}
+
+	f := factor * unit.factor()
+	def := &unitDef{factor: f, offset: offset * f, dimension: unit.Dimension()}
+	if offset != 0 {
+		def.difference = unit
+	}
+	return def, nil
+}
+
+// checkSymbol checks that symbol is valid and not defined yet
+func (r *UnitRegistry) checkSymbol(symbol string) error {
+	if !validSymbol(symbol) {
+		return fmt.Errorf("%w: invalid unit symbol %q", ErrInvalidInput, symbol)
+	}
+	if _, ok := r.units[symbol]; ok {
+		return fmt.Errorf("%w: unit %s is already defined", ErrInvalidInput, symbol)
+	}
+	return nil
+}
+
+// validSymbol reports whether s consists of letters, digits, _, % and °
+// and does not start with a digit
+func validSymbol(s string) bool {
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r), r == '_', r == '%', r == '°':
+		case unicode.IsDigit(r) && i > 0:
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// parseFactor parses a positive number or fraction such as "5/9"
+func parseFactor(s string) (float64, error) {
+	num, den, isFraction := strings.Cut(s, "/")
+	f, err := strconv.ParseFloat(num, 64)
+	if err == nil && isFraction {
+		var d float64
+		d, err = strconv.ParseFloat(den, 64)
+		f /= d
+	}
+	if err != nil || !(f > 0) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: invalid factor %q", ErrInvalidInput, s)
+	}
+	return f, nil
+}
+
+// Parse parses a unit expression such as "kW*h", "MB/s" or "m/s^2". Named
+// units may have a prefix, e.g. "km" or "MiB". The empty string is
+// dimensionless. Units with an offset, such as degC, cannot be combined
+// with other units.
+func (r *UnitRegistry) Parse(s string) (Unit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.parse(s)
+}
+
+// parse implements Parse without locking
+func (r *UnitRegistry) parse(s string) (Unit, error) {
+	var unit Unit
+	rest := strings.TrimSpace(s)
+	power := 1
+
+	for i := 0; rest != ""; i++ {
+		end := strings.IndexAny(rest, "*/")
+		term := rest
+		if end >= 0 {
+			term = rest[:end]
+		}
+		term = strings.TrimSpace(term)
+
+		if term != "1" || i > 0 || power != 1 {
+			t, err := r.parseTerm(term)
+			if err != nil {
+				return Unit{}, err
+			}
+			unit.add(t.def, t.power*power)
+		}
+
+		if end < 0 {
+			break
+		}
+		power = 1
+		if rest[end] == '/' {
+			power = -1
+		}
+		rest = rest[end+1:]
+		if strings.TrimSpace(rest) == "" {
+			return Unit{}, fmt.Errorf("%w: unit %q ends with an operator", ErrInvalidInput, s)
+		}
+	}
+
+	unit = unit.nonzero()
+	for _, t := range unit.terms {
+		if t.def.offset != 0 && (len(unit.terms) > 1 || t.power != 1) {
+			return Unit{}, fmt.Errorf("%w: %s has an offset and cannot be combined with other units", ErrInvalidInput, t.def.symbol)
+		}
+	}
+	return unit, nil
+}
+
+// parseTerm parses a unit with an optional power such as "s^2"
+func (r *UnitRegistry) parseTerm(term string) (unitTerm, error) {
+	symbol, exponent, hasPower := strings.Cut(term, "^")
+	symbol = strings.TrimSpace(symbol)
+
+	power := 1
+	if hasPower {
+		var err error
+		power, err = strconv.Atoi(strings.TrimSpace(exponent))
+		if err != nil || power == 0 {
+			return unitTerm{}, fmt.Errorf("%w: invalid power in %q", ErrInvalidInput, term)
+		}
+	}
+
+	def, ok := r.lookup(symbol)
+	if !ok {
+		return unitTerm{}, fmt.Errorf("%w: unknown unit %q", ErrInvalidInput, symbol)
+	}
+	return unitTerm{def: def, power: power}, nil
+}
+
+// lookup returns the named unit, which may have a prefix. The longest
+// matching prefix wins, so that "dam" is a decameter.
+func (r *UnitRegistry) lookup(symbol string) (*unitDef, bool) {
+	if def, ok := r.units[symbol]; ok {
+		return def, true
+	}
+
+	var best string
+	var base *unitDef
+	for prefix := range r.prefixes {
+		if len(prefix) <= len(best) || !strings.HasPrefix(symbol, prefix) {
+			continue
+		}
+		if def, ok := r.units[symbol[len(prefix):]]; ok && def.offset == 0 {
+			best, base = prefix, def
+		}
+	}
+	if base == nil {
+		return nil, false
+	}
+
+	return &unitDef{
+		symbol:    symbol,
+		factor:    r.prefixes[best] * base.factor,
+		dimension: base.dimension,
+	}, true
+}
@@ -0,0 +1,204 @@
+// Package calcstatus maps calculation errors to gRPC status errors and back.
+//
+// Servers convert errors from package calc with ToStatus. The resulting status
+// carries an ErrorInfo whose reason names the ErrorKind and, when the error is
+// tied to an input field, a BadRequest with the field violation. Clients
+// convert status errors with FromStatus so that the sentinel errors of package
+// calc can be matched with errors.Is.
+package calcstatus
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"llamacalc/pkg/calc"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// Domain is the ErrorInfo domain of LlamaCalc errors
+const Domain = "llamacalc"
+
+// kindInfo describes how an error kind is reported
+type kindInfo struct {
+	kind pb.ErrorKind
+	err  error
+	code codes.Code
+}
+
+// kinds lists every error kind with its sentinel error and gRPC status code
+var kinds = []kindInfo{
+	{pb.ErrorKind_ERROR_KIND_INVALID_INPUT, calc.ErrInvalidInput, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_DIVIDE_BY_ZERO, calc.ErrDivideByZero, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_OVERFLOW, calc.ErrOverflow, codes.OutOfRange},
+	{pb.ErrorKind_ERROR_KIND_UNDERFLOW, calc.ErrUnderflow, codes.OutOfRange},
+	{pb.ErrorKind_ERROR_KIND_UNKNOWN_OPERATION, calc.ErrUnknownOperation, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_DOMAIN, calc.ErrDomain, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_SINGULAR_MATRIX, calc.ErrSingular, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_INCOMPATIBLE_UNITS, calc.ErrIncompatibleUnits, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_CURRENCY_MISMATCH, calc.ErrCurrencyMismatch, codes.InvalidArgument},
+	{pb.ErrorKind_ERROR_KIND_NOT_CONVERGED, calc.ErrNotConverged, codes.InvalidArgument},
+}
+
+// metadataError is implemented by errors that carry details for the
+// ErrorInfo metadata, e.g. the condition number of a singular matrix
+type metadataError interface {
+	error
+	Metadata() map[string]string
+}
+
+// Error is a calculation error received from a server
+type Error struct {
+	Kind    pb.ErrorKind
+	Field   string
+	Message string
+	// Metadata holds the ErrorInfo metadata, e.g. "condition_number"
+	Metadata map[string]string
+
+	status *status.Status
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the sentinel error of the error kind
+func (e *Error) Unwrap() error {
+	if info, ok := lookupKind(e.Kind); ok {
+		return info.err
+	}
+	return nil
+}
+
+// GRPCStatus returns the original status so status.Code keeps working
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// KindOf returns the error kind of err, or ERROR_KIND_UNSPECIFIED if err is
+// not a calculation error
+func KindOf(err error) pb.ErrorKind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+
+	for _, info := range kinds {
+		if errors.Is(err, info.err) {
+			return info.kind
+		}
+	}
+
+	return pb.ErrorKind_ERROR_KIND_UNSPECIFIED
+}
+
+// Reason returns the ErrorInfo reason for an error kind
+func Reason(kind pb.ErrorKind) string {
+	return strings.TrimPrefix(kind.String(), "ERROR_KIND_")
+}
+
+// ToStatus converts an error returned by the calculation engine into a gRPC
+// status error. Errors that already carry a status are returned unchanged.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	kind := KindOf(err)
+	info, ok := lookupKind(kind)
+	if !ok {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: Reason(kind),
+		Domain: Domain,
+	}
+	var metaErr metadataError
+	if errors.As(err, &metaErr) {
+		errorInfo.Metadata = metaErr.Metadata()
+	}
+	details := []protoadapt.MessageV1{errorInfo}
+
+	var fieldErr *calc.FieldError
+	if errors.As(err, &fieldErr) {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       fieldErr.Field,
+				Description: fieldErr.Err.Error(),
+			}},
+		})
+	}
+
+	st := status.New(info.code, err.Error())
+	if withDetails, detailErr := st.WithDetails(details...); detailErr == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// FromStatus converts a gRPC status error received from a server into an
+// *Error when it describes a calculation error. Other errors are returned
+// unchanged.
+func FromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() == codes.OK {
+		return err
+	}
+
+	e := &Error{
+		Message: st.Message(),
+		status:  st,
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Domain != Domain {
+				continue
+			}
+			if value, ok := pb.ErrorKind_value["ERROR_KIND_"+d.Reason]; ok {
+				e.Kind = pb.ErrorKind(value)
+			}
+			e.Metadata = d.Metadata
+		case *errdetails.BadRequest:
+			if len(d.FieldViolations) > 0 {
+				e.Field = d.FieldViolations[0].Field
+			}
+		}
+	}
+
+	if e.Kind == pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+		return err
+	}
+
+	return e
+}
+
+// lookupKind returns the description of an error kind
+func lookupKind(kind pb.ErrorKind) (kindInfo, bool) {
+	for _, info := range kinds {
+		if info.kind == kind {
+			return info, true
+		}
+	}
+	return kindInfo{}, false
+}
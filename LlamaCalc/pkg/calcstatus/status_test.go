@@ -0,0 +1,116 @@
+package calcstatus_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/linalg"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// kinds is the sentinel error and status code of every error kind
+var kinds = map[pb.ErrorKind]struct {
+	Err  error
+	Code codes.Code
+}{
+	pb.ErrorKind_ERROR_KIND_INVALID_INPUT:      {calc.ErrInvalidInput, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_DIVIDE_BY_ZERO:     {calc.ErrDivideByZero, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_OVERFLOW:           {calc.ErrOverflow, codes.OutOfRange},
+	pb.ErrorKind_ERROR_KIND_UNDERFLOW:          {calc.ErrUnderflow, codes.OutOfRange},
+	pb.ErrorKind_ERROR_KIND_UNKNOWN_OPERATION:  {calc.ErrUnknownOperation, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_DOMAIN:             {calc.ErrDomain, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_SINGULAR_MATRIX:    {calc.ErrSingular, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_INCOMPATIBLE_UNITS: {calc.ErrIncompatibleUnits, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_CURRENCY_MISMATCH:  {calc.ErrCurrencyMismatch, codes.InvalidArgument},
+	pb.ErrorKind_ERROR_KIND_NOT_CONVERGED:      {calc.ErrNotConverged, codes.InvalidArgument},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for value, name := range pb.ErrorKind_name {
+		kind := pb.ErrorKind(value)
+		if kind == pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+			continue
+		}
+		want, ok := kinds[kind]
+		if !ok {
+			t.Errorf("%s: no test case", name)
+			continue
+		}
+
+		t.Run(calcstatus.Reason(kind), func(t *testing.T) {
+			for _, field := range []string{"", "b"} {
+				var err error = fmt.Errorf("%w: detail", want.Err)
+				if field != "" {
+					err = &calc.FieldError{Field: field, Err: err}
+				}
+
+				st := calcstatus.ToStatus(err)
+				if status.Code(st) != want.Code || status.Convert(st).Message() != err.Error() {
+					t.Errorf("got status %v, want %v with message %q", st, want.Code, err)
+				}
+
+				got := calcstatus.FromStatus(st)
+				var e *calcstatus.Error
+				if !errors.As(got, &e) || e.Kind != kind || e.Field != field || e.Message != err.Error() {
+					t.Fatalf("got %#v, want kind %v and field %q", got, kind, field)
+				}
+				if !errors.Is(got, want.Err) || calcstatus.KindOf(got) != kind || status.Code(got) != want.Code {
+					t.Errorf("got %v, which does not match %v", got, want.Err)
+				}
+				for other, info := range kinds {
+					if other != kind && errors.Is(got, info.Err) {
+						t.Errorf("got %v, which matches %v", got, info.Err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	tests := []struct {
+		Err  error
+		Want map[string]string
+	}{
+		{&linalg.SingularError{Condition: 1e17}, map[string]string{"condition_number": "1e+17"}},
+		{&calc.ConvergenceError{Iterations: 100, Estimate: 0.5, Residual: 1e-3}, map[string]string{"iterations": "100", "estimate": "0.5", "residual": "0.001"}},
+	}
+	for _, tc := range tests {
+		var e *calcstatus.Error
+		if !errors.As(calcstatus.FromStatus(calcstatus.ToStatus(tc.Err)), &e) || fmt.Sprint(e.Metadata) != fmt.Sprint(tc.Want) {
+			t.Errorf("%v: got %+v, want metadata %v", tc.Err, e, tc.Want)
+		}
+	}
+}
+
+func TestOtherErrors(t *testing.T) {
+	tests := []struct {
+		Err  error
+		Code codes.Code
+	}{
+		{errors.New("disk full"), codes.Internal},
+		{context.Canceled, codes.Canceled},
+		{fmt.Errorf("evaluate: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{status.Error(codes.PermissionDenied, "no"), codes.PermissionDenied},
+	}
+	for _, tc := range tests {
+		st := calcstatus.ToStatus(tc.Err)
+		if status.Code(st) != tc.Code {
+			t.Errorf("%v: got %v, want %v", tc.Err, status.Code(st), tc.Code)
+		}
+		// Statuses without an error kind are returned unchanged
+		if got := calcstatus.FromStatus(st); got != st || calcstatus.KindOf(got) != pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+			t.Errorf("%v: got %#v", tc.Err, got)
+		}
+	}
+	if calcstatus.ToStatus(nil) != nil || calcstatus.FromStatus(nil) != nil {
+		t.Error("nil is not returned unchanged")
+	}
+}
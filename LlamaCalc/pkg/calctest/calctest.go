@@ -0,0 +1,358 @@
+// Package calctest runs a complete LlamaCalc gRPC server in process for tests.
+//
+// NewServer starts a server.GRPCServer on an in-memory bufconn listener and
+// returns it together with a ready LlamaCalcClient, so that consumers can test
+// their retry, fallback and auth handling without network access:
+//
+//	srv := calctest.NewServer(t, calctest.WithJWTAuth("USER"))
+//	srv.SetFaults(calctest.Fault{Err: status.Error(codes.Unavailable, "down"), Count: 2})
+//	result, err := srv.Client().Add(ctx, 1, 2)
+package calctest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/test/bufconn"
+
+	client "llamacalc/api/client/go"
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/server"
+)
+
+// bufnetAddr is the address clients dial; it is also the TLS server name
+const bufnetAddr = "bufnet"
+
+// bufSize is the buffer size of the in-memory listener
+const bufSize = 1024 * 1024
+
+// options collects the configuration of a test server
+type options struct {
+	tls          bool
+	mtls         bool
+	certRole     string
+	jwtAuth      bool
+	jwtRole      string
+	faults       []Fault
+	seed         int64
+	serverConfig func(*server.Config)
+	clientConfig func(*client.ClientConfig)
+}
+
+// Option configures a test server
+type Option func(*options)
+
+// WithTLS serves over TLS using a generated throwaway CA
+func WithTLS() Option {
+	return func(o *options) {
+		o.tls = true
+	}
+}
+
+// WithMTLS serves over mutual TLS. The client certificate carries role as its
+// OU ("Admin", "Guest" or anything else for a regular user), which the server
+// uses for RBAC.
+func WithMTLS(role string) Option {
+	return func(o *options) {
+		o.tls = true
+		o.mtls = true
+		o.certRole = role
+	}
+}
+
+// WithJWTAuth enables authentication and RBAC. The returned client sends a
+// token signed by the test signer for the given role (e.g. "ADMIN").
+func WithJWTAuth(role string) Option {
+	return func(o *options) {
+		o.jwtAuth = true
+		o.jwtRole = role
+	}
+}
+
+// WithFaults injects faults into the server from the start
+func WithFaults(faults ...Fault) Option {
+	return func(o *options) {
+		o.faults = append(o.faults, faults...)
+	}
+}
+
+// WithSeed sets the seed used to decide probabilistic faults
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = seed
+	}
+}
+
+// WithServerConfig lets tests adjust the server configuration
+func WithServerConfig(fn func(*server.Config)) Option {
+	return func(o *options) {
+		o.serverConfig = fn
+	}
+}
+
+// WithClientConfig lets tests adjust the configuration of the returned client
+func WithClientConfig(fn func(*client.ClientConfig)) Option {
+	return func(o *options) {
+		o.clientConfig = fn
+	}
+}
+
+// Server is an in-process LlamaCalc server
+type Server struct {
+	tb         testing.TB
+	opts       *options
+	grpcServer *server.GRPCServer
+	listener   *bufconn.Listener
+	certs      *certFiles
+	jwtManager *auth.JWTManager
+	faults     *faultInjector
+	client     *client.LlamaCalcClient
+}
+
+// NewServer starts a test server and a client connected to it. Both are
+// shut down when the test finishes.
+func NewServer(tb testing.TB, opts ...Option) *Server {
+	tb.Helper()
+
+	o := &options{
+		certRole: "User",
+		jwtRole:  string(auth.RoleAdmin),
+		seed:     1,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	s := &Server{
+		tb:       tb,
+		opts:     o,
+		listener: bufconn.Listen(bufSize),
+		faults:   newFaultInjector(o.seed),
+	}
+	s.faults.set(o.faults)
+
+	config := &server.Config{
+		MaxRecvMsgSize:       4 * 1024 * 1024,
+		MaxSendMsgSize:       4 * 1024 * 1024,
+		MaxConcurrentStreams: 100,
+		Keepalive: keepalive.ServerParameters{
+			Time:    time.Minute,
+			Timeout: 10 * time.Second,
+		},
+		MaxPrecision:         10,
+		MaxDecimalPlaces:     10,
+		OverflowCheckEnabled: true,
+		UnaryInterceptors:    []grpc.UnaryServerInterceptor{s.faults.Unary()},
+		StreamInterceptors:   []grpc.StreamServerInterceptor{s.faults.Stream()},
+	}
+
+	if o.tls {
+		certs, err := generateCerts(tb.TempDir(), o.certRole)
+		if err != nil {
+			tb.Fatalf("calctest: %v", err)
+		}
+		s.certs = certs
+
+		config.TLSEnabled = true
+		config.MTLSEnabled = o.mtls
+		config.CertFile = certs.ServerCert
+		config.KeyFile = certs.ServerKey
+		config.CAFile = certs.CACert
+	}
+
+	if o.jwtAuth || o.mtls {
+		config.AuthEnabled = true
+		config.RBACEnabled = true
+		config.JWTSecretKey = randomSecret(tb)
+		config.JWTTokenDuration = time.Hour
+		s.jwtManager = auth.NewJWTManager(config.JWTSecretKey, config.JWTTokenDuration)
+	}
+
+	if o.serverConfig != nil {
+		o.serverConfig(config)
+	}
+
+	grpcServer, err := server.NewGRPCServer(config)
+	if err != nil {
+		tb.Fatalf("calctest: failed to create server: %v", err)
+	}
+	s.grpcServer = grpcServer
+
+	go func() {
+		_ = grpcServer.Serve(s.listener)
+	}()
+	tb.Cleanup(s.Close)
+
+	c, err := s.NewClient(o.clientConfig)
+	if err != nil {
+		tb.Fatalf("calctest: failed to create client: %v", err)
+	}
+	s.client = c
+
+	return s
+}
+
+// Client returns the client created with the server
+func (s *Server) Client() *client.LlamaCalcClient {
+	return s.client
+}
+
+// NewClient creates another client connected to the server. It is configured
+// like the default client before modify is applied. The caller must close it.
+func (s *Server) NewClient(modify func(*client.ClientConfig)) (*client.LlamaCalcClient, error) {
+	config := s.ClientConfig()
+	if modify != nil {
+		modify(config)
+	}
+	return client.NewLlamaCalcClient(config)
+}
+
+// ClientConfig returns a client configuration that connects to the server
+// with the credentials selected by the options
+func (s *Server) ClientConfig() *client.ClientConfig {
+	config := client.DefaultClientConfig()
+	config.ServerAddress = bufnetAddr
+	config.DialOptions = []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		}),
+	}
+
+	if s.certs != nil {
+		config.Insecure = false
+		config.TLSEnabled = true
+		config.MTLSEnabled = s.opts.mtls
+		config.CACertFile = s.certs.CACert
+		if s.opts.mtls {
+			config.CertFile = s.certs.ClientCert
+			config.KeyFile = s.certs.ClientKey
+		}
+	}
+
+	if s.opts.jwtAuth {
+		config.JWTToken = s.Token("calctest", s.opts.jwtRole)
+		config.AllowInsecureCredentials = !s.opts.tls
+	}
+
+	return config
+}
+
+// Dial opens a raw connection to the server with the credentials selected by
+// the options, for calling services through generated stubs. The caller must
+// close it.
+func (s *Server) Dial(opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if s.certs != nil {
+		tlsConfig, err := s.clientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		}),
+	}
+	if s.opts.jwtAuth {
+		source := client.StaticTokenSource(s.Token("calctest", s.opts.jwtRole))
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(client.NewTokenCredentials(source, !s.opts.tls)))
+	}
+
+	return grpc.Dial(bufnetAddr, append(dialOpts, opts...)...)
+}
+
+// clientTLSConfig returns the TLS configuration of a client trusting the test CA
+func (s *Server) clientTLSConfig() (*tls.Config, error) {
+	caPEM, err := os.ReadFile(s.certs.CACert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA cert: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("failed to add CA cert to pool")
+	}
+
+	config := &tls.Config{
+		RootCAs:    pool,
+		ServerName: bufnetAddr,
+		MinVersion: tls.VersionTLS13,
+	}
+
+	if s.opts.mtls {
+		cert, err := tls.LoadX509KeyPair(s.certs.ClientCert, s.certs.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client key pair: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// Token signs a token for username and role with the test signer
+func (s *Server) Token(username, role string) string {
+	return s.TenantToken(username, role, "")
+}
+
+// TenantToken signs a token for username and role in a tenant with the test
+// signer
+func (s *Server) TenantToken(username, role, tenant string) string {
+	if s.jwtManager == nil {
+		s.tb.Fatalf("calctest: Token requires WithJWTAuth or WithMTLS")
+	}
+
+	token, err := s.jwtManager.GenerateForTenant(username, role, tenant)
+	if err != nil {
+		s.tb.Fatalf("calctest: failed to sign token: %v", err)
+	}
+	return token
+}
+
+// SetFaults replaces the injected faults; call it without arguments to stop
+// injecting faults
+func (s *Server) SetFaults(faults ...Fault) {
+	s.faults.set(faults)
+}
+
+// GRPCServer returns the underlying server
+func (s *Server) GRPCServer() *server.GRPCServer {
+	return s.grpcServer
+}
+
+// Close shuts down the client and the server. It is called automatically
+// when the test finishes.
+func (s *Server) Close() {
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.Stop()
+		s.grpcServer = nil
+	}
+}
+
+// randomSecret returns a random JWT signing secret
+func randomSecret(tb testing.TB) string {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		tb.Fatalf("calctest: failed to generate secret: %v", err)
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,202 @@
+package calctest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calctest"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// calculatorClient returns a Calculator client of srv on a raw connection,
+// which does not retry
+func calculatorClient(t *testing.T, srv *calctest.Server, opts ...grpc.DialOption) pb.CalculatorClient {
+	t.Helper()
+	conn, err := srv.Dial(opts...)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewCalculatorClient(conn)
+}
+
+func TestTLS(t *testing.T) {
+	srv := calctest.NewServer(t, calctest.WithTLS())
+	ctx := context.Background()
+
+	result, err := srv.Client().Add(ctx, 1, 2)
+	if err != nil || result != 3 {
+		t.Fatalf("got %v, %v", result, err)
+	}
+	resp, err := calculatorClient(t, srv).Add(ctx, &pb.CalculationRequest{A: 1, B: 2})
+	if err != nil || resp.Result != 3 {
+		t.Fatalf("got %v, %v", resp, err)
+	}
+
+	_, err = calculatorClient(t, srv, grpc.WithTransportCredentials(insecure.NewCredentials())).Add(ctx, &pb.CalculationRequest{A: 1, B: 2})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v without TLS, want Unavailable", err)
+	}
+}
+
+func TestMTLSRoles(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		Role string
+		// Want are the codes of Add, Multiply and Divide, which need the
+		// roles GUEST, USER and ADMIN
+		Want [3]codes.Code
+	}{
+		{"Admin", [3]codes.Code{codes.OK, codes.OK, codes.OK}},
+		{"User", [3]codes.Code{codes.OK, codes.OK, codes.PermissionDenied}},
+		{"Guest", [3]codes.Code{codes.OK, codes.PermissionDenied, codes.PermissionDenied}},
+		{"Other", [3]codes.Code{codes.OK, codes.OK, codes.PermissionDenied}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Role, func(t *testing.T) {
+			c := calculatorClient(t, calctest.NewServer(t, calctest.WithMTLS(tc.Role)))
+			req := &pb.CalculationRequest{A: 6, B: 3}
+
+			_, err := c.Add(ctx, req)
+			if status.Code(err) != tc.Want[0] {
+				t.Errorf("Add: got %v, want %v", err, tc.Want[0])
+			}
+			_, err = c.Multiply(ctx, req)
+			if status.Code(err) != tc.Want[1] {
+				t.Errorf("Multiply: got %v, want %v", err, tc.Want[1])
+			}
+			_, err = c.Divide(ctx, req)
+			if status.Code(err) != tc.Want[2] {
+				t.Errorf("Divide: got %v, want %v", err, tc.Want[2])
+			}
+		})
+	}
+}
+
+func TestFaultCount(t *testing.T) {
+	srv := calctest.NewServer(t, calctest.WithFaults(calctest.Fault{
+		Method: pb.Calculator_Divide_FullMethodName,
+		Err:    calc.ErrDivideByZero,
+		Count:  2,
+	}))
+	c := calculatorClient(t, srv)
+	ctx := context.Background()
+	req := &pb.CalculationRequest{A: 6, B: 3}
+
+	// Other methods are not affected
+	if _, err := c.Add(ctx, req); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := c.Divide(ctx, req)
+		if !errors.Is(calcstatus.FromStatus(err), calc.ErrDivideByZero) {
+			t.Fatalf("call %d: got %v, want the injected error", i+1, err)
+		}
+	}
+	if resp, err := c.Divide(ctx, req); err != nil || resp.Result != 2 {
+		t.Errorf("got %v, %v after the fault's count", resp, err)
+	}
+
+	// SetFaults replaces the faults and their counts
+	srv.SetFaults(calctest.Fault{Err: status.Error(codes.Unavailable, "down"), Count: 1})
+	if _, err := c.Divide(ctx, req); status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v, want Unavailable", err)
+	}
+	srv.SetFaults()
+	if _, err := c.Divide(ctx, req); err != nil {
+		t.Errorf("got %v without faults", err)
+	}
+}
+
+// failures returns which of n calls fail with a fault of rate on a server
+// with seed
+func failures(t *testing.T, seed int64, rate float64, n int) []bool {
+	t.Helper()
+	c := calculatorClient(t, calctest.NewServer(t,
+		calctest.WithSeed(seed),
+		calctest.WithFaults(calctest.Fault{Err: status.Error(codes.Unavailable, "down"), Rate: rate}),
+	))
+
+	failed := make([]bool, n)
+	for i := range failed {
+		_, err := c.Add(context.Background(), &pb.CalculationRequest{A: 1, B: 2})
+		failed[i] = err != nil
+	}
+	return failed
+}
+
+func TestFaultRate(t *testing.T) {
+	const n = 50
+	first := failures(t, 42, 0.5, n)
+	count := 0
+	for _, failed := range first {
+		if failed {
+			count++
+		}
+	}
+	if count == 0 || count == n {
+		t.Errorf("%d of %d calls failed at rate 0.5", count, n)
+	}
+
+	// The same seed fails the same calls
+	second := failures(t, 42, 0.5, n)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("call %d: got failed %v, then %v with the same seed", i+1, first[i], second[i])
+		}
+	}
+}
+
+func TestFaultLatency(t *testing.T) {
+	const latency = 50 * time.Millisecond
+	srv := calctest.NewServer(t, calctest.WithFaults(calctest.Fault{Latency: latency}))
+	c := calculatorClient(t, srv)
+	req := &pb.CalculationRequest{A: 1, B: 2}
+
+	start := time.Now()
+	if _, err := c.Add(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < latency {
+		t.Errorf("call took %v, want at least %v", elapsed, latency)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), latency/5)
+	defer cancel()
+	if _, err := c.Add(ctx, req); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("got %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestStreamFaults(t *testing.T) {
+	srv := calctest.NewServer(t, calctest.WithFaults(calctest.Fault{
+		Method: pb.Calculator_StatisticsStream_FullMethodName,
+		Err:    status.Error(codes.Unavailable, "down"),
+		Count:  1,
+	}))
+	c := calculatorClient(t, srv)
+	ctx := context.Background()
+
+	for i, want := range []codes.Code{codes.Unavailable, codes.OK} {
+		stream, err := c.StatisticsStream(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := stream.Send(&pb.StatisticsRequest{Data: []float64{1, 2}}); err != nil && want == codes.OK {
+			t.Fatal(err)
+		}
+		_, err = stream.CloseAndRecv()
+		if status.Code(err) != want {
+			t.Errorf("stream %d: got %v, want %v", i+1, err, want)
+		}
+	}
+}
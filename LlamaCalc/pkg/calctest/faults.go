@@ -0,0 +1,124 @@
+package calctest
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"llamacalc/pkg/calcstatus"
+)
+
+// Fault describes a failure injected into matching RPCs, unary or streaming
+type Fault struct {
+	// Method is the full RPC method name (e.g. "/llamacalc.v1.Calculator/Divide");
+	// empty matches every method
+	Method string
+	// Latency is added before the RPC is handled, or a stream is opened
+	Latency time.Duration
+	// Rate is the probability (0-1] that Err is returned; 0 means always
+	Rate float64
+	// Err is returned instead of calling the handler. Errors from package calc
+	// are converted to typed status errors, so calc.ErrDivideByZero injects a
+	// DIVIDE_BY_ZERO error. Nil only injects latency.
+	Err error
+	// Count limits how many times the fault fires; 0 means unlimited
+	Count int
+}
+
+// faultInjector is a server interceptor that applies the configured faults
+type faultInjector struct {
+	mu     sync.Mutex
+	faults []*Fault
+	fired  map[*Fault]int
+	rand   *rand.Rand
+}
+
+// newFaultInjector creates a fault injector with a deterministic random source
+func newFaultInjector(seed int64) *faultInjector {
+	return &faultInjector{
+		fired: make(map[*Fault]int),
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// set replaces the active faults
+func (f *faultInjector) set(faults []Fault) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults = nil
+	f.fired = make(map[*Fault]int)
+	for i := range faults {
+		fault := faults[i]
+		f.faults = append(f.faults, &fault)
+	}
+}
+
+// match returns the latency and error to inject for method
+func (f *faultInjector) match(method string) (time.Duration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var latency time.Duration
+	for _, fault := range f.faults {
+		if fault.Method != "" && fault.Method != method {
+			continue
+		}
+		if fault.Count > 0 && f.fired[fault] >= fault.Count {
+			continue
+		}
+		if fault.Rate > 0 && f.rand.Float64() >= fault.Rate {
+			continue
+		}
+
+		f.fired[fault]++
+		latency += fault.Latency
+		if fault.Err != nil {
+			return latency, calcstatus.ToStatus(fault.Err)
+		}
+	}
+
+	return latency, nil
+}
+
+// inject waits for the latency to inject for method and returns the error
+// to inject, if any
+func (f *faultInjector) inject(ctx context.Context, method string) error {
+	latency, err := f.match(method)
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return calcstatus.ToStatus(ctx.Err())
+		case <-timer.C:
+		}
+	}
+
+	return err
+}
+
+// Unary returns the fault injecting server interceptor
+func (f *faultInjector) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := f.inject(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the fault injecting stream interceptor. Faults apply when
+// a stream starts, before any message is received.
+func (f *faultInjector) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := f.inject(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -1,4 +1,4 @@
-// Package calculator provides the core calculation functionality for LlamaCalc
+// Package calculator provides the Calculator gRPC service on top of a calc.Engine
 package calculator
 
 import (
@@ -8,10 +8,12 @@ import (
 	"math"
 	"time"
 
-	pb "llamacalc/pkg/proto"
-
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
 )
 
 // Operation type
@@ -28,141 +30,308 @@ const (
 // Service implements the Calculator gRPC service
 type Service struct {
 	pb.UnimplementedCalculatorServer
+
+	engine calc.Engine
 }
 
-// NewService creates a new calculator service
-func NewService() *Service {
-	return &Service{}
+// NewService creates a new calculator service that delegates to engine
+func NewService(engine calc.Engine) *Service {
+	return &Service{
+		engine: engine,
+	}
 }
 
 // Add implements the Add RPC method
 func (s *Service) Add(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	// Check for context timeout or cancellation
-	if ctx.Err() != nil {
-		return nil, status.Errorf(codes.Canceled, "request cancelled or timed out")
+	return ToResponse(s.calculate(ctx, "Add", req))
+}
+
+// Subtract implements the Subtract RPC method
+func (s *Service) Subtract(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
+	return ToResponse(s.calculate(ctx, "Subtract", req))
+}
+
+// Multiply implements the Multiply RPC method
+func (s *Service) Multiply(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
+	return ToResponse(s.calculate(ctx, "Multiply", req))
+}
+
+// Divide implements the Divide RPC method
+func (s *Service) Divide(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
+	return ToResponse(s.calculate(ctx, "Divide", req))
+}
+
+// Invoke implements the Invoke RPC method
+func (s *Service) Invoke(ctx context.Context, req *pb.InvokeRequest) (*pb.CalculationResponse, error) {
+	ctx = WithAngleUnit(ctx, req.AngleUnit)
+	if !hasUnits(req.Units...) {
+		return ToResponse(s.engine.Invoke(ctx, req.Operation, req.Args...))
 	}
 
-	// Add a small delay to simulate processing time (for demonstration purposes)
-	time.Sleep(10 * time.Millisecond)
+	args := make([]calc.Quantity, len(req.Args))
+	for i, arg := range req.Args {
+		args[i].Value = arg
+		if i < len(req.Units) {
+			args[i].Unit = req.Units[i]
+		}
+	}
+	engine, ok := s.engine.(calc.UnitEngine)
+	if !ok {
+		return nil, unsupported("unit")
+	}
+	return ToResponse(engine.InvokeUnits(ctx, req.Operation, args...))
+}
 
-	// Check for potential overflow
-	if (req.A > 0 && req.B > math.MaxFloat64-req.A) || (req.A < 0 && req.B < -math.MaxFloat64-req.A) {
-		return &pb.CalculationResponse{
-			Result:       0,
-			StatusCode:   1,
-			ErrorMessage: "overflow detected in addition operation",
-			Operation:    string(OpAdd),
-		}, nil
+// Convert implements the Convert RPC method
+func (s *Service) Convert(ctx context.Context, req *pb.ConvertRequest) (*pb.CalculationResponse, error) {
+	engine, ok := s.engine.(calc.UnitEngine)
+	if !ok {
+		return nil, unsupported("unit")
 	}
+	return ToResponse(engine.Convert(ctx, req.Value, req.From, req.To))
+}
 
-	result := req.A + req.B
+// calculate performs a two-argument operation, with units if the request
+// has any
+func (s *Service) calculate(ctx context.Context, op string, req *pb.CalculationRequest) calc.CalculationResult {
+	if !hasUnits(req.AUnit, req.BUnit) {
+		return s.engine.Calculate(ctx, op, req.A, req.B)
+	}
+	engine, ok := s.engine.(calc.UnitEngine)
+	if !ok {
+		return calc.CalculationResult{Operation: op, Error: unsupported("unit")}
+	}
+	return engine.InvokeUnits(ctx, op,
+		calc.Quantity{Value: req.A, Unit: req.AUnit},
+		calc.Quantity{Value: req.B, Unit: req.BUnit},
+	)
+}
 
-	return &pb.CalculationResponse{
-		Result:       result,
-		StatusCode:   0,
-		ErrorMessage: "",
-		Operation:    string(OpAdd),
-	}, nil
+// unsupported reports a mode of calculation the engine does not implement
+func unsupported(mode string) error {
+	return status.Errorf(codes.Unimplemented, "%s calculations are not supported by the engine", mode)
 }
 
-// Subtract implements the Subtract RPC method
-func (s *Service) Subtract(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	// Check for context timeout or cancellation
-	if ctx.Err() != nil {
-		return nil, status.Errorf(codes.Canceled, "request cancelled or timed out")
+// hasUnits reports whether any of units is set
+func hasUnits(units ...string) bool {
+	for _, unit := range units {
+		if unit != "" {
+			return true
+		}
 	}
+	return false
+}
 
-	// Add a small delay to simulate processing time (for demonstration purposes)
-	time.Sleep(10 * time.Millisecond)
+// Evaluate implements the Evaluate RPC method
+func (s *Service) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.CalculationResponse, error) {
+	ctx = WithAngleUnit(ctx, req.AngleUnit)
+	return ToResponse(s.engine.Evaluate(ctx, req.Expression))
+}
 
-	// Check for potential overflow
-	if (req.A > 0 && req.B < req.A-math.MaxFloat64) || (req.A < 0 && req.B > req.A+math.MaxFloat64) {
-		return &pb.CalculationResponse{
-			Result:       0,
-			StatusCode:   1,
-			ErrorMessage: "overflow detected in subtraction operation",
-			Operation:    string(OpSubtract),
-		}, nil
+// ComplexCalculate implements the ComplexCalculate RPC method
+func (s *Service) ComplexCalculate(ctx context.Context, req *pb.ComplexRequest) (*pb.ComplexResponse, error) {
+	args := make([]complex128, len(req.Args))
+	for i, arg := range req.Args {
+		args[i] = complex(arg.GetReal(), arg.GetImag())
 	}
 
-	result := req.A - req.B
+	engine, ok := s.engine.(calc.ComplexEngine)
+	if !ok {
+		return nil, unsupported("complex")
+	}
+	ctx = WithAngleUnit(ctx, req.AngleUnit)
+	return ToComplexResponse(engine.Complex(ctx, req.Operation, args...))
+}
 
-	return &pb.CalculationResponse{
-		Result:       result,
-		StatusCode:   0,
-		ErrorMessage: "",
-		Operation:    string(OpSubtract),
-	}, nil
+// RationalCalculate implements the RationalCalculate RPC method
+func (s *Service) RationalCalculate(ctx context.Context, req *pb.RationalRequest) (*pb.RationalResponse, error) {
+	engine, ok := s.engine.(calc.RationalEngine)
+	if !ok {
+		return nil, unsupported("rational")
+	}
+	return ToRationalResponse(engine.Rational(ctx, req.Operation, req.Args...))
 }
 
-// Multiply implements the Multiply RPC method
-func (s *Service) Multiply(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	// Check for context timeout or cancellation
-	if ctx.Err() != nil {
-		return nil, status.Errorf(codes.Canceled, "request cancelled or timed out")
+// IntegerCalculate implements the IntegerCalculate RPC method. The
+// operation stops with DEADLINE_EXCEEDED at the request deadline.
+func (s *Service) IntegerCalculate(ctx context.Context, req *pb.IntegerRequest) (*pb.IntegerResponse, error) {
+	engine, ok := s.engine.(calc.IntegerEngine)
+	if !ok {
+		return nil, unsupported("integer")
 	}
+	return ToIntegerResponse(engine.Integer(ctx, req.Operation, req.Args...))
+}
 
-	// Add a small delay to simulate processing time (for demonstration purposes)
-	time.Sleep(10 * time.Millisecond)
+// MoneyCalculate implements the MoneyCalculate RPC method
+func (s *Service) MoneyCalculate(ctx context.Context, req *pb.MoneyRequest) (*pb.MoneyResponse, error) {
+	engine, ok := s.engine.(calc.MoneyEngine)
+	if !ok {
+		return nil, unsupported("money")
+	}
+	amounts := make([]calc.Money, len(req.Amounts))
+	for i, amount := range req.Amounts {
+		money, err := FromMoney(amount)
+		if err != nil {
+			return nil, calcstatus.ToStatus(&calc.FieldError{Field: fmt.Sprintf("amounts[%d]", i), Err: err})
+		}
+		amounts[i] = money
+	}
+	numbers := make([]calc.Decimal, len(req.Numbers))
+	for i, number := range req.Numbers {
+		d, err := calc.ParseDecimal(number)
+		if err != nil {
+			return nil, calcstatus.ToStatus(&calc.FieldError{Field: fmt.Sprintf("numbers[%d]", i), Err: err})
+		}
+		numbers[i] = d
+	}
+	return ToMoneyResponse(engine.Money(ctx, req.Operation, amounts, numbers, FromRoundingMode(req.Rounding)))
+}
 
-	// Check for potential overflow
-	absA, absB := math.Abs(req.A), math.Abs(req.B)
-	if absA > 1 && absB > math.MaxFloat64/absA {
-		return &pb.CalculationResponse{
-			Result:       0,
-			StatusCode:   1,
-			ErrorMessage: "overflow detected in multiplication operation",
-			Operation:    string(OpMultiply),
-		}, nil
+// ConvertCurrency implements the ConvertCurrency RPC method
+func (s *Service) ConvertCurrency(ctx context.Context, req *pb.ConvertCurrencyRequest) (*pb.ConvertCurrencyResponse, error) {
+	engine, ok := s.engine.(calc.MoneyEngine)
+	if !ok {
+		return nil, unsupported("money")
+	}
+	amount, err := FromMoney(req.Amount)
+	if err != nil {
+		return nil, calcstatus.ToStatus(&calc.FieldError{Field: "amount", Err: err})
 	}
+	return ToConvertCurrencyResponse(engine.ConvertCurrency(ctx, amount, req.To, FromRoundingMode(req.Rounding)))
+}
+
+// roundingModes maps the rounding modes of requests to the engine's
+var roundingModes = map[pb.RoundingMode]calc.RoundingMode{
+	pb.RoundingMode_ROUNDING_MODE_HALF_EVEN: calc.HalfEven,
+	pb.RoundingMode_ROUNDING_MODE_HALF_UP:   calc.HalfUp,
+	pb.RoundingMode_ROUNDING_MODE_DOWN:      calc.Down,
+	pb.RoundingMode_ROUNDING_MODE_UP:        calc.Up,
+	pb.RoundingMode_ROUNDING_MODE_FLOOR:     calc.Floor,
+	pb.RoundingMode_ROUNDING_MODE_CEILING:   calc.Ceiling,
+}
+
+// FromRoundingMode returns the engine's rounding mode for mode, which is
+// HalfEven if unspecified
+func FromRoundingMode(mode pb.RoundingMode) calc.RoundingMode {
+	return roundingModes[mode]
+}
 
-	result := req.A * req.B
+// FromMoney parses an amount of money from a request
+func FromMoney(m *pb.Money) (calc.Money, error) {
+	if m == nil {
+		return calc.Money{}, fmt.Errorf("%w: amount is required", calc.ErrInvalidInput)
+	}
+	return calc.ParseMoney(m.Currency, m.Amount)
+}
+
+// ToMoney converts an amount of money into its message
+func ToMoney(m calc.Money) *pb.Money {
+	return &pb.Money{Currency: m.Currency.Code, Amount: m.Amount.String()}
+}
+
+// WithAngleUnit applies the angle unit of a request to ctx
+func WithAngleUnit(ctx context.Context, unit pb.AngleUnit) context.Context {
+	if unit == pb.AngleUnit_ANGLE_UNIT_DEGREES {
+		return calc.WithAngleUnit(ctx, calc.Degrees)
+	}
+	return calc.WithAngleUnit(ctx, calc.Radians)
+}
+
+// ToResponse converts a calculation result into a gRPC response, or into a
+// typed status error if the calculation failed
+func ToResponse(result calc.CalculationResult) (*pb.CalculationResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
+	}
 
 	return &pb.CalculationResponse{
-		Result:       result,
-		StatusCode:   0,
-		ErrorMessage: "",
-		Operation:    string(OpMultiply),
+		Result:     result.Value,
+		Operation:  result.Operation,
+		DurationNs: result.Duration.Nanoseconds(),
+		Unit:       result.Unit,
 	}, nil
 }
 
-// Divide implements the Divide RPC method
-func (s *Service) Divide(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	// Check for context timeout or cancellation
-	if ctx.Err() != nil {
-		return nil, status.Errorf(codes.Canceled, "request cancelled or timed out")
+// ToComplexResponse converts a complex calculation result into a gRPC
+// response, or into a typed status error if the calculation failed
+func ToComplexResponse(result calc.ComplexResult) (*pb.ComplexResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
 	}
 
-	// Add a small delay to simulate processing time (for demonstration purposes)
-	time.Sleep(10 * time.Millisecond)
+	return &pb.ComplexResponse{
+		Result:     &pb.Complex{Real: real(result.Value), Imag: imag(result.Value)},
+		Operation:  result.Operation,
+		DurationNs: result.Duration.Nanoseconds(),
+	}, nil
+}
 
-	// Check for division by zero
-	if req.B == 0 {
-		return &pb.CalculationResponse{
-			Result:       0,
-			StatusCode:   2,
-			ErrorMessage: "division by zero",
-			Operation:    string(OpDivide),
-		}, nil
+// ToRationalResponse converts a rational calculation result into a gRPC
+// response, or into a typed status error if the calculation failed
+func ToRationalResponse(result calc.RationalResult) (*pb.RationalResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
 	}
 
-	result := req.A / req.B
+	return &pb.RationalResponse{
+		Result:      result.Value.RatString(),
+		Numerator:   result.Value.Num().String(),
+		Denominator: result.Value.Denom().String(),
+		Mixed:       calc.FormatMixed(result.Value),
+		Decimal:     result.Decimal,
+		Operation:   result.Operation,
+		DurationNs:  result.Duration.Nanoseconds(),
+	}, nil
+}
 
-	// Check for infinity or NaN
-	if math.IsInf(result, 0) || math.IsNaN(result) {
-		return &pb.CalculationResponse{
-			Result:       0,
-			StatusCode:   3,
-			ErrorMessage: fmt.Sprintf("invalid result: %v", result),
-			Operation:    string(OpDivide),
-		}, nil
+// ToIntegerResponse converts an integer calculation result into a gRPC
+// response, or into a typed status error if the calculation failed
+func ToIntegerResponse(result calc.IntegerResult) (*pb.IntegerResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
 	}
 
-	return &pb.CalculationResponse{
-		Result:       result,
-		StatusCode:   0,
-		ErrorMessage: "",
-		Operation:    string(OpDivide),
+	resp := &pb.IntegerResponse{
+		Results:    make([]string, len(result.Values)),
+		Operation:  result.Operation,
+		DurationNs: result.Duration.Nanoseconds(),
+	}
+	for i, value := range result.Values {
+		resp.Results[i] = value.String()
+	}
+	return resp, nil
+}
+
+// ToMoneyResponse converts a money calculation result into a gRPC
+// response, or into a typed status error if the calculation failed
+func ToMoneyResponse(result calc.MoneyResult) (*pb.MoneyResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
+	}
+
+	resp := &pb.MoneyResponse{
+		Results:    make([]*pb.Money, len(result.Values)),
+		Operation:  result.Operation,
+		DurationNs: result.Duration.Nanoseconds(),
+	}
+	for i, value := range result.Values {
+		resp.Results[i] = ToMoney(value)
+	}
+	return resp, nil
+}
+
+// ToConvertCurrencyResponse converts a currency conversion result into a
+// gRPC response, or into a typed status error if the conversion failed
+func ToConvertCurrencyResponse(result calc.CurrencyResult) (*pb.ConvertCurrencyResponse, error) {
+	if result.Error != nil {
+		return nil, calcstatus.ToStatus(result.Error)
+	}
+
+	return &pb.ConvertCurrencyResponse{
+		Result:        ToMoney(result.Value),
+		Rate:          result.RateDecimal,
+		EffectiveDate: result.Effective.Format(time.DateOnly),
+		DurationNs:    result.Duration.Nanoseconds(),
 	}, nil
 }
 
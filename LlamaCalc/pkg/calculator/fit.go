@@ -0,0 +1,45 @@
+package calculator
+
+import (
+	"context"
+	"time"
+
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/fit"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// fitModels maps the models of the API to package fit
+var fitModels = map[pb.FitModel]fit.Model{
+	pb.FitModel_FIT_MODEL_LINEAR:      fit.Linear,
+	pb.FitModel_FIT_MODEL_POLYNOMIAL:  fit.Polynomial,
+	pb.FitModel_FIT_MODEL_EXPONENTIAL: fit.Exponential,
+	pb.FitModel_FIT_MODEL_LOGARITHMIC: fit.Logarithmic,
+}
+
+// Fit implements the Fit RPC method
+func (s *Service) Fit(ctx context.Context, req *pb.FitRequest) (*pb.FitResponse, error) {
+	start := time.Now()
+
+	opts := fit.Options{
+		Model:  fitModels[req.Model],
+		Degree: int(req.Degree),
+	}
+	if len(req.Weights) > 0 {
+		opts.Weights = req.Weights
+	}
+
+	result, err := fit.Fit(req.X, req.Y, opts)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.FitResponse{
+		Coefficients:    result.Coefficients,
+		StandardErrors:  result.StandardErrors,
+		RSquared:        result.RSquared,
+		Residuals:       result.Residuals,
+		ConditionNumber: result.Condition,
+		DurationNs:      time.Since(start).Nanoseconds(),
+	}, nil
+}
@@ -1,36 +1,56 @@
 package calculator
 
 import (
+	"context"
 	"math"
+
+	"llamacalc/pkg/calc"
 )
 
-// Calculator provides simple calculation operations
-type Calculator struct{}
+// Calculator provides simple calculation operations without error reporting.
+// Failed calculations return NaN; use Calculate to get the error.
+type Calculator struct {
+	engine calc.Engine
+}
 
-// NewCalculator creates a new Calculator
+// NewCalculator creates a new Calculator backed by the default engine
 func NewCalculator() *Calculator {
-	return &Calculator{}
+	return &Calculator{
+		engine: calc.NewDefaultCalculator(),
+	}
+}
+
+// Calculate performs the named operation and returns its error, if any
+func (c *Calculator) Calculate(op string, a, b float64) (float64, error) {
+	result := c.engine.Calculate(context.Background(), op, a, b)
+	return result.Value, result.Error
 }
 
 // Add adds two numbers
 func (c *Calculator) Add(a, b float64) float64 {
-	return a + b
+	return c.value("Add", a, b)
 }
 
 // Subtract subtracts b from a
 func (c *Calculator) Subtract(a, b float64) float64 {
-	return a - b
+	return c.value("Subtract", a, b)
 }
 
 // Multiply multiplies two numbers
 func (c *Calculator) Multiply(a, b float64) float64 {
-	return a * b
+	return c.value("Multiply", a, b)
 }
 
 // Divide divides a by b
 func (c *Calculator) Divide(a, b float64) float64 {
-	if b == 0 {
+	return c.value("Divide", a, b)
+}
+
+// value performs an operation and maps errors to NaN
+func (c *Calculator) value(op string, a, b float64) float64 {
+	result, err := c.Calculate(op, a, b)
+	if err != nil {
 		return math.NaN()
 	}
-	return a / b
+	return result
 }
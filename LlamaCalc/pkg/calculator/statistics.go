@@ -0,0 +1,87 @@
+package calculator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"llamacalc/pkg/calcstatus"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/stats"
+)
+
+// Statistics implements the Statistics RPC method
+func (s *Service) Statistics(ctx context.Context, req *pb.StatisticsRequest) (*pb.StatisticsResponse, error) {
+	start := time.Now()
+
+	summary, err := stats.Describe(req.Data, req.Percentiles)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return ToStatisticsResponse(summary, req.Percentiles, time.Since(start)), nil
+}
+
+// StatisticsStream implements the StatisticsStream RPC method. Chunks are
+// added to an accumulator as they arrive, so memory use does not grow with
+// the dataset.
+func (s *Service) StatisticsStream(stream pb.Calculator_StatisticsStreamServer) error {
+	start := time.Now()
+
+	acc := stats.NewAccumulator()
+	var percentiles []float64
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if percentiles == nil && len(chunk.Percentiles) > 0 {
+			if err := stats.ValidatePercentiles(chunk.Percentiles); err != nil {
+				return calcstatus.ToStatus(err)
+			}
+			percentiles = chunk.Percentiles
+		}
+		if err := acc.Add(chunk.Data...); err != nil {
+			return calcstatus.ToStatus(err)
+		}
+	}
+
+	summary, err := acc.Summary(percentiles)
+	if err != nil {
+		return calcstatus.ToStatus(err)
+	}
+
+	return stream.SendAndClose(ToStatisticsResponse(summary, percentiles, time.Since(start)))
+}
+
+// ToStatisticsResponse converts a summary into a gRPC response
+func ToStatisticsResponse(summary *stats.Summary, percentiles []float64, duration time.Duration) *pb.StatisticsResponse {
+	resp := &pb.StatisticsResponse{
+		Count:       summary.Count,
+		Sum:         summary.Sum,
+		Mean:        summary.Mean,
+		Variance:    summary.Variance,
+		Stddev:      summary.StdDev,
+		Min:         summary.Min,
+		Max:         summary.Max,
+		Median:      summary.Median,
+		Mode:        summary.Mode,
+		ModeCount:   summary.ModeCount,
+		Skewness:    summary.Skewness,
+		Kurtosis:    summary.Kurtosis,
+		Approximate: summary.Approximate,
+		DurationNs:  duration.Nanoseconds(),
+	}
+	for i, value := range summary.Percentiles {
+		resp.Percentiles = append(resp.Percentiles, &pb.Percentile{
+			Percentile: percentiles[i],
+			Value:      value,
+		})
+	}
+	return resp
+}
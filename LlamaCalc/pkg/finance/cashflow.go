@@ -0,0 +1,165 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"llamacalc/pkg/calc"
+)
+
+// daysPerYear is the year length of XNPV and XIRR
+const daysPerYear = 365
+
+// checkCashFlows validates the values of a series of cash flows and, if
+// dates is not nil, their dates. It returns the years from the first date
+// to each date.
+func checkCashFlows(values []calc.Decimal, dates []time.Time) ([]float64, error) {
+	if len(values) == 0 || len(values) > MaxCashFlows {
+		return nil, &calc.FieldError{Field: "values", Err: fmt.Errorf("%w: there must be from 1 to %d cash flows", calc.ErrInvalidInput, MaxCashFlows)}
+	}
+	if dates == nil {
+		return nil, nil
+	}
+	if len(dates) != len(values) {
+		return nil, &calc.FieldError{Field: "dates", Err: fmt.Errorf("%w: got %d dates for %d values", calc.ErrInvalidInput, len(dates), len(values))}
+	}
+
+	years := make([]float64, len(dates))
+	for i, date := range dates {
+		if date.Before(dates[0]) {
+			return nil, &calc.FieldError{Field: fmt.Sprintf("dates[%d]", i), Err: fmt.Errorf("%w: dates must not precede the first date", calc.ErrInvalidInput)}
+		}
+		years[i] = date.Sub(dates[0]).Hours() / 24 / daysPerYear
+	}
+	return years, nil
+}
+
+// NPV returns the net present value of cash flows at the end of each
+// period, the first one period from now, as spreadsheets do
+//
+//	sum of values[i] / (1+rate)^(i+1)
+func NPV(rate calc.Decimal, values []calc.Decimal, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	if err := checkRate("rate", rate); err != nil {
+		return calc.Decimal{}, err
+	}
+	if _, err := checkCashFlows(values, nil); err != nil {
+		return calc.Decimal{}, err
+	}
+
+	growth := new(big.Rat).Add(big.NewRat(1, 1), rate.Rat())
+	if err := checkPower("values", growth, len(values)); err != nil {
+		return calc.Decimal{}, err
+	}
+
+	// Horner's scheme from the last cash flow
+	npv := new(big.Rat)
+	for i := len(values) - 1; i >= 0; i-- {
+		npv.Add(npv, values[i].Rat())
+		npv.Quo(npv, growth)
+	}
+	return rd.round(npv), nil
+}
+
+// XNPV returns the net present value of cash flows on the given dates,
+// discounted to the first date with years of 365 days
+//
+//	sum of values[i] / (1+rate)^((dates[i]-dates[0])/365)
+func XNPV(rate calc.Decimal, values []calc.Decimal, dates []time.Time, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	if err := checkRate("rate", rate); err != nil {
+		return calc.Decimal{}, err
+	}
+	years, err := checkCashFlows(values, dates)
+	if err != nil {
+		return calc.Decimal{}, err
+	}
+
+	npv, _ := presentValue(floats(values), years, rate.Float64())
+	return rd.roundFloat(npv)
+}
+
+// IRR returns the internal rate of return of cash flows at the end of each
+// period, the rate at which their NPV is zero, solved with Newton's method
+// from guess. The cash flows must include a positive and a negative value.
+func IRR(values []calc.Decimal, guess float64, rd Rounding) (Solution, error) {
+	if err := rd.check(); err != nil {
+		return Solution{}, err
+	}
+	if _, err := checkCashFlows(values, nil); err != nil {
+		return Solution{}, err
+	}
+	if err := checkSigns(values); err != nil {
+		return Solution{}, err
+	}
+
+	periods := make([]float64, len(values))
+	for i := range periods {
+		periods[i] = float64(i)
+	}
+	flows := floats(values)
+	return solve(func(r float64) (float64, float64) {
+		return presentValue(flows, periods, r)
+	}, guess, rd)
+}
+
+// XIRR returns the internal rate of return of cash flows on the given
+// dates, the rate at which their XNPV is zero, solved with Newton's method
+// from guess. The cash flows must include a positive and a negative value.
+func XIRR(values []calc.Decimal, dates []time.Time, guess float64, rd Rounding) (Solution, error) {
+	if err := rd.check(); err != nil {
+		return Solution{}, err
+	}
+	years, err := checkCashFlows(values, dates)
+	if err != nil {
+		return Solution{}, err
+	}
+	if err := checkSigns(values); err != nil {
+		return Solution{}, err
+	}
+
+	flows := floats(values)
+	return solve(func(r float64) (float64, float64) {
+		return presentValue(flows, years, r)
+	}, guess, rd)
+}
+
+// checkSigns checks that cash flows have a rate of return
+func checkSigns(values []calc.Decimal) error {
+	var positive, negative bool
+	for _, v := range values {
+		positive = positive || v.Sign() > 0
+		negative = negative || v.Sign() < 0
+	}
+	if !positive || !negative {
+		return &calc.FieldError{Field: "values", Err: fmt.Errorf("%w: cash flows need a positive and a negative value", calc.ErrDomain)}
+	}
+	return nil
+}
+
+// presentValue returns the sum of values[i] / (1+rate)^times[i] and its
+// derivative with respect to rate
+func presentValue(values, times []float64, rate float64) (float64, float64) {
+	var pv, dpv float64
+	for i, v := range values {
+		discount := math.Pow(1+rate, -times[i])
+		pv += v * discount
+		dpv -= times[i] * v * discount / (1 + rate)
+	}
+	return pv, dpv
+}
+
+// floats converts decimals to float64
+func floats(values []calc.Decimal) []float64 {
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = v.Float64()
+	}
+	return result
+}
@@ -0,0 +1,297 @@
+// Package finance implements spreadsheet-compatible financial functions on
+// decimal numbers.
+//
+// PV, FV, PMT, NPV, compound interest and amortization schedules are computed
+// exactly with rational arithmetic and rounded once at the end. NPER, XNPV
+// and the rate solvers RATE, IRR and XIRR need logarithms or fractional
+// powers and are computed in float64, like spreadsheets do, before rounding.
+//
+// Signs follow the spreadsheet convention: money received is positive and
+// money paid out is negative, so a loan of 1000 has a negative payment.
+package finance
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"llamacalc/pkg/calc"
+)
+
+// Limits of the inputs
+const (
+	// MaxPeriods limits the number of periods and of rows of a schedule
+	MaxPeriods = 10000
+	// MaxCashFlows limits the number of cash flows of NPV and IRR
+	MaxCashFlows = 10000
+	// MaxPlaces limits the decimal places of results
+	MaxPlaces = 30
+	// maxPowerBits limits the size of exact powers such as (1 + rate)^nper
+	maxPowerBits = 1 << 20
+)
+
+// Default decimal places of results
+const (
+	// DefaultPlaces is the default for amounts of money
+	DefaultPlaces = 2
+	// DefaultRatePlaces is the default for rates and numbers of periods
+	DefaultRatePlaces = 10
+)
+
+// Rounding tells how results are rounded
+type Rounding struct {
+	// Places is the number of decimal places, from 0 to MaxPlaces
+	Places int
+	Mode   calc.RoundingMode
+}
+
+// round rounds x
+func (r Rounding) round(x *big.Rat) calc.Decimal {
+	return calc.DecimalFromRat(x, r.Places, r.Mode)
+}
+
+// roundFloat rounds a float64 result, which must be finite
+func (r Rounding) roundFloat(x float64) (calc.Decimal, error) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return calc.Decimal{}, calc.ErrOverflow
+	}
+	return r.round(new(big.Rat).SetFloat64(x)), nil
+}
+
+// check validates the number of decimal places
+func (r Rounding) check() error {
+	if r.Places < 0 || r.Places > MaxPlaces {
+		return &calc.FieldError{Field: "places", Err: fmt.Errorf("%w: places must be between 0 and %d", calc.ErrInvalidInput, MaxPlaces)}
+	}
+	return nil
+}
+
+// Solution is the result of an iterative solver
+type Solution struct {
+	Value calc.Decimal
+	// Iterations is the number of Newton steps taken
+	Iterations int
+	// Residual is the value of the solved function at the unrounded result,
+	// e.g. the net present value at the internal rate of return
+	Residual float64
+}
+
+// TimeValue are the terms of the time value of money equation
+//
+//	pv*(1+rate)^nper + pmt*(1+rate*type)*((1+rate)^nper - 1)/rate + fv = 0
+//
+// where type is 1 for payments Due at the beginning of each period and 0
+// for payments at the end. Each of PV, FV, PMT, NPER and RATE solves it for
+// its term and ignores the value given for it.
+type TimeValue struct {
+	// Rate is the interest rate per period
+	Rate calc.Decimal
+	// Periods is the number of payment periods
+	Periods int
+	// Payment is the payment made each period
+	Payment calc.Decimal
+	// Present is the present value
+	Present calc.Decimal
+	// Future is the future value after the last payment
+	Future calc.Decimal
+	// Due tells whether payments are made at the beginning of each period
+	Due bool
+}
+
+// typ returns the type term of the equation
+func (tv TimeValue) typ() *big.Rat {
+	if tv.Due {
+		return big.NewRat(1, 1)
+	}
+	return new(big.Rat)
+}
+
+// checkRate validates a rate per period, which must exceed -1
+func checkRate(field string, rate calc.Decimal) error {
+	if rate.Rat().Cmp(big.NewRat(-1, 1)) <= 0 {
+		return &calc.FieldError{Field: field, Err: fmt.Errorf("%w: rate must be greater than -1", calc.ErrDomain)}
+	}
+	return nil
+}
+
+// checkPeriods validates a number of periods
+func checkPeriods(n int) error {
+	if n < 1 || n > MaxPeriods {
+		return &calc.FieldError{Field: "periods", Err: fmt.Errorf("%w: periods must be a whole number from 1 to %d", calc.ErrInvalidInput, MaxPeriods)}
+	}
+	return nil
+}
+
+// checkPower refuses powers x^n that would be too large to compute exactly
+func checkPower(field string, x *big.Rat, n int) error {
+	if (x.Num().BitLen()+x.Denom().BitLen())*n > maxPowerBits {
+		return &calc.FieldError{Field: field, Err: fmt.Errorf("%w: too many periods for the precision of the rate", calc.ErrInvalidInput)}
+	}
+	return nil
+}
+
+// pow returns x^n for n >= 0, see checkPower
+func pow(field string, x *big.Rat, n int) (*big.Rat, error) {
+	if err := checkPower(field, x, n); err != nil {
+		return nil, err
+	}
+	exp := big.NewInt(int64(n))
+	num := new(big.Int).Exp(x.Num(), exp, nil)
+	den := new(big.Int).Exp(x.Denom(), exp, nil)
+	return new(big.Rat).SetFrac(num, den), nil
+}
+
+// terms returns (1+rate)^nper and the annuity factor
+// (1+rate*type)*((1+rate)^nper - 1)/rate, which is nper for a zero rate
+func (tv TimeValue) terms() (*big.Rat, *big.Rat, error) {
+	if err := checkRate("rate", tv.Rate); err != nil {
+		return nil, nil, err
+	}
+	if err := checkPeriods(tv.Periods); err != nil {
+		return nil, nil, err
+	}
+
+	rate := tv.Rate.Rat()
+	growth, err := pow("periods", new(big.Rat).Add(big.NewRat(1, 1), rate), tv.Periods)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rate.Sign() == 0 {
+		return growth, new(big.Rat).SetInt64(int64(tv.Periods)), nil
+	}
+
+	annuity := new(big.Rat).Sub(growth, big.NewRat(1, 1))
+	annuity.Quo(annuity, rate)
+	annuity.Mul(annuity, new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Mul(rate, tv.typ())))
+	return growth, annuity, nil
+}
+
+// FV returns the future value
+//
+//	-(pv*(1+rate)^nper + pmt*annuity)
+func FV(tv TimeValue, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	growth, annuity, err := tv.terms()
+	if err != nil {
+		return calc.Decimal{}, err
+	}
+
+	fv := new(big.Rat).Mul(tv.Present.Rat(), growth)
+	fv.Add(fv, new(big.Rat).Mul(tv.Payment.Rat(), annuity))
+	return rd.round(fv.Neg(fv)), nil
+}
+
+// PV returns the present value
+//
+//	-(fv + pmt*annuity) / (1+rate)^nper
+func PV(tv TimeValue, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	growth, annuity, err := tv.terms()
+	if err != nil {
+		return calc.Decimal{}, err
+	}
+
+	pv := new(big.Rat).Mul(tv.Payment.Rat(), annuity)
+	pv.Add(pv, tv.Future.Rat())
+	pv.Quo(pv, growth)
+	return rd.round(pv.Neg(pv)), nil
+}
+
+// PMT returns the payment per period
+//
+//	-(pv*(1+rate)^nper + fv) / annuity
+func PMT(tv TimeValue, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	pmt, err := payment(tv)
+	if err != nil {
+		return calc.Decimal{}, err
+	}
+	return rd.round(pmt), nil
+}
+
+// payment returns the exact payment per period
+func payment(tv TimeValue) (*big.Rat, error) {
+	growth, annuity, err := tv.terms()
+	if err != nil {
+		return nil, err
+	}
+
+	pmt := new(big.Rat).Mul(tv.Present.Rat(), growth)
+	pmt.Add(pmt, tv.Future.Rat())
+	pmt.Quo(pmt, annuity)
+	return pmt.Neg(pmt), nil
+}
+
+// NPER returns the number of periods
+//
+//	ln((pmt*(1+rate*type) - fv*rate) / (pmt*(1+rate*type) + pv*rate)) / ln(1+rate)
+//
+// It fails with calc.ErrDomain if the payments never reach the future value.
+func NPER(tv TimeValue, rd Rounding) (calc.Decimal, error) {
+	if err := rd.check(); err != nil {
+		return calc.Decimal{}, err
+	}
+	if err := checkRate("rate", tv.Rate); err != nil {
+		return calc.Decimal{}, err
+	}
+
+	rate, pmt := tv.Rate.Rat(), tv.Payment.Rat()
+	pv, fv := tv.Present.Rat(), tv.Future.Rat()
+	if rate.Sign() == 0 {
+		if pmt.Sign() == 0 {
+			return calc.Decimal{}, &calc.FieldError{Field: "payment", Err: fmt.Errorf("%w: payment must not be zero without interest", calc.ErrDomain)}
+		}
+		n := new(big.Rat).Add(pv, fv)
+		n.Quo(n, pmt)
+		return rd.round(n.Neg(n)), nil
+	}
+
+	due := new(big.Rat).Mul(pmt, new(big.Rat).Add(big.NewRat(1, 1), new(big.Rat).Mul(rate, tv.typ())))
+	num := new(big.Rat).Sub(due, new(big.Rat).Mul(fv, rate))
+	den := new(big.Rat).Add(due, new(big.Rat).Mul(pv, rate))
+	if den.Sign() == 0 || num.Sign()*den.Sign() <= 0 {
+		return calc.Decimal{}, fmt.Errorf("%w: the payments never reach the future value", calc.ErrDomain)
+	}
+
+	ratio, _ := new(big.Rat).Quo(num, den).Float64()
+	r, _ := rate.Float64()
+	return rd.roundFloat(math.Log(ratio) / math.Log1p(r))
+}
+
+// RATE returns the interest rate per period, solved with Newton's method
+// from guess, e.g. DefaultGuess
+func RATE(tv TimeValue, guess float64, rd Rounding) (Solution, error) {
+	if err := rd.check(); err != nil {
+		return Solution{}, err
+	}
+	if err := checkPeriods(tv.Periods); err != nil {
+		return Solution{}, err
+	}
+
+	n := float64(tv.Periods)
+	pmt, pv, fv := tv.Payment.Float64(), tv.Present.Float64(), tv.Future.Float64()
+	t := 0.0
+	if tv.Due {
+		t = 1
+	}
+
+	// f(r) is the left-hand side of the time value equation
+	f := func(r float64) (float64, float64) {
+		if math.Abs(r) < 1e-10 {
+			// Limits for r -> 0
+			return pv + pmt*n + fv, pv*n + pmt*(n*(n-1)/2+t*n)
+		}
+		growth := math.Pow(1+r, n)
+		dgrowth := n * math.Pow(1+r, n-1)
+		annuity := (1 + r*t) * (growth - 1) / r
+		dannuity := t*(growth-1)/r + (1+r*t)*(dgrowth*r-(growth-1))/(r*r)
+		return pv*growth + pmt*annuity + fv, pv*dgrowth + pmt*dannuity
+	}
+	return solve(f, guess, rd)
+}
@@ -0,0 +1,245 @@
+package finance_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/finance"
+)
+
+// spreadsheetCase is a result documented for a spreadsheet function
+type spreadsheetCase struct {
+	Name string
+	Fn   func() (calc.Decimal, error)
+	Want string
+	Err  error
+}
+
+var (
+	cents = finance.Rounding{Places: 2}
+	rates = finance.Rounding{Places: 10}
+)
+
+var flows = decimals("-10000", "2750", "4250", "3250", "2750")
+
+var flowDates = dates("2008-01-01", "2008-03-01", "2008-10-30", "2009-02-15", "2009-04-01")
+
+var spreadsheetCases = []spreadsheetCase{
+	{
+		Name: "FV(0.5%, 10, -200, -500, 1)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.FV(finance.TimeValue{Rate: decimal("0.005"), Periods: 10, Payment: decimal("-200"), Present: decimal("-500"), Due: true}, cents)
+		},
+		Want: "2581.40",
+	},
+	{
+		Name: "PV(0.08/12, 240, 500)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.PV(finance.TimeValue{Rate: decimal("0.0066666667"), Periods: 240, Payment: decimal("500")}, cents)
+		},
+		Want: "-59777.15",
+	},
+	{
+		Name: "PMT(0.05/12, 360, 100000)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.PMT(finance.TimeValue{Rate: decimal("0.0041666666667"), Periods: 360, Present: decimal("100000")}, cents)
+		},
+		Want: "-536.82",
+	},
+	{
+		Name: "PMT without interest",
+		Fn: func() (calc.Decimal, error) {
+			return finance.PMT(finance.TimeValue{Periods: 8, Present: decimal("1")}, finance.Rounding{Places: 2, Mode: calc.HalfUp})
+		},
+		Want: "-0.13",
+	},
+	{
+		Name: "NPER(1%, -100, -1000, 10000, 1)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.NPER(finance.TimeValue{Rate: decimal("0.01"), Payment: decimal("-100"), Present: decimal("-1000"), Future: decimal("10000"), Due: true}, rates)
+		},
+		Want: "59.6738656743",
+	},
+	{
+		Name: "NPER never repaid",
+		Fn: func() (calc.Decimal, error) {
+			return finance.NPER(finance.TimeValue{Rate: decimal("0.1"), Payment: decimal("-50"), Present: decimal("1000")}, rates)
+		},
+		Err: calc.ErrDomain,
+	},
+	{
+		Name: "RATE(48, -200, 8000)",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.RATE(finance.TimeValue{Periods: 48, Payment: decimal("-200"), Present: decimal("8000")}, finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Want: "0.0077014725",
+	},
+	{
+		Name: "NPV(10%, -10000, 3000, 4200, 6800)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.NPV(decimal("0.1"), decimals("-10000", "3000", "4200", "6800"), cents)
+		},
+		Want: "1188.44",
+	},
+	{
+		Name: "XNPV(9%, ...)",
+		Fn: func() (calc.Decimal, error) {
+			return finance.XNPV(decimal("0.09"), flows, flowDates, cents)
+		},
+		Want: "2086.65",
+	},
+	{
+		Name: "IRR(-70000, 12000, 15000, 18000, 21000)",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.IRR(decimals("-70000", "12000", "15000", "18000", "21000"), finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Want: "-0.0212448483",
+	},
+	{
+		Name: "IRR(-70000, 12000, 15000, 18000, 21000, 26000)",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.IRR(decimals("-70000", "12000", "15000", "18000", "21000", "26000"), finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Want: "0.0866309480",
+	},
+	{
+		Name: "IRR without a sign change",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.IRR(decimals("1", "2"), finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Err: calc.ErrDomain,
+	},
+	{
+		Name: "IRR without a root",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.IRR(decimals("-1", "1", "-1", "1", "-1"), finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Err: calc.ErrNotConverged,
+	},
+	{
+		Name: "XIRR(...)",
+		Fn: func() (calc.Decimal, error) {
+			s, err := finance.XIRR(flows, flowDates, finance.DefaultGuess, rates)
+			return s.Value, err
+		},
+		Want: "0.3733625335",
+	},
+	{
+		Name: "compound interest monthly",
+		Fn: func() (calc.Decimal, error) {
+			c, err := finance.CompoundInterest(decimal("1000"), decimal("0.05"), 12, decimal("10"), cents)
+			return c.Amount, err
+		},
+		Want: "1647.01",
+	},
+	{
+		Name: "compound interest daily for 30 years",
+		Fn: func() (calc.Decimal, error) {
+			c, err := finance.CompoundInterest(decimal("1000"), decimal("0.05"), 365, decimal("30"), cents)
+			return c.Interest, err
+		},
+		Want: "3481.23",
+	},
+	{
+		Name: "compound interest partial period",
+		Fn: func() (calc.Decimal, error) {
+			c, err := finance.CompoundInterest(decimal("1000"), decimal("0.05"), 1, decimal("0.5"), cents)
+			return c.Amount, err
+		},
+		Err: calc.ErrInvalidInput,
+	},
+}
+
+func TestSpreadsheetFunctions(t *testing.T) {
+	for _, c := range spreadsheetCases {
+		got, err := c.Fn()
+		if c.Err != nil {
+			if !errors.Is(err, c.Err) {
+				t.Errorf("%s: got error %v, want %v", c.Name, err, c.Err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", c.Name, err)
+		} else if got.String() != c.Want {
+			t.Errorf("%s = %s, want %s", c.Name, got, c.Want)
+		}
+	}
+}
+
+func TestConvergenceError(t *testing.T) {
+	_, err := finance.IRR(decimals("-1", "1", "-1", "1", "-1"), finance.DefaultGuess, rates)
+	var convergence *calc.ConvergenceError
+	if !errors.As(err, &convergence) {
+		t.Fatalf("got error %v, want a *calc.ConvergenceError", err)
+	}
+	if convergence.Iterations != finance.MaxIterations {
+		t.Errorf("iterations = %d, want %d", convergence.Iterations, finance.MaxIterations)
+	}
+}
+
+func TestAmortize(t *testing.T) {
+	schedule, err := finance.Amortize(decimal("0.01"), 12, decimal("1000"), cents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := schedule.Payment.String(); got != "88.85" {
+		t.Errorf("payment = %s, want 88.85", got)
+	}
+	if len(schedule.Installments) != 12 {
+		t.Fatalf("got %d installments, want 12", len(schedule.Installments))
+	}
+
+	// The principal is repaid exactly, with the last payment absorbing the
+	// rounding of the others
+	last := schedule.Installments[11]
+	if last.Payment.String() != "88.84" || last.Balance.Sign() != 0 {
+		t.Errorf("last installment = %+v, want a payment of 88.84 and no balance", last)
+	}
+	var repaid calc.Decimal
+	for _, installment := range schedule.Installments {
+		repaid = repaid.Add(installment.Principal)
+	}
+	if repaid.String() != "1000.00" {
+		t.Errorf("repaid %s, want 1000.00", repaid)
+	}
+	if got := schedule.TotalInterest.String(); got != "66.19" {
+		t.Errorf("total interest = %s, want 66.19", got)
+	}
+}
+
+func decimal(s string) calc.Decimal {
+	d, err := calc.ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func decimals(values ...string) []calc.Decimal {
+	result := make([]calc.Decimal, len(values))
+	for i, v := range values {
+		result[i] = decimal(v)
+	}
+	return result
+}
+
+func dates(values ...string) []time.Time {
+	result := make([]time.Time, len(values))
+	for i, v := range values {
+		t, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = t
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"llamacalc/pkg/calc"
+)
+
+// Compound is the result of compound interest
+type Compound struct {
+	// Amount is the principal with interest
+	Amount calc.Decimal
+	// Interest is the interest earned
+	Interest calc.Decimal
+}
+
+// CompoundInterest returns the amount a principal grows to at a nominal
+// annual rate compounded periodsPerYear times a year
+//
+//	principal * (1 + rate/periodsPerYear)^(periodsPerYear*years)
+//
+// The number of compounding periods must be whole. It is not limited by
+// MaxPeriods, so daily compounding over decades works, but by the size of
+// the exact power.
+func CompoundInterest(principal, rate calc.Decimal, periodsPerYear int, years calc.Decimal, rd Rounding) (Compound, error) {
+	if err := rd.check(); err != nil {
+		return Compound{}, err
+	}
+	if periodsPerYear < 1 || periodsPerYear > MaxPeriods {
+		return Compound{}, &calc.FieldError{Field: "periods_per_year", Err: fmt.Errorf("%w: periods per year must be from 1 to %d", calc.ErrInvalidInput, MaxPeriods)}
+	}
+
+	periods := new(big.Rat).Mul(years.Rat(), big.NewRat(int64(periodsPerYear), 1))
+	if !periods.IsInt() || periods.Sign() < 0 || periods.Cmp(big.NewRat(math.MaxInt32, 1)) > 0 {
+		return Compound{}, &calc.FieldError{Field: "years", Err: fmt.Errorf("%w: years must give a whole, non-negative number of periods", calc.ErrInvalidInput)}
+	}
+
+	periodic := new(big.Rat).Quo(rate.Rat(), big.NewRat(int64(periodsPerYear), 1))
+	if periodic.Cmp(big.NewRat(-1, 1)) <= 0 {
+		return Compound{}, &calc.FieldError{Field: "rate", Err: fmt.Errorf("%w: rate per period must be greater than -1", calc.ErrDomain)}
+	}
+	growth, err := pow("years", periodic.Add(periodic, big.NewRat(1, 1)), int(periods.Num().Int64()))
+	if err != nil {
+		return Compound{}, err
+	}
+
+	amount := new(big.Rat).Mul(principal.Rat(), growth)
+	interest := new(big.Rat).Sub(amount, principal.Rat())
+	return Compound{Amount: rd.round(amount), Interest: rd.round(interest)}, nil
+}
+
+// Installment is one row of an amortization schedule
+type Installment struct {
+	// Period counts from 1
+	Period int
+	// Payment is Interest plus Principal
+	Payment calc.Decimal
+	// Interest is the interest on the balance of the previous period
+	Interest calc.Decimal
+	// Principal is the part of the payment that repays the loan
+	Principal calc.Decimal
+	// Balance is the loan left after the payment
+	Balance calc.Decimal
+}
+
+// Schedule is the amortization schedule of a loan
+type Schedule struct {
+	// Payment is the regular payment, PMT rounded
+	Payment calc.Decimal
+	// Installments lists one row per period
+	Installments []Installment
+	// TotalPayments and TotalInterest are the sums over all installments
+	TotalPayments calc.Decimal
+	TotalInterest calc.Decimal
+}
+
+// Amortize returns the schedule of a loan of principal repaid with equal
+// payments at the end of each period. Payments and the interest of each
+// period are rounded; the last payment repays the remaining balance
+// exactly, so it may differ from the others. The amounts of a positive
+// principal are positive.
+func Amortize(rate calc.Decimal, periods int, principal calc.Decimal, rd Rounding) (*Schedule, error) {
+	if err := rd.check(); err != nil {
+		return nil, err
+	}
+	pmt, err := payment(TimeValue{Rate: rate, Periods: periods, Present: principal})
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &Schedule{
+		Payment:      rd.round(pmt.Neg(pmt)),
+		Installments: make([]Installment, 0, periods),
+	}
+	balance := principal.Round(rd.Places, rd.Mode)
+	for period := 1; period <= periods; period++ {
+		interest := balance.Mul(rate).Round(rd.Places, rd.Mode)
+		pay := schedule.Payment
+		if due := balance.Add(interest); period == periods || due.Cmp(pay)*principal.Sign() <= 0 {
+			// Repay the rest, possibly early when rounding overpays
+			pay = due
+		}
+
+		repaid := pay.Sub(interest)
+		balance = balance.Sub(repaid)
+		schedule.Installments = append(schedule.Installments, Installment{
+			Period:    period,
+			Payment:   pay,
+			Interest:  interest,
+			Principal: repaid,
+			Balance:   balance,
+		})
+		schedule.TotalPayments = schedule.TotalPayments.Add(pay)
+		schedule.TotalInterest = schedule.TotalInterest.Add(interest)
+
+		if balance.Sign() == 0 {
+			break
+		}
+	}
+	return schedule, nil
+}
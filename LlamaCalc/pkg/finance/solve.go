@@ -0,0 +1,52 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// Solver settings
+const (
+	// DefaultGuess is the usual starting rate of RATE, IRR and XIRR
+	DefaultGuess = 0.1
+	// MaxIterations limits the Newton steps of the rate solvers
+	MaxIterations = 100
+	// tolerance is the relative step size at which a solver has converged
+	tolerance = 1e-12
+)
+
+// solve finds a root greater than -1 of the function f, which returns its
+// value and derivative, with Newton's method starting from guess. Steps
+// that would reach -1 or below are shortened to half the distance to -1.
+func solve(f func(r float64) (float64, float64), guess float64, rd Rounding) (Solution, error) {
+	if math.IsNaN(guess) || math.IsInf(guess, 0) || guess <= -1 {
+		return Solution{}, &calc.FieldError{Field: "guess", Err: fmt.Errorf("%w: guess must be greater than -1", calc.ErrDomain)}
+	}
+
+	r := guess
+	for i := 1; i <= MaxIterations; i++ {
+		y, dy := f(r)
+		if math.IsNaN(y) || math.IsInf(y, 0) || dy == 0 || math.IsNaN(dy) || math.IsInf(dy, 0) {
+			return Solution{}, &calc.ConvergenceError{Iterations: i - 1, Estimate: r, Residual: y}
+		}
+
+		next := r - y/dy
+		if next <= -1 {
+			next = (r - 1) / 2
+		}
+		if math.Abs(next-r) <= tolerance*math.Max(1, math.Abs(r)) {
+			residual, _ := f(next)
+			value, err := rd.roundFloat(next)
+			if err != nil {
+				return Solution{}, err
+			}
+			return Solution{Value: value, Iterations: i, Residual: residual}, nil
+		}
+		r = next
+	}
+
+	residual, _ := f(r)
+	return Solution{}, &calc.ConvergenceError{Iterations: MaxIterations, Estimate: r, Residual: residual}
+}
@@ -0,0 +1,296 @@
+// Package fit fits models to data by linear least squares.
+//
+// Every model is linear in its coefficients, possibly after transforming the
+// data, and is solved with the QR-based least squares of package linalg.
+// Results only depend on the input, so they can be compared against golden
+// files. Errors use the sentinels of package calc.
+package fit
+
+import (
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/linalg"
+)
+
+// Model is a family of curves
+type Model int
+
+// Models
+const (
+	// Linear fits y = c0 + c1*x
+	Linear Model = iota + 1
+	// Polynomial fits y = c0 + c1*x + ... + cd*x^d of a chosen degree d
+	Polynomial
+	// Exponential fits y = c0 * exp(c1*x) by a linear fit of ln(y); y must
+	// be positive
+	Exponential
+	// Logarithmic fits y = c0 + c1*ln(x); x must be positive
+	Logarithmic
+)
+
+// String implements fmt.Stringer
+func (m Model) String() string {
+	switch m {
+	case Linear:
+		return "linear"
+	case Polynomial:
+		return "polynomial"
+	case Exponential:
+		return "exponential"
+	case Logarithmic:
+		return "logarithmic"
+	}
+	return fmt.Sprintf("Model(%d)", int(m))
+}
+
+// MaxDegree is the highest degree of a polynomial fit
+const MaxDegree = 20
+
+// Options selects the model to fit
+type Options struct {
+	Model Model
+	// Degree is the degree of a Polynomial fit
+	Degree int
+	// Weights optionally weight each point in the sum of squares; they must
+	// not be negative. Points with zero weight do not influence the fit.
+	Weights []float64
+}
+
+// Result is a fitted model
+type Result struct {
+	Model Model
+	// Coefficients in the order documented for the model
+	Coefficients []float64
+	// StandardErrors of the coefficients; they are 0 if there are no more
+	// points than coefficients
+	StandardErrors []float64
+	// RSquared is the (weighted) coefficient of determination in the
+	// original scale of y
+	RSquared float64
+	// Residuals are y minus the fitted value at each point
+	Residuals []float64
+	// Condition is the condition number of the least-squares problem
+	Condition float64
+}
+
+// Predict returns the fitted value at x
+func (r *Result) Predict(x float64) float64 {
+	c := r.Coefficients
+	switch r.Model {
+	case Exponential:
+		return c[0] * math.Exp(c[1]*x)
+	case Logarithmic:
+		return c[0] + c[1]*math.Log(x)
+	}
+
+	// Horner's scheme for Linear and Polynomial
+	var y float64
+	for i := len(c) - 1; i >= 0; i-- {
+		y = y*x + c[i]
+	}
+	return y
+}
+
+// Fit fits the model selected by opts to the points (x[i], y[i])
+func Fit(x, y []float64, opts Options) (*Result, error) {
+	degree, err := validate(x, y, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transform the data so that the model is a polynomial of degree in t
+	// with response z
+	m, n := len(x), degree+1
+	t, z := x, y
+	switch opts.Model {
+	case Exponential:
+		z, err = logValues("y", y, opts.Model)
+	case Logarithmic:
+		t, err = logValues("x", x, opts.Model)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Weighted least squares scales each row by the square root of its weight
+	design := linalg.Zeros(m, n)
+	response := linalg.Zeros(m, 1)
+	for i := 0; i < m; i++ {
+		w := 1.0
+		if opts.Weights != nil {
+			w = math.Sqrt(opts.Weights[i])
+		}
+		p := w
+		for j := 0; j < n; j++ {
+			design.Set(i, j, p)
+			p *= t[i]
+		}
+		response.Data[i] = w * z[i]
+	}
+
+	solution, err := linalg.LeastSquares(design, response)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Model:          opts.Model,
+		Coefficients:   solution.X.Data,
+		StandardErrors: make([]float64, n),
+		Residuals:      make([]float64, m),
+		Condition:      solution.Condition,
+	}
+
+	// Standard errors follow from the residuals of the linear problem
+	var ssr float64
+	for i := 0; i < m; i++ {
+		var fitted float64
+		for j := 0; j < n; j++ {
+			fitted += design.At(i, j) * result.Coefficients[j]
+		}
+		r := response.Data[i] - fitted
+		ssr += r * r
+	}
+	if dof := weightedPoints(opts.Weights, m) - n; dof > 0 {
+		variance := ssr / float64(dof)
+		for j := 0; j < n; j++ {
+			result.StandardErrors[j] = math.Sqrt(variance * solution.Covariance.At(j, j))
+		}
+	}
+
+	if opts.Model == Exponential {
+		// c0 was fitted as ln(c0); propagate its error to first order
+		result.Coefficients[0] = math.Exp(result.Coefficients[0])
+		result.StandardErrors[0] *= result.Coefficients[0]
+	}
+
+	result.RSquared = rSquared(result, x, y, opts.Weights)
+
+	for _, v := range append(append([]float64{result.RSquared}, result.Coefficients...), result.StandardErrors...) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, calc.ErrOverflow
+		}
+	}
+
+	return result, nil
+}
+
+// validate checks the data and options and returns the polynomial degree of
+// the transformed model
+func validate(x, y []float64, opts Options) (int, error) {
+	degree := 1
+	switch opts.Model {
+	case Linear, Exponential, Logarithmic:
+	case Polynomial:
+		degree = opts.Degree
+		if degree < 1 || degree > MaxDegree {
+			return 0, &calc.FieldError{
+				Field: "degree",
+				Err:   fmt.Errorf("%w: degree must be between 1 and %d", calc.ErrInvalidInput, MaxDegree),
+			}
+		}
+	default:
+		return 0, &calc.FieldError{Field: "model", Err: fmt.Errorf("%w: unknown model %v", calc.ErrInvalidInput, opts.Model)}
+	}
+
+	if len(y) != len(x) {
+		return 0, &calc.FieldError{
+			Field: "y",
+			Err:   fmt.Errorf("%w: got %d values for %d x values", calc.ErrInvalidInput, len(y), len(x)),
+		}
+	}
+	if opts.Weights != nil && len(opts.Weights) != len(x) {
+		return 0, &calc.FieldError{
+			Field: "weights",
+			Err:   fmt.Errorf("%w: got %d weights for %d points", calc.ErrInvalidInput, len(opts.Weights), len(x)),
+		}
+	}
+
+	for _, values := range []struct {
+		field  string
+		values []float64
+	}{{"x", x}, {"y", y}, {"weights", opts.Weights}} {
+		for i, v := range values.values {
+			if math.IsNaN(v) || math.IsInf(v, 0) || (values.field == "weights" && v < 0) {
+				return 0, &calc.FieldError{Field: fmt.Sprintf("%s[%d]", values.field, i), Err: calc.ErrInvalidInput}
+			}
+		}
+	}
+
+	if points := weightedPoints(opts.Weights, len(x)); points < degree+1 {
+		return 0, &calc.FieldError{
+			Field: "x",
+			Err:   fmt.Errorf("%w: the %v model needs at least %d weighted points, got %d", calc.ErrInvalidInput, opts.Model, degree+1, points),
+		}
+	}
+
+	return degree, nil
+}
+
+// weightedPoints returns the number of points with a positive weight
+func weightedPoints(weights []float64, points int) int {
+	if weights == nil {
+		return points
+	}
+
+	n := 0
+	for _, w := range weights {
+		if w > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// logValues returns the natural logarithms of the values named field, which
+// must be positive for model
+func logValues(field string, values []float64, model Model) ([]float64, error) {
+	logs := make([]float64, len(values))
+	for i, v := range values {
+		if v <= 0 {
+			return nil, &calc.FieldError{
+				Field: fmt.Sprintf("%s[%d]", field, i),
+				Err:   fmt.Errorf("%w: values must be positive for the %v model", calc.ErrDomain, model),
+			}
+		}
+		logs[i] = math.Log(v)
+	}
+	return logs, nil
+}
+
+// rSquared sets the residuals of result and returns its weighted
+// coefficient of determination in the original scale of y
+func rSquared(result *Result, x, y, weights []float64) float64 {
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	var sumW, sumWY float64
+	for i := range y {
+		sumW += weight(i)
+		sumWY += weight(i) * y[i]
+	}
+	mean := sumWY / sumW
+
+	var ssRes, ssTot float64
+	for i := range y {
+		r := y[i] - result.Predict(x[i])
+		result.Residuals[i] = r
+		ssRes += weight(i) * r * r
+		ssTot += weight(i) * (y[i] - mean) * (y[i] - mean)
+	}
+
+	if ssTot == 0 {
+		// A constant response is fitted perfectly or not at all
+		if ssRes == 0 {
+			return 1
+		}
+		return 0
+	}
+	return 1 - ssRes/ssTot
+}
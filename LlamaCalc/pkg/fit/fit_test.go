@@ -0,0 +1,123 @@
+package fit_test
+
+import (
+	"encoding/json"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"llamacalc/pkg/fit"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenCase is a fit whose result is recorded in testdata
+type goldenCase struct {
+	Name string
+	X, Y []float64
+	Opts fit.Options
+}
+
+var goldenCases = []goldenCase{
+	{
+		Name: "linear exact",
+		X:    []float64{0, 1, 2, 3},
+		Y:    []float64{1, 3, 5, 7},
+		Opts: fit.Options{Model: fit.Linear},
+	},
+	{
+		Name: "linear noisy",
+		X:    []float64{1, 2, 3, 4, 5, 6},
+		Y:    []float64{2.1, 3.9, 6.2, 7.8, 10.1, 12.2},
+		Opts: fit.Options{Model: fit.Linear},
+	},
+	{
+		Name: "quadratic",
+		X:    []float64{-2, -1, 0, 1, 2, 3},
+		Y:    []float64{9.2, 3.1, 0.9, 2.8, 9.1, 19.3},
+		Opts: fit.Options{Model: fit.Polynomial, Degree: 2},
+	},
+	{
+		Name: "exponential",
+		X:    []float64{0, 1, 2, 3, 4},
+		Y:    []float64{2.0, 5.5, 14.6, 40.5, 109.0},
+		Opts: fit.Options{Model: fit.Exponential},
+	},
+	{
+		Name: "logarithmic",
+		X:    []float64{1, 2, 4, 8, 16},
+		Y:    []float64{0.1, 2.0, 4.1, 5.9, 8.1},
+		Opts: fit.Options{Model: fit.Logarithmic},
+	},
+	{
+		Name: "weighted ignores outlier",
+		X:    []float64{0, 1, 2, 3, 4},
+		Y:    []float64{1, 2, 30, 4, 5},
+		Opts: fit.Options{Model: fit.Linear, Weights: []float64{1, 1, 0, 1, 1}},
+	},
+}
+
+// tolerance is the relative difference allowed against the golden values,
+// for platforms that fuse multiply-add operations
+const tolerance = 1e-9
+
+func TestGolden(t *testing.T) {
+	results := make(map[string]*fit.Result, len(goldenCases))
+	for _, c := range goldenCases {
+		result, err := fit.Fit(c.X, c.Y, c.Opts)
+		if err != nil {
+			t.Fatalf("%s: %v", c.Name, err)
+		}
+		results[c.Name] = result
+	}
+
+	path := filepath.Join("testdata", "golden.json")
+	if *update {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var golden map[string]*fit.Result
+	if err := json.Unmarshal(data, &golden); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range goldenCases {
+		got, want := results[c.Name], golden[c.Name]
+		if want == nil {
+			t.Errorf("%s: missing from %s; run with -update", c.Name, path)
+			continue
+		}
+		compare(t, c.Name+" coefficients", got.Coefficients, want.Coefficients)
+		compare(t, c.Name+" standard errors", got.StandardErrors, want.StandardErrors)
+		compare(t, c.Name+" residuals", got.Residuals, want.Residuals)
+		compare(t, c.Name+" R²", []float64{got.RSquared}, []float64{want.RSquared})
+	}
+}
+
+// compare reports values of got that differ from want by more than tolerance
+func compare(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Errorf("%s: got %d values, want %d", name, len(got), len(want))
+		return
+	}
+	for i := range got {
+		scale := math.Max(1, math.Abs(want[i]))
+		if math.Abs(got[i]-want[i]) > tolerance*scale {
+			t.Errorf("%s[%d] = %v, want %v", name, i, got[i], want[i])
+		}
+	}
+}
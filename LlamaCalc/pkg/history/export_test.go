@@ -0,0 +1,4 @@
+package history
+
+// Remove replaces the removal of files by FileStore.Compact
+var Remove = &remove
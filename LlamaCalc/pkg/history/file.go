@@ -0,0 +1,542 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultSegmentSize is the size at which a FileStore starts a new segment
+const DefaultSegmentSize = 64 << 20
+
+// FileOptions configure a FileStore
+type FileOptions struct {
+	// SegmentSize is the size in bytes at which segments are rotated; 0
+	// means DefaultSegmentSize
+	SegmentSize int64
+	// Sync flushes every record to disk before Append returns
+	Sync bool
+}
+
+// compactionsFile lists the compactions whose old segments may remain in a
+// FileStore directory
+const compactionsFile = "compactions.json"
+
+// FileStore appends records to JSONL segment files in a directory. The last
+// segment is written; when it reaches the segment size, a new one is
+// started. Segments are named after the Seq of their first record, so they
+// sort in the order of their records. Compact drops old records from all but
+// the last segment, and lists itself in compactions.json until it is done.
+type FileStore struct {
+	dir  string
+	opts FileOptions
+
+	mu sync.RWMutex
+	// segments are the first Seq of the segment files, in order; the last
+	// one is active
+	segments []uint64
+	active   *os.File
+	size     int64
+	seq      uint64
+	closed   bool
+	// compactions drop records that may remain in segments
+	compactions []compaction
+}
+
+// compaction drops the records up to Through that are older than Before
+type compaction struct {
+	Before  time.Time `json:"before"`
+	Through uint64    `json:"through"`
+}
+
+// remove removes a file; tests replace it to interrupt a compaction
+var remove = os.Remove
+
+// dropped reports whether one of compactions dropped r
+func dropped(compactions []compaction, r *Record) bool {
+	for _, c := range compactions {
+		if r.Seq <= c.Through && r.Time.Before(c.Before) {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenFileStore opens the store in dir, creating the directory if needed.
+// A record that was not completely written when the process stopped is
+// discarded.
+func OpenFileStore(dir string, opts FileOptions) (*FileStore, error) {
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	f := &FileStore{dir: dir, opts: opts}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history directory: %w", err)
+	}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "compact-") {
+			// Left by an interrupted compaction
+			os.Remove(filepath.Join(dir, entry.Name()))
+		}
+	}
+	if f.segments, err = listSegments(dir); err != nil {
+		return nil, err
+	}
+	if f.compactions, err = readCompactions(dir); err != nil {
+		return nil, err
+	}
+
+	if len(f.segments) == 0 {
+		if err := f.rotate(1); err != nil {
+			return nil, err
+		}
+		return f, nil
+	}
+
+	// Find the last Seq and the end of the last complete record
+	first := f.segments[len(f.segments)-1]
+	f.seq = first - 1
+	path := f.path(first)
+	size, err := scan(path, func(r *Record) bool {
+		f.seq = max(f.seq, r.Seq)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Truncate(path, size); err != nil {
+		return nil, fmt.Errorf("failed to recover history segment: %w", err)
+	}
+	f.active, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history segment: %w", err)
+	}
+	f.size = size
+	return f, nil
+}
+
+// Append implements Store. The record's Seq is set, and its Time if it is
+// zero.
+func (f *FileStore) Append(ctx context.Context, record *Record) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return ErrClosed
+	}
+
+	record.Seq = f.seq + 1
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode history record: %w", err)
+	}
+	line = append(line, '\n')
+
+	if f.size > 0 && f.size+int64(len(line)) > f.opts.SegmentSize {
+		if err := f.rotate(record.Seq); err != nil {
+			return err
+		}
+	}
+	n, err := f.active.Write(line)
+	f.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write history record: %w", err)
+	}
+	if f.opts.Sync {
+		if err := f.active.Sync(); err != nil {
+			return fmt.Errorf("failed to write history record: %w", err)
+		}
+	}
+	f.seq = record.Seq
+	return nil
+}
+
+// rotate closes the active segment and starts one with the record first
+func (f *FileStore) rotate(first uint64) error {
+	if f.active != nil {
+		if err := f.active.Close(); err != nil {
+			return fmt.Errorf("failed to close history segment: %w", err)
+		}
+	}
+
+	active, err := os.OpenFile(f.path(first), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create history segment: %w", err)
+	}
+	f.active = active
+	f.size = 0
+	f.segments = append(f.segments, first)
+	return nil
+}
+
+// Query implements Store
+func (f *FileStore) Query(ctx context.Context, q Query) (*Page, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.closed {
+		return nil, ErrClosed
+	}
+
+	// Start at the last segment that can hold the record after q.After
+	start := sort.Search(len(f.segments), func(i int) bool { return f.segments[i] > q.After+1 }) - 1
+	start = max(start, 0)
+
+	page := &Page{}
+	limit := q.limit()
+	// last skips the records of a page already returned, and the copies
+	// of records left by an interrupted compaction; its compaction drops
+	// the records it did not copy
+	last := q.After
+	for _, first := range f.segments[start:] {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		done := false
+		_, err := scan(f.path(first), func(r *Record) bool {
+			if r.Seq <= last {
+				return true
+			}
+			last = r.Seq
+			if dropped(f.compactions, r) || !q.Matches(r) {
+				return true
+			}
+			if len(page.Records) == limit {
+				page.Next = page.Records[limit-1].Seq
+				done = true
+				return false
+			}
+			page.Records = append(page.Records, r)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			break
+		}
+	}
+	return page, nil
+}
+
+// Compact drops the records before a time from all but the active segment
+// and merges the remaining records into as few segments as the segment size
+// allows. Queries see the same records, less the dropped ones, at any time,
+// even if compaction is interrupted: the compaction is listed in
+// compactions.json before any segment is replaced, and removed from it when
+// the old segments are gone.
+func (f *FileStore) Compact(ctx context.Context, before time.Time) (err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return ErrClosed
+	}
+
+	active := f.segments[len(f.segments)-1]
+	old := f.segments[:len(f.segments)-1]
+	// Records dropped by an interrupted compaction are dropped again
+	compactions := append(f.compactions[:len(f.compactions):len(f.compactions)], compaction{Before: before, Through: active - 1})
+	var (
+		compacted []uint64
+		tmp       *os.File
+		tmpSize   int64
+		tmps      []*os.File
+		last      uint64
+	)
+	defer func() {
+		for _, t := range tmps {
+			t.Close()
+			os.Remove(t.Name())
+		}
+	}()
+
+	for _, first := range old {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		_, scanErr := scan(f.path(first), func(r *Record) bool {
+			if r.Seq <= last {
+				return true
+			}
+			last = r.Seq
+			if dropped(compactions, r) {
+				return true
+			}
+
+			var line []byte
+			if line, err = json.Marshal(r); err != nil {
+				return false
+			}
+			line = append(line, '\n')
+			if tmp == nil || tmpSize > 0 && tmpSize+int64(len(line)) > f.opts.SegmentSize {
+				if tmp, err = os.CreateTemp(f.dir, "compact-*.tmp"); err != nil {
+					return false
+				}
+				tmps = append(tmps, tmp)
+				compacted = append(compacted, r.Seq)
+				tmpSize = 0
+			}
+			var n int
+			n, err = tmp.Write(line)
+			tmpSize += int64(n)
+			return err == nil
+		})
+		if scanErr != nil {
+			return scanErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to compact history: %w", err)
+		}
+	}
+
+	for _, t := range tmps {
+		if err := t.Sync(); err != nil {
+			return fmt.Errorf("failed to compact history: %w", err)
+		}
+		if err := t.Close(); err != nil {
+			return fmt.Errorf("failed to compact history: %w", err)
+		}
+	}
+
+	if err := writeCompactions(f.dir, compactions); err != nil {
+		return err
+	}
+	f.compactions = compactions
+	defer func() {
+		// Segments may have been replaced and added
+		if err != nil {
+			if segments, listErr := listSegments(f.dir); listErr == nil {
+				f.segments = segments
+			}
+		}
+	}()
+
+	// Replace the segments from the last one down, so that every record
+	// remains in some segment if this is interrupted
+	for i := len(tmps) - 1; i >= 0; i-- {
+		if err := os.Rename(tmps[i].Name(), f.path(compacted[i])); err != nil {
+			return fmt.Errorf("failed to compact history: %w", err)
+		}
+		tmps = tmps[:i]
+	}
+	kept := map[uint64]bool{}
+	for _, first := range compacted {
+		kept[first] = true
+	}
+	for _, first := range old {
+		if !kept[first] {
+			if err := remove(f.path(first)); err != nil {
+				return fmt.Errorf("failed to compact history: %w", err)
+			}
+		}
+	}
+	f.segments = append(compacted, active)
+
+	if err := remove(filepath.Join(f.dir, compactionsFile)); err != nil {
+		return fmt.Errorf("failed to compact history: %w", err)
+	}
+	f.compactions = nil
+	return nil
+}
+
+// readCompactions reads the compactions listed in dir
+func readCompactions(dir string) ([]compaction, error) {
+	data, err := os.ReadFile(filepath.Join(dir, compactionsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history compactions: %w", err)
+	}
+	var compactions []compaction
+	if err := json.Unmarshal(data, &compactions); err != nil {
+		return nil, fmt.Errorf("corrupt history compactions: %w", err)
+	}
+	return compactions, nil
+}
+
+// writeCompactions lists compactions in dir, replacing the file at once
+func writeCompactions(dir string, compactions []compaction) error {
+	data, err := json.Marshal(compactions)
+	if err != nil {
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, "compact-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, compactionsFile)); err != nil {
+		return fmt.Errorf("failed to write history compactions: %w", err)
+	}
+	return nil
+}
+
+// Close implements Store
+func (f *FileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.active.Close()
+}
+
+// path returns the file of the segment starting with the record first
+func (f *FileStore) path(first uint64) string {
+	return segmentPath(f.dir, first)
+}
+
+// segmentPath returns the file of a segment in dir
+func segmentPath(dir string, first uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("segment-%020d.jsonl", first))
+}
+
+// listSegments returns the first Seq of the segments in dir, in order
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history directory: %w", err)
+	}
+	var segments []uint64
+	for _, entry := range entries {
+		if first, ok := segmentSeq(entry.Name()); ok {
+			segments = append(segments, first)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+// segmentSeq returns the first Seq of a segment file name
+func segmentSeq(name string) (uint64, bool) {
+	digits, ok := strings.CutPrefix(name, "segment-")
+	if !ok {
+		return 0, false
+	}
+	digits, ok = strings.CutSuffix(digits, ".jsonl")
+	if !ok {
+		return 0, false
+	}
+	first, err := strconv.ParseUint(digits, 10, 64)
+	return first, err == nil && first > 0
+}
+
+// scan calls fn with the records of a segment file until it returns false.
+// It returns the size of the complete records, ignoring an incomplete last
+// line.
+func scan(path string, fn func(*Record) bool) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read history segment: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var size int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return size, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read history segment: %w", err)
+		}
+
+		var r Record
+		if err := json.Unmarshal(bytes.TrimSpace(line), &r); err != nil {
+			return 0, fmt.Errorf("corrupt record in history segment %s at offset %d: %w", filepath.Base(path), size, err)
+		}
+		size += int64(len(line))
+		if !fn(&r) {
+			return size, nil
+		}
+	}
+}
+
+// ReadFile calls fn with the records of a JSONL file, such as a segment of
+// a FileStore, in order. An incomplete last line, e.g. of a file being
+// written, is ignored.
+func ReadFile(path string, fn func(*Record) error) error {
+	var fnErr error
+	_, err := scan(path, func(r *Record) bool {
+		fnErr = fn(r)
+		return fnErr == nil
+	})
+	if err != nil {
+		return err
+	}
+	return fnErr
+}
+
+// ReadDir calls fn with the records of the FileStore in dir, in order,
+// without opening it; the store may be written meanwhile
+func ReadDir(dir string, fn func(*Record) error) error {
+	segments, err := listSegments(dir)
+	if err != nil {
+		return err
+	}
+	compactions, err := readCompactions(dir)
+	if err != nil {
+		return err
+	}
+
+	var last uint64
+	for _, first := range segments {
+		err := ReadFile(segmentPath(dir, first), func(r *Record) error {
+			// Skip the copies of records left by an interrupted compaction,
+			// and the records it dropped
+			if r.Seq <= last {
+				return nil
+			}
+			last = r.Seq
+			if dropped(compactions, r) {
+				return nil
+			}
+			return fn(r)
+		})
+		if errors.Is(err, os.ErrNotExist) {
+			// Removed by a compaction after its records were merged
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
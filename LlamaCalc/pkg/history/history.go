@@ -0,0 +1,125 @@
+// Package history records the calculations performed by a server for audit
+// and reproducibility.
+//
+// A Record holds the request and response of a call in the JSON mapping of
+// Protocol Buffers together with the caller, the time and the engine
+// settings. Records are numbered in the order they are appended and are
+// queried oldest first, a page at a time.
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the number of records of a page if none is given
+	DefaultPageSize = 100
+	// MaxPageSize is the largest number of records of a page
+	MaxPageSize = 1000
+)
+
+// ErrClosed reports the use of a closed store
+var ErrClosed = errors.New("history store closed")
+
+// Record is a recorded calculation
+type Record struct {
+	// Seq numbers the records of a store in the order they were appended
+	Seq  uint64    `json:"seq"`
+	Time time.Time `json:"time"`
+
+	// Principal, Role and Tenant identify the caller; they are empty without
+	// authentication
+	Principal string `json:"principal,omitempty"`
+	Role      string `json:"role,omitempty"`
+	Tenant    string `json:"tenant,omitempty"`
+
+	// Method is the full gRPC method, e.g. "/llamacalc.v1.Calculator/Add"
+	Method string `json:"method"`
+	// Operation and Module are the metric labels of the call
+	Operation string `json:"operation"`
+	Module    string `json:"module"`
+
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+
+	// Code is the gRPC status code of the call, e.g. "OK"
+	Code string `json:"code"`
+	// ErrorKind is the reason of a calculation error, e.g. "DIVIDE_BY_ZERO"
+	ErrorKind string `json:"error_kind,omitempty"`
+	Error     string `json:"error,omitempty"`
+
+	// Engine describes the engine that performed the calculation
+	Engine   Engine        `json:"engine"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Engine is the version and the rounding settings of an engine
+type Engine struct {
+	Version          string `json:"version,omitempty"`
+	MaxPrecision     int    `json:"max_precision"`
+	MaxDecimalPlaces int    `json:"max_decimal_places"`
+	OverflowCheck    bool   `json:"overflow_check"`
+}
+
+// Query selects records. Empty fields select every record.
+type Query struct {
+	Principal string
+	// Operation is a metric operation label, e.g. "ADD"
+	Operation string
+	// Since and Until select the records in [Since, Until)
+	Since, Until time.Time
+	ErrorKind    string
+
+	// After continues a query after the record with this Seq, see Page
+	After uint64
+	// Limit is the size of the page; 0 means DefaultPageSize
+	Limit int
+}
+
+// Page is a page of the records selected by a query
+type Page struct {
+	Records []*Record
+	// Next is the Query.After of the next page; it is 0 on the last page
+	Next uint64
+}
+
+// Store keeps records
+type Store interface {
+	// Append numbers and stores a record
+	Append(ctx context.Context, record *Record) error
+	// Query returns the next page of records selected by q
+	Query(ctx context.Context, q Query) (*Page, error)
+	// Close releases the store
+	Close() error
+}
+
+// Matches reports whether q selects r, regardless of paging
+func (q Query) Matches(r *Record) bool {
+	switch {
+	case q.Principal != "" && r.Principal != q.Principal:
+		return false
+	case q.Operation != "" && r.Operation != q.Operation:
+		return false
+	case q.ErrorKind != "" && r.ErrorKind != q.ErrorKind:
+		return false
+	case !q.Since.IsZero() && r.Time.Before(q.Since):
+		return false
+	case !q.Until.IsZero() && !r.Time.Before(q.Until):
+		return false
+	}
+	return true
+}
+
+// limit returns the page size of q
+func (q Query) limit() int {
+	switch {
+	case q.Limit <= 0:
+		return DefaultPageSize
+	case q.Limit > MaxPageSize:
+		return MaxPageSize
+	}
+	return q.Limit
+}
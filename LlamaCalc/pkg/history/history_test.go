@@ -0,0 +1,258 @@
+package history_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"llamacalc/pkg/history"
+)
+
+// start is the time of the first record of the tests
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// appendRecords appends n records, one a minute, alternating between alice
+// and bob and between ADD and DIVIDE. Every third record failed.
+func appendRecords(t *testing.T, store history.Store, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		r := &history.Record{
+			Time:      start.Add(time.Duration(i) * time.Minute),
+			Principal: []string{"alice", "bob"}[i%2],
+			Method:    "/llamacalc.v1.Calculator/Invoke",
+			Operation: []string{"ADD", "DIVIDE"}[i%2],
+			Request:   json.RawMessage(`{"operands":[1,0]}`),
+			Code:      "OK",
+		}
+		if i%3 == 2 {
+			r.Code, r.ErrorKind = "INVALID_ARGUMENT", "DIVIDE_BY_ZERO"
+		}
+		if err := store.Append(context.Background(), r); err != nil {
+			t.Fatal(err)
+		}
+		if r.Seq != uint64(i+1) {
+			t.Fatalf("record %d: got Seq %d", i+1, r.Seq)
+		}
+	}
+}
+
+// queryAll returns the Seq of every record selected by q, following pages
+func queryAll(t *testing.T, store history.Store, q history.Query) []uint64 {
+	t.Helper()
+	var seqs []uint64
+	for {
+		page, err := store.Query(context.Background(), q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range page.Records {
+			seqs = append(seqs, r.Seq)
+		}
+		if page.Next == 0 {
+			return seqs
+		}
+		q.After = page.Next
+	}
+}
+
+// count returns the number of records of n appended by appendRecords that
+// match q
+func count(n int, q history.Query) int {
+	matched := 0
+	for i := 0; i < n; i++ {
+		r := &history.Record{
+			Time:      start.Add(time.Duration(i) * time.Minute),
+			Principal: []string{"alice", "bob"}[i%2],
+			Operation: []string{"ADD", "DIVIDE"}[i%2],
+		}
+		if i%3 == 2 {
+			r.ErrorKind = "DIVIDE_BY_ZERO"
+		}
+		if q.Matches(r) {
+			matched++
+		}
+	}
+	return matched
+}
+
+func TestQuery(t *testing.T) {
+	store, err := history.OpenFileStore(t.TempDir(), history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	appendRecords(t, store, 50)
+
+	tests := []struct {
+		Name  string
+		Query history.Query
+		Want  int
+	}{
+		{"all", history.Query{}, 50},
+		{"principal", history.Query{Principal: "alice"}, 25},
+		{"operation", history.Query{Operation: "DIVIDE"}, 25},
+		{"error kind", history.Query{ErrorKind: "DIVIDE_BY_ZERO"}, 16},
+		{"time range", history.Query{Since: start.Add(10 * time.Minute), Until: start.Add(20 * time.Minute)}, 10},
+		{"combined", history.Query{Principal: "bob", ErrorKind: "DIVIDE_BY_ZERO", Until: start.Add(30 * time.Minute)}, 5},
+		{"unknown", history.Query{Principal: "carol"}, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			if want := count(50, tc.Query); want != tc.Want {
+				t.Fatalf("Matches selects %d records, want %d", want, tc.Want)
+			}
+			for _, limit := range []int{0, 1, 7} {
+				tc.Query.Limit = limit
+				seqs := queryAll(t, store, tc.Query)
+				if len(seqs) != tc.Want {
+					t.Errorf("limit %d: got %d records, want %d", limit, len(seqs), tc.Want)
+				}
+				for i := 1; i < len(seqs); i++ {
+					if seqs[i] <= seqs[i-1] {
+						t.Errorf("limit %d: records out of order: %v", limit, seqs)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestReopen(t *testing.T) {
+	dir := t.TempDir()
+	store, err := history.OpenFileStore(dir, history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendRecords(t, store, 20)
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	segments, _ := filepath.Glob(filepath.Join(dir, "segment-*.jsonl"))
+	if len(segments) < 2 {
+		t.Fatalf("got %d segments, want rotation", len(segments))
+	}
+	// A record torn by a crash is discarded
+	last, err := os.OpenFile(segments[len(segments)-1], os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last.WriteString(`{"seq":21,"meth`)
+	last.Close()
+
+	store, err = history.OpenFileStore(dir, history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	r := &history.Record{Method: "/llamacalc.v1.Calculator/Add"}
+	if err := store.Append(context.Background(), r); err != nil || r.Seq != 21 {
+		t.Fatalf("got Seq %d, %v, want 21", r.Seq, err)
+	}
+	if seqs := queryAll(t, store, history.Query{}); len(seqs) != 21 || seqs[20] != 21 {
+		t.Errorf("got %v", seqs)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	dir := t.TempDir()
+	store, err := history.OpenFileStore(dir, history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	appendRecords(t, store, 50)
+	before, _ := filepath.Glob(filepath.Join(dir, "segment-*.jsonl"))
+
+	if err := store.Compact(context.Background(), start.Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := filepath.Glob(filepath.Join(dir, "segment-*.jsonl"))
+	if len(after) >= len(before) {
+		t.Errorf("got %d segments after compaction, had %d", len(after), len(before))
+	}
+
+	seqs := queryAll(t, store, history.Query{Limit: 3})
+	if len(seqs) != 20 || seqs[0] != 31 || seqs[19] != 50 {
+		t.Fatalf("got %v, want the records from 31 to 50", seqs)
+	}
+
+	// Compaction is idempotent and appending continues
+	if err := store.Compact(context.Background(), start.Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	r := &history.Record{Method: "/llamacalc.v1.Calculator/Add"}
+	if err := store.Append(context.Background(), r); err != nil || r.Seq != 51 {
+		t.Fatalf("got Seq %d, %v, want 51", r.Seq, err)
+	}
+	if again := queryAll(t, store, history.Query{}); len(again) != 21 {
+		t.Errorf("got %d records after compacting again, want 21", len(again))
+	}
+}
+
+func TestCompactInterrupted(t *testing.T) {
+	dir := t.TempDir()
+	store, err := history.OpenFileStore(dir, history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendRecords(t, store, 50)
+	first := filepath.Join(dir, "segment-00000000000000000001.jsonl")
+
+	// Interrupt the compaction after it replaced the segments, before it
+	// removed the old ones
+	interrupted := errors.New("interrupted")
+	*history.Remove = func(string) error { return interrupted }
+	err = store.Compact(context.Background(), start.Add(30*time.Minute))
+	*history.Remove = os.Remove
+	if !errors.Is(err, interrupted) {
+		t.Fatalf("got %v, want the compaction interrupted", err)
+	}
+	if _, err := os.Stat(first); err != nil {
+		t.Fatalf("the first segment is gone: %v", err)
+	}
+
+	// The dropped records stay dropped for the store, for readers of the
+	// directory and when the store is opened again
+	want := func(name string, seqs []uint64) {
+		t.Helper()
+		if len(seqs) != 20 || seqs[0] != 31 || seqs[19] != 50 {
+			t.Errorf("%s: got %v, want the records from 31 to 50", name, seqs)
+		}
+	}
+	want("store", queryAll(t, store, history.Query{Limit: 3}))
+	var read []uint64
+	err = history.ReadDir(dir, func(r *history.Record) error {
+		read = append(read, r.Seq)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want("ReadDir", read)
+
+	store.Close()
+	store, err = history.OpenFileStore(dir, history.FileOptions{SegmentSize: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	want("reopened", queryAll(t, store, history.Query{}))
+
+	// The next compaction finishes the job, even with an earlier time
+	if err := store.Compact(context.Background(), start.Add(10*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(first); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got %v for the first segment, want it removed", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "compactions.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("got %v for the compactions, want them removed", err)
+	}
+	want("compacted again", queryAll(t, store, history.Query{Limit: 7}))
+}
@@ -0,0 +1,477 @@
+// Package library keeps the user-defined functions and constants of tenants.
+//
+// Functions are expressions of their parameters, e.g. "margin(p, c) =
+// (p - c)/p", and constants are numbers. A function may use the registered
+// operations, the constants pi and e and the other definitions of its
+// tenant, but not variables or itself, directly or indirectly. Every change
+// increments the version of the library, and callers can make a change
+// conditional on the version they have seen. Each definition names the role
+// required to use it, which its author must have.
+package library
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+)
+
+var (
+	// ErrNotFound reports a definition that does not exist
+	ErrNotFound = errors.New("definition not found")
+	// ErrConflict reports a change based on an outdated library version
+	ErrConflict = errors.New("library version conflict")
+	// ErrLimit reports a library that is full
+	ErrLimit = errors.New("library limit exceeded")
+	// ErrForbidden reports a change to a definition whose role the caller
+	// does not have
+	ErrForbidden = errors.New("insufficient role for definition")
+)
+
+const (
+	// MaxDefinitions is the number of definitions of a tenant
+	MaxDefinitions = 100
+	// MaxParams is the number of parameters of a function
+	MaxParams = 8
+)
+
+// Definition is a user-defined function or constant
+type Definition struct {
+	Name string `json:"name"`
+	// Params are the parameters of a function
+	Params []string `json:"params,omitempty"`
+	// Body is the expression of a function; it is empty for constants
+	Body string `json:"body,omitempty"`
+	// Value is the value of a constant
+	Value float64 `json:"value,omitempty"`
+	// Role is required to use the definition; empty allows any caller
+	Role auth.Role `json:"role,omitempty"`
+	// Version is the library version that last changed the definition
+	Version int       `json:"version"`
+	Author  string    `json:"author,omitempty"`
+	Updated time.Time `json:"updated"`
+}
+
+// IsConstant reports whether d defines a constant
+func (d *Definition) IsConstant() bool {
+	return d.Body == ""
+}
+
+// String formats a definition like it is defined, e.g. "f(x) = x^2"
+func (d *Definition) String() string {
+	if d.IsConstant() {
+		return fmt.Sprintf("%s = %g", d.Name, d.Value)
+	}
+	return fmt.Sprintf("%s(%s) = %s", d.Name, strings.Join(d.Params, ", "), d.Body)
+}
+
+// Library is the set of definitions of a tenant
+type Library struct {
+	Tenant string `json:"tenant"`
+	// Version counts the changes of the library
+	Version     int                    `json:"version"`
+	Definitions map[string]*Definition `json:"definitions"`
+}
+
+// newLibrary returns an empty library
+func newLibrary(tenant string) *Library {
+	return &Library{Tenant: tenant, Definitions: map[string]*Definition{}}
+}
+
+// clone returns a copy of lib; definitions are not changed in place
+func (lib *Library) clone() *Library {
+	c := *lib
+	c.Definitions = make(map[string]*Definition, len(lib.Definitions))
+	for name, def := range lib.Definitions {
+		c.Definitions[name] = def
+	}
+	return &c
+}
+
+// Sorted returns the definitions by name
+func (lib *Library) Sorted() []*Definition {
+	defs := make([]*Definition, 0, len(lib.Definitions))
+	for _, def := range lib.Definitions {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+// ParseFunction parses a function definition like "margin(p, c) = (p - c)/p"
+// into a definition without role
+func ParseFunction(src string) (*Definition, error) {
+	head, body, ok := strings.Cut(src, "=")
+	if !ok {
+		return nil, &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: expected name(params) = expression", calc.ErrInvalidInput)}
+	}
+
+	node, err := expr.Parse(head)
+	call, ok := node.(*expr.Call)
+	if err != nil || !ok {
+		return nil, &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: expected name(params) before =", calc.ErrInvalidInput)}
+	}
+	def := &Definition{Name: call.Func, Params: []string{}, Body: strings.TrimSpace(body)}
+	for _, arg := range call.Args {
+		param, ok := arg.(*expr.Ident)
+		if !ok {
+			return nil, &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: parameter %s is not a name", calc.ErrInvalidInput, arg)}
+		}
+		def.Params = append(def.Params, param.Name)
+	}
+	if def.Body == "" {
+		return nil, &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: empty function body", calc.ErrInvalidInput)}
+	}
+	return def, nil
+}
+
+// Manager changes the libraries of a store and caches them for evaluation
+type Manager struct {
+	store    Store
+	registry *calc.Registry
+
+	mu     sync.Mutex
+	loaded map[string]*loaded
+}
+
+// loaded is a library with its definitions for evaluation
+type loaded struct {
+	lib  *Library
+	defs *calc.Definitions
+}
+
+// NewManager creates a manager of the libraries in store. Definitions may
+// not be named like the operations of registry.
+func NewManager(store Store, registry *calc.Registry) *Manager {
+	return &Manager{store: store, registry: registry, loaded: make(map[string]*loaded)}
+}
+
+// Load returns the library of a tenant and its definitions for evaluation
+// with calc.WithDefinitions. Both must not be changed.
+func (m *Manager) Load(ctx context.Context, tenant string) (*Library, *calc.Definitions, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, err := m.load(ctx, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l.lib, l.defs, nil
+}
+
+// load returns the cached library of a tenant
+func (m *Manager) load(ctx context.Context, tenant string) (*loaded, error) {
+	if l, ok := m.loaded[tenant]; ok {
+		return l, nil
+	}
+	lib, err := m.store.Load(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	l := &loaded{lib: lib, defs: definitions(lib)}
+	m.loaded[tenant] = l
+	return l, nil
+}
+
+// Define adds or replaces a definition of a tenant on behalf of a caller
+// with role. If baseVersion is not 0, the library must be at that version.
+func (m *Manager) Define(ctx context.Context, tenant string, role auth.Role, def *Definition, baseVersion int) (*Definition, error) {
+	name := strings.ToLower(def.Name)
+	if !allows(role, def.Role) {
+		return nil, fmt.Errorf("%w: defining %s for %s requires that role", ErrForbidden, name, def.Role)
+	}
+
+	var stored *Definition
+	err := m.change(ctx, tenant, role, name, baseVersion, func(lib *Library) error {
+		if _, ok := lib.Definitions[name]; !ok && len(lib.Definitions) >= MaxDefinitions {
+			return fmt.Errorf("%w: at most %d definitions", ErrLimit, MaxDefinitions)
+		}
+		stored = &Definition{
+			Name:    name,
+			Params:  append([]string(nil), def.Params...),
+			Body:    def.Body,
+			Value:   def.Value,
+			Role:    def.Role,
+			Version: lib.Version,
+			Author:  def.Author,
+			Updated: time.Now().UTC(),
+		}
+		if err := m.check(stored); err != nil {
+			return err
+		}
+		lib.Definitions[name] = stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// Delete removes a definition of a tenant on behalf of a caller with role.
+// Definitions used by other definitions cannot be deleted.
+func (m *Manager) Delete(ctx context.Context, tenant string, role auth.Role, name string, baseVersion int) error {
+	name = strings.ToLower(name)
+	return m.change(ctx, tenant, role, name, baseVersion, func(lib *Library) error {
+		if _, ok := lib.Definitions[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		delete(lib.Definitions, name)
+		return nil
+	})
+}
+
+// change applies fn to the next version of a library, validates and saves
+// it. The caller must have the role of the definition being replaced.
+func (m *Manager) change(ctx context.Context, tenant string, role auth.Role, name string, baseVersion int, fn func(*Library) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, err := m.load(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if baseVersion != 0 && baseVersion != l.lib.Version {
+		return fmt.Errorf("%w: library is at version %d, not %d", ErrConflict, l.lib.Version, baseVersion)
+	}
+	if existing, ok := l.lib.Definitions[name]; ok && !allows(role, existing.Role) {
+		return fmt.Errorf("%w: %s requires %s", ErrForbidden, name, existing.Role)
+	}
+
+	lib := l.lib.clone()
+	lib.Version++
+	if err := fn(lib); err != nil {
+		return err
+	}
+	if err := m.validate(lib); err != nil {
+		return err
+	}
+	if err := m.store.Save(ctx, lib); err != nil {
+		return err
+	}
+	m.loaded[tenant] = &loaded{lib: lib, defs: definitions(lib)}
+	return nil
+}
+
+// check validates a single definition: its name, role, parameters and body
+func (m *Manager) check(def *Definition) error {
+	if !isName(def.Name) {
+		return &calc.FieldError{Field: "name", Err: fmt.Errorf("%w: %q is not a name", calc.ErrInvalidInput, def.Name)}
+	}
+	if _, ok := calc.Constants[def.Name]; ok {
+		return &calc.FieldError{Field: "name", Err: fmt.Errorf("%w: %s is a built-in constant", calc.ErrInvalidInput, def.Name)}
+	}
+	if _, ok := m.registry.Lookup(def.Name); ok {
+		return &calc.FieldError{Field: "name", Err: fmt.Errorf("%w: %s is a built-in operation", calc.ErrInvalidInput, def.Name)}
+	}
+	switch def.Role {
+	case "", auth.RoleGuest, auth.RoleUser, auth.RoleAdmin:
+	default:
+		return &calc.FieldError{Field: "role", Err: fmt.Errorf("%w: unknown role %s", calc.ErrInvalidInput, def.Role)}
+	}
+
+	if def.IsConstant() {
+		if math.IsNaN(def.Value) || math.IsInf(def.Value, 0) {
+			return &calc.FieldError{Field: "value", Err: fmt.Errorf("%w: constants must be finite", calc.ErrInvalidInput)}
+		}
+		return nil
+	}
+
+	if len(def.Params) > MaxParams {
+		return &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: at most %d parameters", calc.ErrInvalidInput, MaxParams)}
+	}
+	seen := map[string]bool{}
+	for i, param := range def.Params {
+		param = strings.ToLower(param)
+		if _, ok := calc.Constants[param]; ok || !isName(param) || seen[param] {
+			return &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: invalid or repeated parameter %s", calc.ErrInvalidInput, param)}
+		}
+		seen[param] = true
+		def.Params[i] = param
+	}
+	if _, err := expr.Parse(def.Body); err != nil {
+		return &calc.FieldError{Field: "definition", Err: fmt.Errorf("%w: %v", calc.ErrInvalidInput, err)}
+	}
+	return nil
+}
+
+// allows reports whether role may use a definition requiring a role
+func allows(role, required auth.Role) bool {
+	return required == "" || role.Allows(required)
+}
+
+// isName reports whether s is an identifier of expressions
+func isName(s string) bool {
+	node, err := expr.Parse(s)
+	ident, ok := node.(*expr.Ident)
+	return err == nil && ok && ident.Name == s && !strings.HasPrefix(s, "$")
+}
+
+// validate checks that every function of lib only uses its parameters,
+// built-in constants, operations and definitions of lib, and that functions
+// do not call themselves and are nested at most calc.MaxCallDepth deep
+func (m *Manager) validate(lib *Library) error {
+	depths := map[string]int{}
+	for _, def := range lib.Sorted() {
+		if _, err := m.depth(lib, def, depths, map[string]bool{}); err != nil {
+			return &calc.FieldError{Field: "definition", Err: err}
+		}
+	}
+	return nil
+}
+
+// depth returns how deeply a definition nests functions, resolving its
+// references. visiting holds the functions being resolved, to find cycles.
+func (m *Manager) depth(lib *Library, def *Definition, depths map[string]int, visiting map[string]bool) (int, error) {
+	if def.IsConstant() {
+		return 0, nil
+	}
+	if d, ok := depths[def.Name]; ok {
+		return d, nil
+	}
+	if visiting[def.Name] {
+		return 0, fmt.Errorf("%w: %s calls itself", calc.ErrInvalidInput, def.Name)
+	}
+	visiting[def.Name] = true
+	defer delete(visiting, def.Name)
+
+	body, err := expr.Parse(def.Body)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", calc.ErrInvalidInput, def.Name, err)
+	}
+
+	deepest := 0
+	var walk func(node expr.Node) error
+	walk = func(node expr.Node) error {
+		switch n := node.(type) {
+		case *expr.Ident:
+			name := strings.ToLower(n.Name)
+			if _, ok := calc.Constants[name]; ok || contains(def.Params, name) {
+				return nil
+			}
+			if used, ok := lib.Definitions[name]; ok && used.IsConstant() {
+				return nil
+			}
+			return fmt.Errorf("%w: %s uses unknown name %s", calc.ErrInvalidInput, def.Name, n.Name)
+
+		case *expr.Unary:
+			return walk(n.X)
+
+		case *expr.Binary:
+			if err := walk(n.X); err != nil {
+				return err
+			}
+			return walk(n.Y)
+
+		case *expr.Call:
+			for _, arg := range n.Args {
+				if err := walk(arg); err != nil {
+					return err
+				}
+			}
+			used, ok := lib.Definitions[strings.ToLower(n.Func)]
+			if !ok || used.IsConstant() {
+				if _, ok := m.registry.Lookup(n.Func); ok {
+					return nil
+				}
+				return fmt.Errorf("%w: %s calls unknown function %s", calc.ErrInvalidInput, def.Name, n.Func)
+			}
+			if len(n.Args) != len(used.Params) {
+				return fmt.Errorf("%w: %s calls %s with %d arguments, want %d", calc.ErrInvalidInput, def.Name, used.Name, len(n.Args), len(used.Params))
+			}
+			d, err := m.depth(lib, used, depths, visiting)
+			if err != nil {
+				return err
+			}
+			deepest = max(deepest, d)
+		}
+		return nil
+	}
+	if err := walk(body); err != nil {
+		return 0, err
+	}
+
+	if deepest+1 > calc.MaxCallDepth {
+		return 0, fmt.Errorf("%w: %s nests functions deeper than %d levels", calc.ErrInvalidInput, def.Name, calc.MaxCallDepth)
+	}
+	depths[def.Name] = deepest + 1
+	return deepest + 1, nil
+}
+
+// contains reports whether names contains name
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// definitions converts a validated library for evaluation
+func definitions(lib *Library) *calc.Definitions {
+	defs := &calc.Definitions{Functions: map[string]*calc.UserFunction{}, Constants: map[string]float64{}}
+	for name, def := range lib.Definitions {
+		if def.IsConstant() {
+			defs.Constants[name] = def.Value
+			continue
+		}
+		body, err := expr.Parse(def.Body)
+		if err != nil {
+			continue
+		}
+		defs.Functions[name] = &calc.UserFunction{Name: name, Params: def.Params, Body: body}
+	}
+	return defs
+}
+
+// Uses returns the definitions used by expressions, directly or through
+// other definitions. Expressions that do not parse use nothing.
+func (lib *Library) Uses(expressions ...string) []*Definition {
+	var used []*Definition
+	seen := map[string]bool{}
+	var walk func(node expr.Node)
+	use := func(name string) {
+		name = strings.ToLower(name)
+		def, ok := lib.Definitions[name]
+		if !ok || seen[name] {
+			return
+		}
+		seen[name] = true
+		used = append(used, def)
+		if body, err := expr.Parse(def.Body); err == nil && !def.IsConstant() {
+			walk(body)
+		}
+	}
+	walk = func(node expr.Node) {
+		switch n := node.(type) {
+		case *expr.Ident:
+			use(n.Name)
+		case *expr.Unary:
+			walk(n.X)
+		case *expr.Binary:
+			walk(n.X)
+			walk(n.Y)
+		case *expr.Call:
+			use(n.Func)
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		}
+	}
+
+	for _, expression := range expressions {
+		if node, err := expr.Parse(expression); err == nil {
+			walk(node)
+		}
+	}
+	return used
+}
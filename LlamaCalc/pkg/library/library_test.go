@@ -0,0 +1,198 @@
+package library_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/library"
+)
+
+// define parses and stores a function for a user
+func define(m *library.Manager, tenant, src string) error {
+	def, err := library.ParseFunction(src)
+	if err != nil {
+		return err
+	}
+	_, err = m.Define(context.Background(), tenant, auth.RoleUser, def, 0)
+	return err
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	engine := calc.NewDefaultCalculator()
+	m := library.NewManager(library.NewMemoryStore(), engine.Registry)
+
+	if _, err := m.Define(ctx, "acme", auth.RoleUser, &library.Definition{Name: "VAT", Value: 0.2}, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, src := range []string{"margin(p, c) = (p - c)/p", "gross(net) = net * (1 + vat)", "Twice(x) = 2 * gross(x)"} {
+		if err := define(m, "acme", src); err != nil {
+			t.Fatalf("%s: %v", src, err)
+		}
+	}
+	_, defs, err := m.Load(ctx, "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Expression string
+		Want       float64
+	}{
+		{"margin(100, 60)", 0.4},
+		{"MARGIN(10, 5) + vat", 0.7},
+		{"twice(10) - pi + pi", 24},
+		{"sqrt(gross(120) - 119)", 5},
+	}
+	for _, tc := range tests {
+		result := engine.Evaluate(calc.WithDefinitions(ctx, defs), tc.Expression)
+		if result.Error != nil || math.Abs(result.Value-tc.Want) > 1e-12 {
+			t.Errorf("%s: got %g, %v, want %g", tc.Expression, result.Value, result.Error, tc.Want)
+		}
+	}
+
+	// Definitions are not visible to other tenants
+	if result := engine.Evaluate(ctx, "margin(100, 60)"); result.Error == nil {
+		t.Error("margin without definitions: want an error")
+	}
+	if _, other, err := m.Load(ctx, "globex"); err != nil || len(other.Functions) != 0 {
+		t.Errorf("other tenant: got %v, %v", other, err)
+	}
+}
+
+func TestInvalidDefinitions(t *testing.T) {
+	m := library.NewManager(library.NewMemoryStore(), calc.NewDefaultCalculator().Registry)
+	for _, src := range []string{"f(x) = x + 1", "g(x, y) = f(x) * y", "k = 1"} {
+		if strings.Contains(src, "(") {
+			if err := define(m, "acme", src); err != nil {
+				t.Fatalf("%s: %v", src, err)
+			}
+		}
+	}
+
+	tests := []string{
+		"x + 1",
+		"f = x",
+		"2(x) = x",
+		"h(1) = 1",
+		"h(x, x) = x",
+		"h(pi) = pi",
+		"h(x) = ",
+		"h(x) = y",
+		"h(x) = (x",
+		"sqrt(x) = x",
+		"pi(x) = x",
+		"h(x) = f(x, x)",
+		"h(x) = h(x - 1)",
+		"f(x) = g(x, 1)",
+	}
+	for _, src := range tests {
+		t.Run(src, func(t *testing.T) {
+			err := define(m, "acme", src)
+			var fieldErr *calc.FieldError
+			if !errors.As(err, &fieldErr) || !errors.Is(err, calc.ErrInvalidInput) {
+				t.Errorf("got %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+
+	for _, value := range []float64{math.Inf(1), math.NaN()} {
+		if _, err := m.Define(context.Background(), "acme", auth.RoleUser, &library.Definition{Name: "c", Value: value}, 0); !errors.Is(err, calc.ErrInvalidInput) {
+			t.Errorf("constant %g: got %v, want ErrInvalidInput", value, err)
+		}
+	}
+
+	// Failed definitions do not change the library
+	if lib, _, _ := m.Load(context.Background(), "acme"); lib.Version != 2 || len(lib.Definitions) != 2 {
+		t.Errorf("got version %d with %d definitions, want 2 and 2", lib.Version, len(lib.Definitions))
+	}
+}
+
+func TestDepth(t *testing.T) {
+	m := library.NewManager(library.NewMemoryStore(), calc.NewDefaultCalculator().Registry)
+	if err := define(m, "acme", "f0(x) = x"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < calc.MaxCallDepth; i++ {
+		if err := define(m, "acme", fmt.Sprintf("f%d(x) = f%d(x)", i, i-1)); err != nil {
+			t.Fatalf("depth %d: %v", i+1, err)
+		}
+	}
+	if err := define(m, "acme", fmt.Sprintf("f%d(x) = f%d(x)", calc.MaxCallDepth, calc.MaxCallDepth-1)); !errors.Is(err, calc.ErrInvalidInput) {
+		t.Errorf("depth %d: got %v, want ErrInvalidInput", calc.MaxCallDepth+1, err)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	ctx := context.Background()
+	m := library.NewManager(library.NewMemoryStore(), calc.NewDefaultCalculator().Registry)
+
+	if err := define(m, "acme", "f(x) = x + 1"); err != nil {
+		t.Fatal(err)
+	}
+	def, err := m.Define(ctx, "acme", auth.RoleAdmin, &library.Definition{Name: "g", Params: []string{"x"}, Body: "f(x) * 2", Role: auth.RoleAdmin}, 1)
+	if err != nil || def.Version != 2 {
+		t.Fatalf("got %v, %v, want version 2", def, err)
+	}
+
+	// Changes must be based on the current version
+	if _, err := m.Define(ctx, "acme", auth.RoleUser, &library.Definition{Name: "k", Value: 1}, 1); !errors.Is(err, library.ErrConflict) {
+		t.Errorf("outdated change: got %v, want ErrConflict", err)
+	}
+
+	// Callers need the role of the definitions they change
+	if _, err := m.Define(ctx, "acme", auth.RoleUser, &library.Definition{Name: "k", Value: 1, Role: auth.RoleAdmin}, 0); !errors.Is(err, library.ErrForbidden) {
+		t.Errorf("defining for ADMIN: got %v, want ErrForbidden", err)
+	}
+	if err := m.Delete(ctx, "acme", auth.RoleUser, "g", 0); !errors.Is(err, library.ErrForbidden) {
+		t.Errorf("deleting an ADMIN definition: got %v, want ErrForbidden", err)
+	}
+
+	// Definitions in use cannot be deleted
+	if err := m.Delete(ctx, "acme", auth.RoleUser, "f", 0); !errors.Is(err, calc.ErrInvalidInput) {
+		t.Errorf("deleting a used definition: got %v, want ErrInvalidInput", err)
+	}
+	if err := m.Delete(ctx, "acme", auth.RoleAdmin, "G", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Delete(ctx, "acme", auth.RoleUser, "g", 0); !errors.Is(err, library.ErrNotFound) {
+		t.Errorf("deleting twice: got %v, want ErrNotFound", err)
+	}
+
+	lib, _, err := m.Load(ctx, "acme")
+	if err != nil || lib.Version != 3 {
+		t.Errorf("got version %d, %v, want 3", lib.Version, err)
+	}
+	if used := lib.Uses("f(2) + 1"); len(used) != 1 || used[0].Name != "f" {
+		t.Errorf("Uses: got %v", used)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	registry := calc.NewDefaultCalculator().Registry
+	store, err := library.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := define(library.NewManager(store, registry), "a/../b", "f(x) = x^2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new manager loads the saved library
+	lib, defs, err := library.NewManager(store, registry).Load(ctx, "a/../b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	def, ok := lib.Definitions["f"]
+	if lib.Version != 1 || !ok || def.String() != "f(x) = x^2" || defs.Functions["f"] == nil {
+		t.Errorf("got %+v", lib)
+	}
+}
@@ -0,0 +1,118 @@
+package library
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Store persists the libraries of tenants
+type Store interface {
+	// Load returns the library of a tenant, which is empty at version 0 if
+	// nothing was saved
+	Load(ctx context.Context, tenant string) (*Library, error)
+	// Save replaces the library of its tenant
+	Save(ctx context.Context, lib *Library) error
+}
+
+// MemoryStore keeps libraries in memory, e.g. for tests
+type MemoryStore struct {
+	mu        sync.Mutex
+	libraries map[string]*Library
+}
+
+// NewMemoryStore creates an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{libraries: make(map[string]*Library)}
+}
+
+// Load implements Store
+func (m *MemoryStore) Load(ctx context.Context, tenant string) (*Library, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if lib, ok := m.libraries[tenant]; ok {
+		return lib.clone(), nil
+	}
+	return newLibrary(tenant), nil
+}
+
+// Save implements Store
+func (m *MemoryStore) Save(ctx context.Context, lib *Library) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.libraries[lib.Tenant] = lib.clone()
+	return nil
+}
+
+// FileStore keeps the library of each tenant in a JSON file of a directory
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a store in dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create library directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// path returns the file of a tenant. Tenant names are hex-encoded so that
+// any name is a safe file name.
+func (f *FileStore) path(tenant string) string {
+	return filepath.Join(f.dir, "tenant-"+hex.EncodeToString([]byte(tenant))+".json")
+}
+
+// Load implements Store
+func (f *FileStore) Load(ctx context.Context, tenant string) (*Library, error) {
+	data, err := os.ReadFile(f.path(tenant))
+	if errors.Is(err, os.ErrNotExist) {
+		return newLibrary(tenant), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read library: %w", err)
+	}
+
+	lib := newLibrary(tenant)
+	if err := json.Unmarshal(data, lib); err != nil {
+		return nil, fmt.Errorf("failed to parse library of %q: %w", tenant, err)
+	}
+	if lib.Definitions == nil {
+		lib.Definitions = map[string]*Definition{}
+	}
+	return lib, nil
+}
+
+// Save implements Store. The file is replaced atomically, so a failed save
+// keeps the previous version.
+func (f *FileStore) Save(ctx context.Context, lib *Library) error {
+	data, err := json.MarshalIndent(lib, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode library: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(f.dir, "library-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to save library: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save library: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save library: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.path(lib.Tenant)); err != nil {
+		return fmt.Errorf("failed to save library: %w", err)
+	}
+	return nil
+}
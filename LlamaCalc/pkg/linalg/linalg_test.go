@@ -0,0 +1,300 @@
+package linalg_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/linalg"
+)
+
+// matrix creates a matrix from its rows
+func matrix(t *testing.T, rows ...[]float64) *linalg.Matrix {
+	t.Helper()
+	var data []float64
+	for _, row := range rows {
+		data = append(data, row...)
+	}
+	m, err := linalg.New(len(rows), len(rows[0]), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// product returns a*b
+func product(t *testing.T, a, b *linalg.Matrix) *linalg.Matrix {
+	t.Helper()
+	m, err := linalg.Multiply(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// assertClose fails unless got and want have the same dimensions and their
+// elements differ by at most tol
+func assertClose(t *testing.T, name string, got, want *linalg.Matrix, tol float64) {
+	t.Helper()
+	if got.Rows != want.Rows || got.Cols != want.Cols {
+		t.Fatalf("%s: got %v matrix, want %v", name, got, want)
+	}
+	for i := range want.Data {
+		if math.Abs(got.Data[i]-want.Data[i]) > tol {
+			t.Fatalf("%s: got %v, want %v", name, got.Data, want.Data)
+		}
+	}
+}
+
+// assertSingular fails unless err is a *SingularError whose condition number
+// is at least min
+func assertSingular(t *testing.T, err error, min float64) {
+	t.Helper()
+	var singular *linalg.SingularError
+	if !errors.As(err, &singular) || !errors.Is(err, calc.ErrSingular) {
+		t.Fatalf("got %v, want a SingularError", err)
+	}
+	if !(singular.Condition >= min) {
+		t.Errorf("got condition number %g, want at least %g", singular.Condition, min)
+	}
+}
+
+// assertField fails unless err is a FieldError for field wrapping want
+func assertField(t *testing.T, err error, field string, want error) {
+	t.Helper()
+	var fieldErr *calc.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != field || !errors.Is(err, want) {
+		t.Errorf("got %v, want %v for field %s", err, want, field)
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Rows, Cols int
+		Data       []float64
+	}{
+		{"no rows", 0, 2, nil},
+		{"negative columns", 2, -1, nil},
+		{"too few elements", 2, 2, []float64{1, 2, 3}},
+		{"too many elements", 1, 2, []float64{1, 2, 3}},
+		{"overflowing size", math.MaxInt, 2, nil},
+		{"NaN", 1, 2, []float64{1, math.NaN()}},
+		{"infinity", 1, 1, []float64{math.Inf(-1)}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := linalg.New(tc.Rows, tc.Cols, tc.Data); !errors.Is(err, calc.ErrInvalidInput) {
+				t.Errorf("got %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
+
+func TestLU(t *testing.T) {
+	tests := []struct {
+		Name string
+		A    [][]float64
+		Det  float64
+	}{
+		{"identity", [][]float64{{1, 0}, {0, 1}}, 1},
+		{"pivoting", [][]float64{{0, 1}, {2, 3}}, -2},
+		{"3x3", [][]float64{{2, -1, 0}, {-1, 2, -1}, {0, -1, 2}}, 4},
+		{"singular", [][]float64{{1, 2}, {2, 4}}, 0},
+		{"zero column", [][]float64{{0, 1, 2}, {0, 3, 4}, {0, 5, 7}}, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := matrix(t, tc.A...)
+			f, err := linalg.DecomposeLU(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// P*A = L*U
+			pa := linalg.Zeros(a.Rows, a.Cols)
+			for i, p := range f.Pivot {
+				copy(pa.Data[i*a.Cols:], a.Data[p*a.Cols:(p+1)*a.Cols])
+			}
+			assertClose(t, "L*U", product(t, f.L, f.U), pa, 1e-12)
+			for i := 0; i < a.Rows; i++ {
+				if f.L.At(i, i) != 1 {
+					t.Errorf("L(%d,%d) = %v, want 1", i, i, f.L.At(i, i))
+				}
+				for j := 0; j < i; j++ {
+					if f.U.At(i, j) != 0 || f.L.At(j, i) != 0 {
+						t.Errorf("L or U is not triangular at (%d,%d)", i, j)
+					}
+				}
+			}
+
+			det, err := linalg.Det(a)
+			if err != nil || math.Abs(det-tc.Det) > 1e-12 {
+				t.Errorf("got determinant %v, %v, want %v", det, err, tc.Det)
+			}
+		})
+	}
+
+	if _, err := linalg.DecomposeLU(linalg.Zeros(2, 3)); !errors.Is(err, calc.ErrInvalidInput) {
+		t.Errorf("non-square matrix: got %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestDetRange(t *testing.T) {
+	tests := []struct {
+		Name string
+		A    [][]float64
+		Want error
+	}{
+		{"overflow", [][]float64{{1e200, 0}, {0, 1e200}}, calc.ErrOverflow},
+		{"underflow", [][]float64{{-1e200, 0}, {0, 1e200}}, calc.ErrUnderflow},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _, err := linalg.Det(matrix(t, tc.A...)); !errors.Is(err, tc.Want) {
+				t.Errorf("got %v, want %v", err, tc.Want)
+			}
+		})
+	}
+}
+
+func TestInverse(t *testing.T) {
+	// The condition number of [[1, 1], [1, 1+e]] is (2+e)^2/e
+	conditionOf := func(e float64) float64 { return (2 + e) * (2 + e) / e }
+
+	tests := []struct {
+		Name      string
+		A         [][]float64
+		Condition float64
+		Singular  bool
+	}{
+		{"identity", [][]float64{{1, 0}, {0, 1}}, 1, false},
+		{"well-conditioned", [][]float64{{4, 7}, {2, 6}}, 13 * 1.1, false},
+		{"near-singular", [][]float64{{1, 1}, {1, 1 + 0x1p-30}}, conditionOf(0x1p-30), false},
+		{"ill-conditioned", [][]float64{{1, 1}, {1, 1 + 0x1p-52}}, conditionOf(0x1p-52), true},
+		{"singular", [][]float64{{1, 2}, {2, 4}}, math.Inf(1), true},
+		{"zero", [][]float64{{0, 0}, {0, 0}}, math.Inf(1), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := matrix(t, tc.A...)
+			inv, cond, err := linalg.Inverse(a)
+			if tc.Singular {
+				assertSingular(t, err, math.Min(tc.Condition, linalg.MaxCondition))
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertClose(t, "A*inv(A)", product(t, a, inv), linalg.Identity(a.Rows), 1e-5)
+			if math.Abs(cond-tc.Condition) > 1e-4*tc.Condition {
+				t.Errorf("got condition number %g, want %g", cond, tc.Condition)
+			}
+		})
+	}
+}
+
+func TestSolve(t *testing.T) {
+	a := matrix(t, []float64{2, 1}, []float64{1, 3})
+	b := matrix(t, []float64{3, 5}, []float64{5, 10})
+	x, cond, err := linalg.Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertClose(t, "X", x, matrix(t, []float64{0.8, 1}, []float64{1.4, 3}), 1e-12)
+	if want := 4.0 * 4 / 5; math.Abs(cond-want) > 1e-12 {
+		t.Errorf("got condition number %g, want %g", cond, want)
+	}
+
+	_, _, err = linalg.Solve(matrix(t, []float64{1, 2}, []float64{2, 4}), b)
+	assertSingular(t, err, math.Inf(1))
+	_, _, err = linalg.Solve(matrix(t, []float64{1, 1}, []float64{1, 1 + 0x1p-52}), b)
+	assertSingular(t, err, linalg.MaxCondition)
+
+	// Non-square systems are solved in the least-squares sense instead
+	_, _, err = linalg.Solve(matrix(t, []float64{1, 2, 3}, []float64{4, 5, 6}), b)
+	assertField(t, err, "a", calc.ErrInvalidInput)
+	_, _, err = linalg.Solve(a, matrix(t, []float64{1, 2, 3}))
+	assertField(t, err, "b", calc.ErrInvalidInput)
+}
+
+func TestQR(t *testing.T) {
+	tests := []struct {
+		Name string
+		A    [][]float64
+	}{
+		{"square", [][]float64{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}}},
+		{"tall", [][]float64{{1, 2}, {3, 4}, {5, 6}, {7, 8}}},
+		{"wide", [][]float64{{1, 2, 3}, {4, 5, 6}}},
+		{"singular", [][]float64{{1, 2}, {2, 4}}},
+		{"zero column", [][]float64{{0, 1}, {0, 2}, {0, 3}}},
+		{"one row", [][]float64{{3, 4}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := matrix(t, tc.A...)
+			f, err := linalg.DecomposeQR(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertClose(t, "Q*R", product(t, f.Q, f.R), a, 1e-10)
+			assertClose(t, "Q'*Q", product(t, linalg.Transpose(f.Q), f.Q), linalg.Identity(a.Rows), 1e-12)
+			for i := 0; i < f.R.Rows; i++ {
+				for j := 0; j < i && j < f.R.Cols; j++ {
+					if f.R.At(i, j) != 0 {
+						t.Errorf("R(%d,%d) = %v, want 0", i, j, f.R.At(i, j))
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLeastSquares(t *testing.T) {
+	// y = 1 + 2x with noise of mean zero on each pair of points
+	a := matrix(t, []float64{1, 0}, []float64{1, 1}, []float64{1, 2}, []float64{1, 3})
+	b := matrix(t, []float64{1.1}, []float64{2.9}, []float64{5.1}, []float64{6.9})
+	solution, err := linalg.LeastSquares(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reference from the normal equations A'A x = A'b
+	ata := product(t, linalg.Transpose(a), a)
+	atb := product(t, linalg.Transpose(a), b)
+	want, _, err := linalg.Solve(ata, atb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertClose(t, "X", solution.X, want, 1e-12)
+	inv, _, err := linalg.Inverse(ata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertClose(t, "covariance", solution.Covariance, inv, 1e-12)
+	if solution.Condition < 1 || math.IsInf(solution.Condition, 0) {
+		t.Errorf("got condition number %g", solution.Condition)
+	}
+
+	// A square system has the exact solution
+	square := matrix(t, []float64{2, 1}, []float64{1, 3})
+	solution, err = linalg.LeastSquares(square, matrix(t, []float64{3}, []float64{5}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertClose(t, "square X", solution.X, matrix(t, []float64{0.8}, []float64{1.4}), 1e-12)
+
+	// Rank-deficient and nearly rank-deficient columns
+	rhs := matrix(t, []float64{1}, []float64{2}, []float64{3})
+	_, err = linalg.LeastSquares(matrix(t, []float64{1, 2}, []float64{2, 4}, []float64{3, 6}), rhs)
+	assertSingular(t, err, linalg.MaxCondition)
+	_, err = linalg.LeastSquares(matrix(t, []float64{1, 1}, []float64{1, 1}, []float64{1, 1 + 0x1p-52}), rhs)
+	assertSingular(t, err, linalg.MaxCondition)
+
+	_, err = linalg.LeastSquares(matrix(t, []float64{1, 2, 3}, []float64{4, 5, 6}), matrix(t, []float64{1}, []float64{2}))
+	assertField(t, err, "a", calc.ErrInvalidInput)
+	_, err = linalg.LeastSquares(a, matrix(t, []float64{1}, []float64{2}))
+	assertField(t, err, "b", calc.ErrInvalidInput)
+}
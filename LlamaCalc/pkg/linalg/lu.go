@@ -0,0 +1,242 @@
+package linalg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"llamacalc/pkg/calc"
+)
+
+// MaxCondition is the largest condition number of a matrix that is inverted
+// or solved. Beyond it, roughly the reciprocal of the float64 machine
+// epsilon, the result has no correct digits.
+const MaxCondition = 1 / 0x1p-52
+
+// SingularError reports a singular or ill-conditioned matrix
+type SingularError struct {
+	// Condition is an estimate of the 1-norm condition number; it is +Inf for
+	// exactly singular matrices
+	Condition float64
+}
+
+// Error implements error
+func (e *SingularError) Error() string {
+	return fmt.Sprintf("%v (condition number %.3g)", calc.ErrSingular, e.Condition)
+}
+
+// Unwrap returns calc.ErrSingular
+func (e *SingularError) Unwrap() error {
+	return calc.ErrSingular
+}
+
+// Metadata returns the condition number for error details
+func (e *SingularError) Metadata() map[string]string {
+	return map[string]string{"condition_number": strconv.FormatFloat(e.Condition, 'g', 6, 64)}
+}
+
+// LU is the LU decomposition with partial pivoting P*A = L*U of a square
+// matrix A
+type LU struct {
+	// L is unit lower triangular
+	L *Matrix
+	// U is upper triangular
+	U *Matrix
+	// Pivot lists the row of A at each row of P*A
+	Pivot []int
+	// Sign is the determinant of P, 1 or -1
+	Sign float64
+
+	// lu holds L below and U on and above the diagonal
+	lu *Matrix
+}
+
+// DecomposeLU computes the LU decomposition of the square matrix a. It
+// succeeds for singular matrices, which have a zero on the diagonal of U.
+func DecomposeLU(a *Matrix) (*LU, error) {
+	return decompose("matrix", a)
+}
+
+// decompose computes the LU decomposition of a, which is named field in errors
+func decompose(field string, a *Matrix) (*LU, error) {
+	if err := requireSquare(field, a); err != nil {
+		return nil, err
+	}
+
+	n := a.Rows
+	lu := a.Clone()
+	pivot := make([]int, n)
+	for i := range pivot {
+		pivot[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < n; k++ {
+		// Use the largest remaining element of column k as the pivot
+		p := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu.At(i, k)) > math.Abs(lu.At(p, k)) {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				lu.Data[p*n+j], lu.Data[k*n+j] = lu.Data[k*n+j], lu.Data[p*n+j]
+			}
+			pivot[p], pivot[k] = pivot[k], pivot[p]
+			sign = -sign
+		}
+
+		if lu.At(k, k) == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			f := lu.At(i, k) / lu.At(k, k)
+			lu.Set(i, k, f)
+			for j := k + 1; j < n; j++ {
+				lu.Set(i, j, lu.At(i, j)-f*lu.At(k, j))
+			}
+		}
+	}
+
+	if _, err := checkResult(lu); err != nil {
+		return nil, err
+	}
+
+	l, u := Identity(n), Zeros(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if j < i {
+				l.Set(i, j, lu.At(i, j))
+			} else {
+				u.Set(i, j, lu.At(i, j))
+			}
+		}
+	}
+
+	return &LU{L: l, U: u, Pivot: pivot, Sign: sign, lu: lu}, nil
+}
+
+// Det returns the determinant of the decomposed matrix
+func (f *LU) Det() float64 {
+	det := f.Sign
+	for i := 0; i < f.lu.Rows; i++ {
+		det *= f.lu.At(i, i)
+	}
+	return det
+}
+
+// singular reports whether U has a zero on its diagonal
+func (f *LU) singular() bool {
+	for i := 0; i < f.lu.Rows; i++ {
+		if f.lu.At(i, i) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// solve solves A*X = B for a non-singular A
+func (f *LU) solve(b *Matrix) *Matrix {
+	n, cols := f.lu.Rows, b.Cols
+	x := Zeros(n, cols)
+	for i, p := range f.Pivot {
+		copy(x.Data[i*cols:(i+1)*cols], b.Data[p*cols:(p+1)*cols])
+	}
+
+	for j := 0; j < cols; j++ {
+		// Forward substitution with L
+		for i := 0; i < n; i++ {
+			sum := x.At(i, j)
+			for k := 0; k < i; k++ {
+				sum -= f.lu.At(i, k) * x.At(k, j)
+			}
+			x.Set(i, j, sum)
+		}
+		// Back substitution with U
+		for i := n - 1; i >= 0; i-- {
+			sum := x.At(i, j)
+			for k := i + 1; k < n; k++ {
+				sum -= f.lu.At(i, k) * x.At(k, j)
+			}
+			x.Set(i, j, sum/f.lu.At(i, i))
+		}
+	}
+
+	return x
+}
+
+// inverse returns the inverse of the decomposed matrix and its 1-norm
+// condition number, or a *SingularError
+func (f *LU) inverse(a *Matrix) (*Matrix, float64, error) {
+	if f.singular() {
+		return nil, math.Inf(1), &SingularError{Condition: math.Inf(1)}
+	}
+
+	inv := f.solve(Identity(a.Rows))
+	cond := norm1(a) * norm1(inv)
+	if math.IsNaN(cond) || cond > MaxCondition {
+		return nil, cond, &SingularError{Condition: cond}
+	}
+
+	return inv, cond, nil
+}
+
+// Det returns the determinant of the square matrix a
+func Det(a *Matrix) (float64, error) {
+	f, err := DecomposeLU(a)
+	if err != nil {
+		return 0, err
+	}
+
+	det := f.Det()
+	if math.IsInf(det, 1) {
+		return 0, calc.ErrOverflow
+	}
+	if math.IsInf(det, -1) {
+		return 0, calc.ErrUnderflow
+	}
+	return det, nil
+}
+
+// Inverse returns the inverse of the square matrix a and its 1-norm
+// condition number. Singular and ill-conditioned matrices are reported as a
+// *SingularError.
+func Inverse(a *Matrix) (*Matrix, float64, error) {
+	f, err := DecomposeLU(a)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	inv, cond, err := f.inverse(a)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	inv, err = checkResult(inv)
+	return inv, cond, err
+}
+
+// Solve solves A*X = B for the square matrix a, where b holds one right-hand
+// side per column, and returns X and the 1-norm condition number of a.
+// Singular and ill-conditioned matrices are reported as a *SingularError.
+func Solve(a, b *Matrix) (*Matrix, float64, error) {
+	if b.Rows != a.Rows {
+		return nil, 0, dimensionError("b", "%v right-hand side does not match %v matrix", b, a)
+	}
+
+	f, err := decompose("a", a)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The inverse is only used for the condition number; computing it costs
+	// about as much as the decomposition
+	_, cond, err := f.inverse(a)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	x, err := checkResult(f.solve(b))
+	return x, cond, err
+}
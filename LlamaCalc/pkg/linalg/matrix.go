@@ -0,0 +1,202 @@
+// Package linalg implements dense matrix operations in pure Go.
+//
+// Matrices are stored row-major. Errors use the sentinels of package calc so
+// that they are reported to clients like other calculation errors: invalid
+// dimensions and non-finite elements as calc.ErrInvalidInput, non-finite
+// results as calc.ErrOverflow or calc.ErrUnderflow and singular matrices as a
+// *SingularError wrapping calc.ErrSingular.
+package linalg
+
+import (
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// Matrix is a dense matrix stored in row-major order
+type Matrix struct {
+	Rows int
+	Cols int
+	Data []float64
+}
+
+// New creates a rows x cols matrix backed by data. Both dimensions must be
+// positive, data must hold exactly rows*cols finite elements.
+func New(rows, cols int, data []float64) (*Matrix, error) {
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("%w: dimensions %dx%d must be positive", calc.ErrInvalidInput, rows, cols)
+	}
+	if rows > math.MaxInt/cols || len(data) != rows*cols {
+		return nil, fmt.Errorf("%w: %dx%d matrix needs %d elements, got %d", calc.ErrInvalidInput, rows, cols, rows*cols, len(data))
+	}
+	for i, v := range data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("%w: element %d is not finite", calc.ErrInvalidInput, i)
+		}
+	}
+
+	return &Matrix{Rows: rows, Cols: cols, Data: data}, nil
+}
+
+// Zeros creates a rows x cols matrix of zeros
+func Zeros(rows, cols int) *Matrix {
+	return &Matrix{Rows: rows, Cols: cols, Data: make([]float64, rows*cols)}
+}
+
+// Identity creates the n x n identity matrix
+func Identity(n int) *Matrix {
+	m := Zeros(n, n)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// At returns the element in row i and column j
+func (m *Matrix) At(i, j int) float64 {
+	return m.Data[i*m.Cols+j]
+}
+
+// Set sets the element in row i and column j
+func (m *Matrix) Set(i, j int, v float64) {
+	m.Data[i*m.Cols+j] = v
+}
+
+// IsSquare reports whether m has as many rows as columns
+func (m *Matrix) IsSquare() bool {
+	return m.Rows == m.Cols
+}
+
+// Clone returns a copy of m
+func (m *Matrix) Clone() *Matrix {
+	data := make([]float64, len(m.Data))
+	copy(data, m.Data)
+	return &Matrix{Rows: m.Rows, Cols: m.Cols, Data: data}
+}
+
+// String implements fmt.Stringer
+func (m *Matrix) String() string {
+	return fmt.Sprintf("%dx%d", m.Rows, m.Cols)
+}
+
+// dimensionError reports that the dimensions of field are not acceptable
+func dimensionError(field, format string, args ...interface{}) error {
+	return &calc.FieldError{
+		Field: field,
+		Err:   fmt.Errorf("%w: "+format, append([]interface{}{calc.ErrInvalidInput}, args...)...),
+	}
+}
+
+// requireSquare checks that the matrix named field is square
+func requireSquare(field string, m *Matrix) error {
+	if !m.IsSquare() {
+		return dimensionError(field, "%v matrix is not square", m)
+	}
+	return nil
+}
+
+// checkResult reports the first non-finite element of a result as overflow
+// or underflow, like calc.Calculator does for scalars. NaN can only come from
+// an intermediate infinity and is reported as overflow.
+func checkResult(m *Matrix) (*Matrix, error) {
+	for _, v := range m.Data {
+		switch {
+		case math.IsNaN(v), math.IsInf(v, 1):
+			return nil, calc.ErrOverflow
+		case math.IsInf(v, -1):
+			return nil, calc.ErrUnderflow
+		}
+	}
+	return m, nil
+}
+
+// elementwise applies fn to the corresponding elements of a and b
+func elementwise(a, b *Matrix, fn func(x, y float64) (float64, error)) (*Matrix, error) {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return nil, dimensionError("b", "%v matrix does not match %v matrix", b, a)
+	}
+
+	result := Zeros(a.Rows, a.Cols)
+	for i := range a.Data {
+		v, err := fn(a.Data[i], b.Data[i])
+		if err != nil {
+			return nil, err
+		}
+		result.Data[i] = v
+	}
+
+	return checkResult(result)
+}
+
+// Add returns the element-wise sum a + b
+func Add(a, b *Matrix) (*Matrix, error) {
+	return elementwise(a, b, func(x, y float64) (float64, error) { return x + y, nil })
+}
+
+// Subtract returns the element-wise difference a - b
+func Subtract(a, b *Matrix) (*Matrix, error) {
+	return elementwise(a, b, func(x, y float64) (float64, error) { return x - y, nil })
+}
+
+// MultiplyElements returns the element-wise (Hadamard) product of a and b
+func MultiplyElements(a, b *Matrix) (*Matrix, error) {
+	return elementwise(a, b, func(x, y float64) (float64, error) { return x * y, nil })
+}
+
+// DivideElements returns the element-wise quotient of a and b
+func DivideElements(a, b *Matrix) (*Matrix, error) {
+	return elementwise(a, b, func(x, y float64) (float64, error) {
+		if y == 0 {
+			return 0, &calc.FieldError{Field: "b", Err: calc.ErrDivideByZero}
+		}
+		return x / y, nil
+	})
+}
+
+// Multiply returns the matrix product a * b
+func Multiply(a, b *Matrix) (*Matrix, error) {
+	if a.Cols != b.Rows {
+		return nil, dimensionError("b", "cannot multiply %v matrix by %v matrix", a, b)
+	}
+
+	result := Zeros(a.Rows, b.Cols)
+	for i := 0; i < a.Rows; i++ {
+		row := result.Data[i*b.Cols : (i+1)*b.Cols]
+		for k := 0; k < a.Cols; k++ {
+			aik := a.At(i, k)
+			if aik == 0 {
+				continue
+			}
+			for j, bkj := range b.Data[k*b.Cols : (k+1)*b.Cols] {
+				row[j] += aik * bkj
+			}
+		}
+	}
+
+	return checkResult(result)
+}
+
+// Transpose returns the transpose of m
+func Transpose(m *Matrix) *Matrix {
+	result := Zeros(m.Cols, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			result.Set(j, i, m.At(i, j))
+		}
+	}
+	return result
+}
+
+// norm1 returns the maximum absolute column sum of m
+func norm1(m *Matrix) float64 {
+	var norm float64
+	for j := 0; j < m.Cols; j++ {
+		var sum float64
+		for i := 0; i < m.Rows; i++ {
+			sum += math.Abs(m.At(i, j))
+		}
+		norm = math.Max(norm, sum)
+	}
+	return norm
+}
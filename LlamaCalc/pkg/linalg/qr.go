@@ -0,0 +1,156 @@
+package linalg
+
+import "math"
+
+// QR is the decomposition A = Q*R of an m x n matrix A
+type QR struct {
+	// Q is an m x m orthogonal matrix
+	Q *Matrix
+	// R is an m x n upper triangular matrix
+	R *Matrix
+}
+
+// DecomposeQR computes the QR decomposition of a with Householder
+// reflections
+func DecomposeQR(a *Matrix) (*QR, error) {
+	m := a.Rows
+	r := a.Clone()
+	q := Identity(m)
+
+	triangularize(r, a.Cols, func(v []float64, k int, vnorm2 float64) {
+		// Q = Q*H
+		for i := 0; i < m; i++ {
+			var dot float64
+			for j := k; j < m; j++ {
+				dot += q.At(i, j) * v[j]
+			}
+			f := 2 * dot / vnorm2
+			for j := k; j < m; j++ {
+				q.Set(i, j, q.At(i, j)-f*v[j])
+			}
+		}
+	})
+
+	if _, err := checkResult(r); err != nil {
+		return nil, err
+	}
+
+	return &QR{Q: q, R: r}, nil
+}
+
+// triangularize applies Householder reflections H = I - 2*v*v'/(v'*v) to
+// all columns of r until its first n columns are upper triangular. reflect
+// is called with each reflection, whose vector is non-zero from row k on.
+func triangularize(r *Matrix, n int, reflect func(v []float64, k int, vnorm2 float64)) {
+	m := r.Rows
+	v := make([]float64, m)
+
+	for k := 0; k < n && k < m-1; k++ {
+		// Reflect column k below the diagonal onto the axis
+		var norm float64
+		for i := k; i < m; i++ {
+			norm = math.Hypot(norm, r.At(i, k))
+		}
+		if norm == 0 {
+			continue
+		}
+		alpha := -math.Copysign(norm, r.At(k, k))
+
+		var vnorm2 float64
+		for i := k; i < m; i++ {
+			v[i] = r.At(i, k)
+			if i == k {
+				v[i] -= alpha
+			}
+			vnorm2 += v[i] * v[i]
+		}
+		if vnorm2 == 0 {
+			continue
+		}
+
+		// R = H*R
+		for j := k; j < r.Cols; j++ {
+			var dot float64
+			for i := k; i < m; i++ {
+				dot += v[i] * r.At(i, j)
+			}
+			f := 2 * dot / vnorm2
+			for i := k; i < m; i++ {
+				r.Set(i, j, r.At(i, j)-f*v[i])
+			}
+		}
+		if reflect != nil {
+			reflect(v, k, vnorm2)
+		}
+
+		// Clear the rounding residue below the diagonal
+		r.Set(k, k, alpha)
+		for i := k + 1; i < m; i++ {
+			r.Set(i, k, 0)
+		}
+	}
+}
+
+// LeastSquaresSolution is the solution of a linear least-squares problem
+type LeastSquaresSolution struct {
+	// X minimises the 2-norm of A*X - B, column by column
+	X *Matrix
+	// Covariance is the unscaled covariance (A'*A)^-1 of the solution
+	Covariance *Matrix
+	// Condition is the 1-norm condition number of R in A = Q*R, which is
+	// the 2-norm condition of A up to a factor of the dimension
+	Condition float64
+}
+
+// LeastSquares solves the least-squares problem min |A*X - B| for an m x n
+// matrix a with m >= n, where b holds one right-hand side per column. Q is
+// never formed, so the memory use is that of a and b. Rank-deficient and
+// ill-conditioned matrices are reported as a *SingularError.
+func LeastSquares(a, b *Matrix) (*LeastSquaresSolution, error) {
+	m, n, k := a.Rows, a.Cols, b.Cols
+	if m < n {
+		return nil, dimensionError("a", "%v matrix has fewer rows than columns", a)
+	}
+	if b.Rows != m {
+		return nil, dimensionError("b", "%v right-hand side does not match %v matrix", b, a)
+	}
+
+	// Reduce [A | B] to [R | Q'*B]
+	aug := Zeros(m, n+k)
+	for i := 0; i < m; i++ {
+		copy(aug.Data[i*(n+k):], a.Data[i*n:(i+1)*n])
+		copy(aug.Data[i*(n+k)+n:], b.Data[i*k:(i+1)*k])
+	}
+	triangularize(aug, n, nil)
+
+	r := Zeros(n, n)
+	for i := 0; i < n; i++ {
+		copy(r.Data[i*n+i:(i+1)*n], aug.Data[i*(n+k)+i:i*(n+k)+n])
+	}
+
+	// R is triangular, so its LU decomposition needs no pivoting; its
+	// inverse gives the condition number and the covariance
+	f, err := decompose("a", r)
+	if err != nil {
+		return nil, err
+	}
+	rInv, cond, err := f.inverse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	qtb := Zeros(n, k)
+	for i := 0; i < n; i++ {
+		copy(qtb.Data[i*k:(i+1)*k], aug.Data[i*(n+k)+n:(i+1)*(n+k)])
+	}
+	x, err := checkResult(f.solve(qtb))
+	if err != nil {
+		return nil, err
+	}
+	cov, err := Multiply(rInv, Transpose(rInv))
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeastSquaresSolution{X: x, Covariance: cov, Condition: cond}, nil
+}
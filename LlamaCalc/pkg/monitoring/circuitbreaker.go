@@ -0,0 +1,105 @@
+package monitoring
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// circuitStates lists the states reported by the client circuit breaker
+var circuitStates = []string{"CLOSED", "OPEN", "HALF_OPEN"}
+
+// CircuitBreakerCollector exports client circuit breaker metrics to Prometheus
+type CircuitBreakerCollector struct {
+	callCounter  *prometheus.CounterVec
+	stateGauge   *prometheus.GaugeVec
+	stateCounter *prometheus.CounterVec
+}
+
+// NewCircuitBreakerCollector creates a circuit breaker collector registered
+// with reg, or with the default Prometheus registry if reg is nil. Collectors
+// created with the same registry share their metrics, so one can be created
+// per client.
+func NewCircuitBreakerCollector(reg prometheus.Registerer) (*CircuitBreakerCollector, error) {
+	const namespace = "llamacalc"
+	const subsystem = "client_circuit_breaker"
+
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+
+	callCounter, err := register(reg, prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "calls_total",
+			Help:      "Total number of calls seen by the circuit breaker by outcome",
+		},
+		[]string{"target", "method", "outcome"},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	stateGauge, err := register(reg, prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "state",
+			Help:      "Current circuit state (1 for the active state, 0 otherwise)",
+		},
+		[]string{"target", "method", "state"},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	stateCounter, err := register(reg, prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "state_changes_total",
+			Help:      "Total number of circuit state transitions by new state",
+		},
+		[]string{"target", "method", "state"},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CircuitBreakerCollector{
+		callCounter:  callCounter,
+		stateGauge:   stateGauge,
+		stateCounter: stateCounter,
+	}, nil
+}
+
+// register registers c with reg, or returns the equal collector already
+// registered
+func register[C prometheus.Collector](reg prometheus.Registerer, c C) (C, error) {
+	err := reg.Register(c)
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		if existing, ok := registered.ExistingCollector.(C); ok {
+			return existing, nil
+		}
+	}
+	return c, err
+}
+
+// RecordCall records the outcome of a call
+func (c *CircuitBreakerCollector) RecordCall(target, method, outcome string) {
+	c.callCounter.WithLabelValues(target, method, outcome).Inc()
+}
+
+// RecordState records a circuit state transition
+func (c *CircuitBreakerCollector) RecordState(target, method, state string) {
+	for _, s := range circuitStates {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		c.stateGauge.WithLabelValues(target, method, s).Set(value)
+	}
+	c.stateCounter.WithLabelValues(target, method, state).Inc()
+}
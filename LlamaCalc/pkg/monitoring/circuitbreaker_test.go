@@ -0,0 +1,63 @@
+package monitoring_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"llamacalc/pkg/monitoring"
+)
+
+func TestCircuitBreakerCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	// Collectors of several clients share the metrics of a registry
+	for i := 0; i < 2; i++ {
+		c, err := monitoring.NewCircuitBreakerCollector(reg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.RecordCall("calc", "/Add", "failure")
+		c.RecordState("calc", "/Add", "OPEN")
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := map[string]float64{}
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := ""
+			for _, label := range m.GetLabel() {
+				labels += "," + label.GetValue()
+			}
+			key := family.GetName() + labels
+			switch {
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	want := map[string]float64{
+		"llamacalc_client_circuit_breaker_calls_total,/Add,failure,calc":      2,
+		"llamacalc_client_circuit_breaker_state,/Add,OPEN,calc":               1,
+		"llamacalc_client_circuit_breaker_state,/Add,CLOSED,calc":             0,
+		"llamacalc_client_circuit_breaker_state_changes_total,/Add,OPEN,calc": 2,
+	}
+	for key, value := range want {
+		if got, ok := values[key]; !ok || got != value {
+			t.Errorf("%s: got %v (%v), want %v", key, got, ok, value)
+		}
+	}
+
+	// Other metrics of the same name are not replaced
+	other := prometheus.NewRegistry()
+	other.MustRegister(prometheus.NewCounter(prometheus.CounterOpts{Name: "llamacalc_client_circuit_breaker_calls_total", Help: "other"}))
+	if _, err := monitoring.NewCircuitBreakerCollector(other); err == nil {
+		t.Error("registered over a conflicting metric")
+	}
+}
@@ -3,17 +3,38 @@ package monitoring
 
 import (
 	"context"
-	"strings"
+	"sync"
 	"time"
 
-	pb "llamacalc/pkg/proto"
-
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
 )
 
+// LabelFunc returns the operation and module labels of a call
+type LabelFunc func(fullMethod string, req interface{}) (operation, module string)
+
+// Labels of calls that do not perform an operation
+const (
+	UnknownOperation = "UNKNOWN"
+	UnknownModule    = "none"
+)
+
+var (
+	defaultCollector     *MetricsCollector
+	defaultCollectorOnce sync.Once
+)
+
+// DefaultMetricsCollector returns a collector registered with the default
+// Prometheus registry. It is created on first use and shared afterwards.
+func DefaultMetricsCollector() *MetricsCollector {
+	defaultCollectorOnce.Do(func() {
+		defaultCollector = NewMetricsCollector()
+	})
+	return defaultCollector
+}
+
 // MetricsCollector collects metrics for the Calculator service
 type MetricsCollector struct {
 	requestCounter     *prometheus.CounterVec
@@ -33,7 +54,7 @@ func NewMetricsCollector() *MetricsCollector {
 			Name:      "requests_total",
 			Help:      "Total number of gRPC requests",
 		},
-		[]string{"method", "operation"},
+		[]string{"method", "operation", "module"},
 	)
 
 	errorCounter := promauto.NewCounterVec(
@@ -43,7 +64,7 @@ func NewMetricsCollector() *MetricsCollector {
 			Name:      "errors_total",
 			Help:      "Total number of gRPC errors",
 		},
-		[]string{"method", "operation", "error_code"},
+		[]string{"method", "operation", "module", "error_code"},
 	)
 
 	responseTimeMetric := promauto.NewHistogramVec(
@@ -54,7 +75,7 @@ func NewMetricsCollector() *MetricsCollector {
 			Help:      "Response time of gRPC requests in seconds",
 			Buckets:   prometheus.DefBuckets,
 		},
-		[]string{"method", "operation"},
+		[]string{"method", "operation", "module"},
 	)
 
 	return &MetricsCollector{
@@ -65,39 +86,33 @@ func NewMetricsCollector() *MetricsCollector {
 }
 
 // RecordRequest records a request metric
-func (c *MetricsCollector) RecordRequest(method, operation string) {
-	c.requestCounter.WithLabelValues(method, operation).Inc()
+func (c *MetricsCollector) RecordRequest(method, operation, module string) {
+	c.requestCounter.WithLabelValues(method, operation, module).Inc()
 }
 
 // RecordError records an error metric
-func (c *MetricsCollector) RecordError(method, operation string, errorCode int32) {
-	c.errorCounter.WithLabelValues(method, operation, string(rune(errorCode))).Inc()
+func (c *MetricsCollector) RecordError(method, operation, module string, errorCode string) {
+	c.errorCounter.WithLabelValues(method, operation, module, errorCode).Inc()
 }
 
 // RecordResponseTime records the response time for a request
-func (c *MetricsCollector) RecordResponseTime(method, operation string, duration time.Duration) {
-	c.responseTimeMetric.WithLabelValues(method, operation).Observe(duration.Seconds())
+func (c *MetricsCollector) RecordResponseTime(method, operation, module string, duration time.Duration) {
+	c.responseTimeMetric.WithLabelValues(method, operation, module).Observe(duration.Seconds())
 }
 
-// MetricsInterceptor creates a gRPC interceptor for collecting metrics
-func (c *MetricsCollector) MetricsInterceptor() grpc.UnaryServerInterceptor {
+// MetricsInterceptor creates a gRPC interceptor for collecting metrics. The
+// operation and module labels are taken from labels; calls it does not
+// recognise, or all calls if it is nil, are labelled UnknownOperation.
+func (c *MetricsCollector) MetricsInterceptor(labels LabelFunc) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		method := info.FullMethod
 
-		// Extract operation from method name
-		operation := "UNKNOWN"
-		switch {
-		case strings.Contains(method, "Add"):
-			operation = "ADD"
-		case strings.Contains(method, "Subtract"):
-			operation = "SUBTRACT"
-		case strings.Contains(method, "Multiply"):
-			operation = "MULTIPLY"
-		case strings.Contains(method, "Divide"):
-			operation = "DIVIDE"
+		operation, module := UnknownOperation, UnknownModule
+		if labels != nil {
+			operation, module = labels(method, req)
 		}
 
-		c.RecordRequest(method, operation)
+		c.RecordRequest(method, operation, module)
 		startTime := time.Now()
 
 		// Call the RPC method
@@ -105,20 +120,43 @@ func (c *MetricsCollector) MetricsInterceptor() grpc.UnaryServerInterceptor {
 
 		// Record response time
 		duration := time.Since(startTime)
-		c.RecordResponseTime(method, operation, duration)
+		c.RecordResponseTime(method, operation, module, duration)
 
 		// Record error if any
 		if err != nil {
-			st, ok := status.FromError(err)
-			if ok {
-				c.RecordError(method, operation, int32(st.Code()))
-			} else {
-				c.RecordError(method, operation, -1) // Unknown error
-			}
-		} else if calcResp, ok := resp.(*pb.CalculationResponse); ok && calcResp.StatusCode != 0 {
-			c.RecordError(method, operation, calcResp.StatusCode)
+			c.RecordError(method, operation, module, status.Code(err).String())
 		}
 
 		return resp, err
 	}
 }
+
+// StreamMetricsInterceptor creates a gRPC interceptor for collecting metrics
+// of streaming calls. labels is called with a nil request.
+func (c *MetricsCollector) StreamMetricsInterceptor(labels LabelFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method := info.FullMethod
+
+		operation, module := UnknownOperation, UnknownModule
+		if labels != nil {
+			operation, module = labels(method, nil)
+		}
+
+		c.RecordRequest(method, operation, module)
+		startTime := time.Now()
+
+		// Call the RPC method
+		err := handler(srv, stream)
+
+		// Record response time
+		duration := time.Since(startTime)
+		c.RecordResponseTime(method, operation, module, duration)
+
+		// Record error if any
+		if err != nil {
+			c.RecordError(method, operation, module, status.Code(err).String())
+		}
+
+		return err
+	}
+}
@@ -0,0 +1,104 @@
+package numerics
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// Settings of Ridders' method
+const (
+	// MaxOrder is the highest order of derivative
+	MaxOrder = 2
+	// maxRiddersSteps limits the extrapolation steps, after which the step
+	// size is too small for further steps to help
+	maxRiddersSteps = 20
+	// riddersShrink is the factor the step size shrinks by in each step
+	riddersShrink = 1.4
+	// riddersSafe stops the extrapolation when the error grows by this
+	// factor over the best estimate
+	riddersSafe = 2
+)
+
+// Derivative returns the derivative of the given order, 1 or 2, of f at x
+// with Ridders' method: central differences with shrinking step sizes are
+// extrapolated to a step size of zero. Each iteration shrinks the step,
+// taking at most 20 iterations; the method stops early once the error
+// estimate meets the tolerance or starts to grow.
+func Derivative(ctx context.Context, f Func, x float64, order int, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	if err := checkPoint("x", x); err != nil {
+		return Result{}, err
+	}
+	if order < 1 || order > MaxOrder {
+		return Result{}, &calc.FieldError{Field: "order", Err: fmt.Errorf("%w: order must be from 1 to %d", calc.ErrInvalidInput, MaxOrder)}
+	}
+
+	fn := &counter{f: f, field: "expression"}
+	var fx float64
+	if order == 2 {
+		if fx, err = fn.eval(ctx, x); err != nil {
+			return Result{}, err
+		}
+	}
+	difference := func(h float64) (float64, error) {
+		above, err := fn.eval(ctx, x+h)
+		if err != nil {
+			return 0, err
+		}
+		below, err := fn.eval(ctx, x-h)
+		if err != nil {
+			return 0, err
+		}
+		if order == 1 {
+			return (above - below) / (2 * h), nil
+		}
+		return (above - 2*fx + below) / (h * h), nil
+	}
+
+	// table[j] holds the estimates extrapolated j times from the previous
+	// step, and is overwritten by those of the current step
+	h := 0.1 * math.Max(1, math.Abs(x))
+	first, err := difference(h)
+	if err != nil {
+		return Result{}, err
+	}
+	table := []float64{first}
+	best, errorEstimate := first, math.Inf(1)
+	steps := min(opts.MaxIterations, maxRiddersSteps)
+	for i := 1; i <= steps; i++ {
+		h /= riddersShrink
+		estimate, err := difference(h)
+		if err != nil {
+			return Result{}, err
+		}
+
+		// Both differences have errors in even powers of h, so each
+		// extrapolation removes the leading power
+		next := []float64{estimate}
+		factor := riddersShrink * riddersShrink
+		for j := 1; j <= i; j++ {
+			next = append(next, (next[j-1]*factor-table[j-1])/(factor-1))
+			factor *= riddersShrink * riddersShrink
+			change := math.Max(math.Abs(next[j]-next[j-1]), math.Abs(next[j]-table[j-1]))
+			if change <= errorEstimate {
+				best, errorEstimate = next[j], change
+			}
+		}
+
+		if opts.converged(errorEstimate, best) {
+			return Result{Value: best, ErrorEstimate: errorEstimate, Iterations: i, Evaluations: fn.n}, nil
+		}
+		if math.Abs(next[i]-table[i-1]) >= riddersSafe*errorEstimate {
+			// Rounding errors dominate at smaller steps
+			return Result{}, &calc.ConvergenceError{Iterations: i, Estimate: best, Residual: errorEstimate}
+		}
+		table = next
+	}
+	return Result{}, &calc.ConvergenceError{Iterations: steps, Estimate: best, Residual: errorEstimate}
+}
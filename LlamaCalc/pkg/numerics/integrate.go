@@ -0,0 +1,256 @@
+package numerics
+
+import (
+	"container/heap"
+	"context"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// maxSimpsonDepth limits how often adaptive Simpson halves an interval
+const maxSimpsonDepth = 50
+
+// AdaptiveSimpson integrates f from lower to upper with the adaptive
+// Simpson rule. Each iteration halves an interval whose two halves disagree
+// with it by more than the tolerance allows; the result includes Richardson
+// extrapolation of each pair of halves.
+func AdaptiveSimpson(ctx context.Context, f Func, lower, upper float64, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	if err := checkInterval(lower, upper); err != nil {
+		return Result{}, err
+	}
+	if lower == upper {
+		return Result{}, nil
+	}
+	lower, upper, sign := orient(lower, upper)
+
+	s := &simpson{ctx: ctx, fn: &counter{f: f, field: "expression"}, opts: opts}
+	fa, err := s.fn.eval(ctx, lower)
+	if err != nil {
+		return Result{}, err
+	}
+	fm, err := s.fn.eval(ctx, lower+(upper-lower)/2)
+	if err != nil {
+		return Result{}, err
+	}
+	fb, err := s.fn.eval(ctx, upper)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// The tolerance is relative to the magnitude of the first estimate
+	whole := (upper - lower) / 6 * (fa + 4*fm + fb)
+	tol := opts.Tolerance * math.Max(1, math.Abs(whole))
+	value, errorEstimate, err := s.integrate(lower, upper, fa, fm, fb, whole, tol, 0)
+	if err != nil {
+		return Result{}, err
+	}
+	if s.exhausted {
+		return Result{}, &calc.ConvergenceError{Iterations: s.iterations, Estimate: sign * value, Residual: errorEstimate}
+	}
+	return Result{Value: sign * value, ErrorEstimate: errorEstimate, Iterations: s.iterations, Evaluations: s.fn.n}, nil
+}
+
+// simpson is the state of an adaptive Simpson integration
+type simpson struct {
+	ctx  context.Context
+	fn   *counter
+	opts Options
+	// iterations counts the intervals halved so far
+	iterations int
+	// exhausted is set when an interval could not be halved any more
+	// because of the iteration limit or depth limit
+	exhausted bool
+}
+
+// integrate returns the integral over [a, b] and its error estimate, given
+// f at a, the midpoint and b, and the Simpson estimate whole of the
+// interval
+func (s *simpson) integrate(a, b, fa, fm, fb, whole, tol float64, depth int) (float64, float64, error) {
+	m := a + (b-a)/2
+	lm, rm := a+(m-a)/2, m+(b-m)/2
+	flm, err := s.fn.eval(s.ctx, lm)
+	if err != nil {
+		return 0, 0, err
+	}
+	frm, err := s.fn.eval(s.ctx, rm)
+	if err != nil {
+		return 0, 0, err
+	}
+	left := (m - a) / 6 * (fa + 4*flm + fm)
+	right := (b - m) / 6 * (fm + 4*frm + fb)
+
+	// The halves are 15 times more accurate than the whole, so their
+	// difference estimates the error of the halves 15 times over
+	delta := left + right - whole
+	if math.Abs(delta) <= 15*tol {
+		return left + right + delta/15, math.Abs(delta) / 15, nil
+	}
+	if depth >= maxSimpsonDepth || s.iterations >= s.opts.MaxIterations || lm <= a || rm >= b {
+		s.exhausted = true
+		return left + right + delta/15, math.Abs(delta) / 15, nil
+	}
+
+	s.iterations++
+	leftValue, leftError, err := s.integrate(a, m, fa, flm, fm, left, tol/2, depth+1)
+	if err != nil {
+		return 0, 0, err
+	}
+	rightValue, rightError, err := s.integrate(m, b, fm, frm, fb, right, tol/2, depth+1)
+	if err != nil {
+		return 0, 0, err
+	}
+	return leftValue + rightValue, leftError + rightError, nil
+}
+
+// Nodes and weights of the 7-point Gauss and 15-point Kronrod rules on
+// [-1, 1]. The Kronrod nodes are listed from 1 towards the center, which is
+// the last; the Gauss nodes are every other Kronrod node.
+var (
+	kronrodNodes = [8]float64{
+		0.991455371120812639206854697526329,
+		0.949107912342758524526189684047851,
+		0.864864423359769072789712788640926,
+		0.741531185599394439863864773280788,
+		0.586087235467691130294144845693013,
+		0.405845151377397166906606412076961,
+		0.207784955007898467600689403773245,
+		0,
+	}
+	kronrodWeights = [8]float64{
+		0.022935322010529224963732008058970,
+		0.063092092629978553290700663189204,
+		0.104790010322250183839876322541518,
+		0.140653259715525918745189590510238,
+		0.169004726639267902826583426598550,
+		0.190350578064785409913256402421014,
+		0.204432940075298892414161999234649,
+		0.209482141084727828012999174891714,
+	}
+	gaussWeights = [4]float64{
+		0.129484966168869693270611432679082,
+		0.279705391489276667901467771423780,
+		0.381830050505118944950369775488975,
+		0.417959183673469387755102040816327,
+	}
+)
+
+// GaussKronrod integrates f from lower to upper with the adaptive 15-point
+// Gauss-Kronrod rule. Each iteration halves the interval with the largest
+// error estimate, which is the difference between the Kronrod and embedded
+// 7-point Gauss rules, until the estimates add up to the tolerance.
+func GaussKronrod(ctx context.Context, f Func, lower, upper float64, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	if err := checkInterval(lower, upper); err != nil {
+		return Result{}, err
+	}
+	if lower == upper {
+		return Result{}, nil
+	}
+	lower, upper, sign := orient(lower, upper)
+
+	fn := &counter{f: f, field: "expression"}
+	first, err := kronrod(ctx, fn, lower, upper)
+	if err != nil {
+		return Result{}, err
+	}
+	intervals := &intervalHeap{first}
+	value, errorEstimate := first.value, first.err
+
+	for i := 0; ; i++ {
+		if opts.converged(errorEstimate, value) {
+			return Result{Value: sign * value, ErrorEstimate: errorEstimate, Iterations: i, Evaluations: fn.n}, nil
+		}
+		worst := (*intervals)[0]
+		m := worst.a + (worst.b-worst.a)/2
+		if i == opts.MaxIterations || m <= worst.a || m >= worst.b {
+			return Result{}, &calc.ConvergenceError{Iterations: i, Estimate: sign * value, Residual: errorEstimate}
+		}
+
+		left, err := kronrod(ctx, fn, worst.a, m)
+		if err != nil {
+			return Result{}, err
+		}
+		right, err := kronrod(ctx, fn, m, worst.b)
+		if err != nil {
+			return Result{}, err
+		}
+		heap.Pop(intervals)
+		heap.Push(intervals, left)
+		heap.Push(intervals, right)
+		value += left.value + right.value - worst.value
+		errorEstimate += left.err + right.err - worst.err
+	}
+}
+
+// orient returns the bounds of an interval in increasing order, and -1 if
+// they were swapped so that the integral changes sign, or 1 if not
+func orient(lower, upper float64) (float64, float64, float64) {
+	if lower > upper {
+		return upper, lower, -1
+	}
+	return lower, upper, 1
+}
+
+// interval is a subinterval of a Gauss-Kronrod integration with its
+// integral and error estimate
+type interval struct {
+	a, b       float64
+	value, err float64
+}
+
+// kronrod applies the 15-point Gauss-Kronrod rule to f over [a, b]
+func kronrod(ctx context.Context, fn *counter, a, b float64) (interval, error) {
+	center, half := a+(b-a)/2, (b-a)/2
+	fc, err := fn.eval(ctx, center)
+	if err != nil {
+		return interval{}, err
+	}
+	k := kronrodWeights[7] * fc
+	g := gaussWeights[3] * fc
+	for i, node := range kronrodNodes[:7] {
+		above, err := fn.eval(ctx, center+half*node)
+		if err != nil {
+			return interval{}, err
+		}
+		below, err := fn.eval(ctx, center-half*node)
+		if err != nil {
+			return interval{}, err
+		}
+		k += kronrodWeights[i] * (above + below)
+		if i%2 == 1 {
+			g += gaussWeights[i/2] * (above + below)
+		}
+	}
+	return interval{a: a, b: b, value: k * half, err: math.Abs((k - g) * half)}, nil
+}
+
+// intervalHeap orders intervals by decreasing error estimate
+type intervalHeap []interval
+
+// Len implements heap.Interface
+func (h intervalHeap) Len() int { return len(h) }
+
+// Less implements heap.Interface
+func (h intervalHeap) Less(i, j int) bool { return h[i].err > h[j].err }
+
+// Swap implements heap.Interface
+func (h intervalHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Push implements heap.Interface
+func (h *intervalHeap) Push(x any) { *h = append(*h, x.(interval)) }
+
+// Pop implements heap.Interface
+func (h *intervalHeap) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
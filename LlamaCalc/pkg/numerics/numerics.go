@@ -0,0 +1,128 @@
+// Package numerics implements numerical methods on real functions of one
+// variable: root finding, integration and differentiation.
+//
+// Functions are usually expressions parsed with calc.Calculator.Function.
+// Every method checks the context between function evaluations, so a
+// cancelled request stops a long computation. Methods that do not reach the
+// requested tolerance within their iteration limit fail with a
+// *calc.ConvergenceError carrying the last estimate, whose Residual is the
+// error estimate for integrals and derivatives; other errors use the
+// sentinels of package calc.
+package numerics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// Func is a real function of one variable. It fails with the context error
+// once ctx is done.
+type Func func(ctx context.Context, x float64) (float64, error)
+
+// Defaults and limits of Options
+const (
+	// DefaultTolerance is the tolerance used if none is given
+	DefaultTolerance = 1e-10
+	// DefaultMaxIterations is the iteration limit used if none is given
+	DefaultMaxIterations = 1000
+	// MaxIterations limits the iterations a caller may ask for
+	MaxIterations = 100000
+)
+
+// Options control when an iterative method stops
+type Options struct {
+	// Tolerance is the accuracy wanted: a method has converged when its
+	// error estimate is at most Tolerance*max(1, |value|), so it is an
+	// absolute tolerance for small values and a relative one for large
+	// values. Zero means DefaultTolerance.
+	Tolerance float64
+	// MaxIterations limits the iterations of the method, see each method
+	// for what an iteration is. Zero means DefaultMaxIterations.
+	MaxIterations int
+}
+
+// withDefaults validates the options and fills in the defaults
+func (o Options) withDefaults() (Options, error) {
+	if o.Tolerance == 0 {
+		o.Tolerance = DefaultTolerance
+	}
+	if math.IsNaN(o.Tolerance) || math.IsInf(o.Tolerance, 0) || o.Tolerance < 0 {
+		return Options{}, &calc.FieldError{Field: "tolerance", Err: fmt.Errorf("%w: tolerance must be a positive number", calc.ErrInvalidInput)}
+	}
+	if o.MaxIterations == 0 {
+		o.MaxIterations = DefaultMaxIterations
+	}
+	if o.MaxIterations < 0 || o.MaxIterations > MaxIterations {
+		return Options{}, &calc.FieldError{Field: "max_iterations", Err: fmt.Errorf("%w: max_iterations must be at most %d", calc.ErrInvalidInput, MaxIterations)}
+	}
+	return o, nil
+}
+
+// converged tells whether an error estimate for value meets the tolerance
+func (o Options) converged(errorEstimate, value float64) bool {
+	return errorEstimate <= o.Tolerance*math.Max(1, math.Abs(value))
+}
+
+// Result is the result of a numerical method
+type Result struct {
+	Value float64
+	// ErrorEstimate estimates the absolute error of Value
+	ErrorEstimate float64
+	// Residual is the value of the function at a root; it is 0 for
+	// integrals and derivatives
+	Residual float64
+	// Iterations is the number of iterations performed
+	Iterations int
+	// Evaluations is the number of function evaluations
+	Evaluations int
+}
+
+// epsilon is the machine epsilon of float64
+const epsilon = 0x1p-52
+
+// counter evaluates a function, counting the evaluations
+type counter struct {
+	f Func
+	// field is the input field errors of f are reported for
+	field string
+	n     int
+}
+
+// eval returns f(x), which must be finite
+func (c *counter) eval(ctx context.Context, x float64) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	c.n++
+	y, err := c.f(ctx, x)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+			return 0, err
+		}
+		return 0, &calc.FieldError{Field: c.field, Err: err}
+	}
+	if math.IsNaN(y) || math.IsInf(y, 0) {
+		return 0, &calc.FieldError{Field: c.field, Err: fmt.Errorf("%w: the function is not finite at %g", calc.ErrDomain, x)}
+	}
+	return y, nil
+}
+
+// checkPoint validates a finite input such as a bound of an interval
+func checkPoint(field string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return &calc.FieldError{Field: field, Err: fmt.Errorf("%w: %s must be a finite number", calc.ErrInvalidInput, field)}
+	}
+	return nil
+}
+
+// checkInterval validates the bounds of an interval
+func checkInterval(lower, upper float64) error {
+	if err := checkPoint("lower", lower); err != nil {
+		return err
+	}
+	return checkPoint("upper", upper)
+}
@@ -0,0 +1,172 @@
+package numerics_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/numerics"
+)
+
+// method applies a numerical method to a function
+type method func(ctx context.Context, f numerics.Func) (numerics.Result, error)
+
+// referenceCase is a result known in closed form
+type referenceCase struct {
+	Name       string
+	Expression string
+	Method     method
+	Want       float64
+}
+
+// interval applies a method on an interval
+func interval(fn func(context.Context, numerics.Func, float64, float64, numerics.Options) (numerics.Result, error), lower, upper float64) method {
+	return func(ctx context.Context, f numerics.Func) (numerics.Result, error) {
+		return fn(ctx, f, lower, upper, numerics.Options{})
+	}
+}
+
+// derivative differentiates at x
+func derivative(x float64, order int) method {
+	return func(ctx context.Context, f numerics.Func) (numerics.Result, error) {
+		return numerics.Derivative(ctx, f, x, order, numerics.Options{})
+	}
+}
+
+var referenceCases = []referenceCase{
+	{"bisection of x^2 - 2", "x^2 - 2", interval(numerics.Bisection, 0, 2), math.Sqrt2},
+	{"Brent of x^2 - 2", "x^2 - 2", interval(numerics.Brent, 2, 0), math.Sqrt2},
+	{"Brent of cos(x) - x", "cos(x) - x", interval(numerics.Brent, 0, 1), 0.7390851332151607},
+	{"Brent of a root at a bound", "x - 1", interval(numerics.Brent, 1, 3), 1},
+	{"Newton of x^3 - 2x - 5", "x^3 - 2*x - 5", func(ctx context.Context, f numerics.Func) (numerics.Result, error) {
+		return numerics.Newton(ctx, f, nil, 2, numerics.Options{})
+	}, 2.0945514815423265},
+	{"Simpson of sin(x)", "sin(x)", interval(numerics.AdaptiveSimpson, 0, math.Pi), 2},
+	{"Simpson of sqrt(x)", "sqrt(x)", interval(numerics.AdaptiveSimpson, 0, 1), 2.0 / 3},
+	{"Gauss-Kronrod of exp(-x^2)", "exp(-x^2)", interval(numerics.GaussKronrod, -3, 3), math.Sqrt(math.Pi) * math.Erf(3)},
+	{"Gauss-Kronrod backwards", "1/x", interval(numerics.GaussKronrod, 2, 1), -math.Ln2},
+	{"Gauss-Kronrod of sqrt(x)", "sqrt(x)", interval(numerics.GaussKronrod, 0, 1), 2.0 / 3},
+	{"derivative of sin(x)", "sin(x)", derivative(1, 1), math.Cos(1)},
+	{"second derivative of sin(x)", "sin(x)", derivative(1, 2), -math.Sin(1)},
+	{"derivative of exp(x)", "exp(x)", derivative(20, 1), math.Exp(20)},
+}
+
+func TestReferenceCases(t *testing.T) {
+	engine := calc.NewDefaultCalculator()
+	for _, tc := range referenceCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			f, err := engine.Function(tc.Expression, "x")
+			if err != nil {
+				t.Fatalf("Function(%q): %v", tc.Expression, err)
+			}
+			result, err := tc.Method(context.Background(), f)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// The error estimates are conservative, so the result must be
+			// within them and within the default tolerance
+			tolerance := numerics.DefaultTolerance * math.Max(1, math.Abs(tc.Want))
+			if diff := math.Abs(result.Value - tc.Want); diff > tolerance || diff > 10*result.ErrorEstimate+1e-15 {
+				t.Errorf("got %.17g ± %.3g, want %.17g", result.Value, result.ErrorEstimate, tc.Want)
+			}
+			if result.Evaluations == 0 {
+				t.Error("evaluations were not counted")
+			}
+		})
+	}
+}
+
+func TestNotConverged(t *testing.T) {
+	engine := calc.NewDefaultCalculator()
+	f, err := engine.Function("t^2 + 1", "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = numerics.Newton(context.Background(), f, nil, 1, numerics.Options{MaxIterations: 50})
+	var convergenceErr *calc.ConvergenceError
+	if !errors.As(err, &convergenceErr) || convergenceErr.Iterations != 50 {
+		t.Fatalf("got %v, want a ConvergenceError after 50 iterations", err)
+	}
+	if !errors.Is(err, calc.ErrNotConverged) {
+		t.Errorf("%v does not match ErrNotConverged", err)
+	}
+
+	if _, err := numerics.Brent(context.Background(), f, 0, 1, numerics.Options{}); !errors.Is(err, calc.ErrDomain) {
+		t.Errorf("Brent without a sign change: got %v, want ErrDomain", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	engine := calc.NewDefaultCalculator()
+	f, err := engine.Function("sqrt(x)", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name  string
+		fn    func() (numerics.Result, error)
+		field string
+		err   error
+	}{
+		{"outside the domain", func() (numerics.Result, error) {
+			return numerics.GaussKronrod(context.Background(), f, -1, 1, numerics.Options{})
+		}, "expression", calc.ErrDomain},
+		{"infinite bound", func() (numerics.Result, error) {
+			return numerics.AdaptiveSimpson(context.Background(), f, 0, math.Inf(1), numerics.Options{})
+		}, "upper", calc.ErrInvalidInput},
+		{"negative tolerance", func() (numerics.Result, error) {
+			return numerics.Bisection(context.Background(), f, 0, 1, numerics.Options{Tolerance: -1})
+		}, "tolerance", calc.ErrInvalidInput},
+		{"too many iterations", func() (numerics.Result, error) {
+			return numerics.Brent(context.Background(), f, 0, 1, numerics.Options{MaxIterations: numerics.MaxIterations + 1})
+		}, "max_iterations", calc.ErrInvalidInput},
+		{"third derivative", func() (numerics.Result, error) {
+			return numerics.Derivative(context.Background(), f, 1, 3, numerics.Options{})
+		}, "order", calc.ErrInvalidInput},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.fn()
+			var fieldErr *calc.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tc.field || !errors.Is(err, tc.err) {
+				t.Errorf("got %v, want %v for field %s", err, tc.err, tc.field)
+			}
+		})
+	}
+
+	if _, err := engine.Function("x^2", "pi"); !errors.Is(err, calc.ErrInvalidInput) {
+		t.Errorf("constant as variable: got %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	engine := calc.NewDefaultCalculator()
+	f, err := engine.Function("sin(1/x)", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Cancel once the integration is under way
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	evaluations := 0
+	g := func(ctx context.Context, x float64) (float64, error) {
+		if evaluations++; evaluations == 1000 {
+			cancel()
+		}
+		return f(ctx, x)
+	}
+
+	_, err = numerics.GaussKronrod(ctx, g, 1e-6, 1, numerics.Options{Tolerance: 1e-15, MaxIterations: numerics.MaxIterations})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+	if evaluations > 1000 {
+		t.Errorf("%d evaluations after cancellation", evaluations-1000)
+	}
+}
@@ -0,0 +1,226 @@
+package numerics
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"llamacalc/pkg/calc"
+)
+
+// bracket evaluates f at the bounds of an interval, which must contain a
+// sign change, and returns them in increasing order
+func bracket(ctx context.Context, f *counter, lower, upper float64) (a, fa, b, fb float64, err error) {
+	if err := checkInterval(lower, upper); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	a, b = math.Min(lower, upper), math.Max(lower, upper)
+	if fa, err = f.eval(ctx, a); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if fb, err = f.eval(ctx, b); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if fa != 0 && fb != 0 && (fa < 0) == (fb < 0) {
+		return 0, 0, 0, 0, fmt.Errorf("%w: the function must change sign between lower and upper (f(%g) = %g, f(%g) = %g)", calc.ErrDomain, a, fa, b, fb)
+	}
+	return a, fa, b, fb, nil
+}
+
+// Bisection finds a root of f between lower and upper, where f must change
+// sign, by halving the interval once per iteration. It is slow but always
+// converges, to a root or to a discontinuity where f changes sign.
+func Bisection(ctx context.Context, f Func, lower, upper float64, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	fn := &counter{f: f, field: "expression"}
+	a, fa, b, fb, err := bracket(ctx, fn, lower, upper)
+	if err != nil {
+		return Result{}, err
+	}
+	if fa == 0 {
+		return Result{Value: a, Evaluations: fn.n}, nil
+	}
+	if fb == 0 {
+		return Result{Value: b, Evaluations: fn.n}, nil
+	}
+
+	m, fm := a, fa
+	for i := 1; i <= opts.MaxIterations; i++ {
+		m = a + (b-a)/2
+		if fm, err = fn.eval(ctx, m); err != nil {
+			return Result{}, err
+		}
+		if fm == 0 {
+			return Result{Value: m, Residual: fm, Iterations: i, Evaluations: fn.n}, nil
+		}
+		if (fm < 0) == (fa < 0) {
+			a, fa = m, fm
+		} else {
+			b = m
+		}
+
+		// The root is within the new interval, which has m at one end; stop
+		// when it meets the tolerance or cannot be halved any more
+		if mid := a + (b-a)/2; opts.converged(b-a, m) || mid == a || mid == b {
+			return Result{Value: m, ErrorEstimate: b - a, Residual: fm, Iterations: i, Evaluations: fn.n}, nil
+		}
+	}
+	return Result{}, &calc.ConvergenceError{Iterations: opts.MaxIterations, Estimate: m, Residual: fm}
+}
+
+// Brent finds a root of f between lower and upper, where f must change
+// sign, with Brent's method: inverse quadratic interpolation and secant
+// steps, falling back to bisection whenever they would converge slowly.
+// Each iteration evaluates f once.
+func Brent(ctx context.Context, f Func, lower, upper float64, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	fn := &counter{f: f, field: "expression"}
+	a, fa, b, fb, err := bracket(ctx, fn, lower, upper)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// b is the best estimate, c the other end of the interval containing
+	// the root, a the previous estimate; d is the last step and e the one
+	// before
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for i := 1; i <= opts.MaxIterations; i++ {
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*epsilon*math.Abs(b) + opts.Tolerance*math.Max(1, math.Abs(b))/2
+		half := (c - b) / 2
+		if fb == 0 {
+			return Result{Value: b, Iterations: i - 1, Evaluations: fn.n}, nil
+		}
+		if math.Abs(half) <= tol {
+			return Result{Value: b, ErrorEstimate: math.Abs(c - b), Residual: fb, Iterations: i - 1, Evaluations: fn.n}, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// Interpolate: secant if only two points are known, otherwise
+			// inverse quadratic
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * half * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*half*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*half*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = half
+				e = d
+			}
+		} else {
+			d = half
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, half)
+		}
+		if fb, err = fn.eval(ctx, b); err != nil {
+			return Result{}, err
+		}
+	}
+	return Result{}, &calc.ConvergenceError{Iterations: opts.MaxIterations, Estimate: b, Residual: fb}
+}
+
+// Newton finds a root of f near guess with Newton's method. The derivative
+// df is approximated with central differences if it is nil. Each iteration
+// takes one Newton step; the method fails if it meets a zero derivative or
+// leaves the domain of f.
+func Newton(ctx context.Context, f, df Func, guess float64, opts Options) (Result, error) {
+	opts, err := opts.withDefaults()
+	if err != nil {
+		return Result{}, err
+	}
+	if err := checkPoint("guess", guess); err != nil {
+		return Result{}, err
+	}
+	fn := &counter{f: f, field: "expression"}
+	dfn := &counter{f: df, field: "derivative"}
+	slope := func(x float64) (float64, error) {
+		if df != nil {
+			return dfn.eval(ctx, x)
+		}
+		return centralDifference(ctx, fn, x)
+	}
+
+	x := guess
+	y, err := fn.eval(ctx, x)
+	if err != nil {
+		return Result{}, err
+	}
+	for i := 1; i <= opts.MaxIterations; i++ {
+		if y == 0 {
+			return Result{Value: x, Iterations: i - 1, Evaluations: fn.n + dfn.n}, nil
+		}
+		dy, err := slope(x)
+		if err != nil {
+			return Result{}, err
+		}
+		if dy == 0 {
+			return Result{}, &calc.ConvergenceError{Iterations: i - 1, Estimate: x, Residual: y}
+		}
+
+		step := y / dy
+		next := x - step
+		if math.IsInf(next, 0) {
+			return Result{}, &calc.ConvergenceError{Iterations: i, Estimate: x, Residual: y}
+		}
+		if y, err = fn.eval(ctx, next); err != nil {
+			return Result{}, err
+		}
+		x = next
+		if opts.converged(math.Abs(step), x) {
+			return Result{Value: x, ErrorEstimate: math.Abs(step), Residual: y, Iterations: i, Evaluations: fn.n + dfn.n}, nil
+		}
+	}
+	return Result{}, &calc.ConvergenceError{Iterations: opts.MaxIterations, Estimate: x, Residual: y}
+}
+
+// centralDifference approximates the derivative of f at x with a step that
+// balances truncation and rounding errors
+func centralDifference(ctx context.Context, f *counter, x float64) (float64, error) {
+	h := math.Cbrt(epsilon) * math.Max(1, math.Abs(x))
+	above, err := f.eval(ctx, x+h)
+	if err != nil {
+		return 0, err
+	}
+	below, err := f.eval(ctx, x-h)
+	if err != nil {
+		return 0, err
+	}
+	// Use the step as represented after rounding x+h and x-h
+	return (above - below) / ((x + h) - (x - h)), nil
+}
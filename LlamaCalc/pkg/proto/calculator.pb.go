@@ -94,14 +94,16 @@ func (x *CalculationRequest) GetRole() string {
 	return ""
 }
 
-// Response message containing calculation result
+// Response message containing calculation result.
+// Failed calculations are reported as gRPC status errors instead, see
+// llamacalc.v1.ErrorKind.
 type CalculationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Result of the calculation
 	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
-	// Status code (0 = success, non-zero = error)
+	// Status code (always 0; kept for older clients)
 	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	// Error message (empty if success)
+	// Error message (always empty; kept for older clients)
 	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	// Operation performed
 	Operation string `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
@@ -201,7 +203,7 @@ var file_LlamaCalc_pkg_proto_calculator_proto_rawDesc = string([]byte{
 	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x32, 0xdc, 0x02, 0x0a, 0x0a, 0x43, 0x61,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x32, 0xe1, 0x02, 0x0a, 0x0a, 0x43, 0x61,
 	0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12,
 	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
@@ -223,9 +225,9 @@ var file_LlamaCalc_pkg_proto_calculator_proto_rawDesc = string([]byte{
 	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
 	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x6c, 0x6c, 0x61, 0x6d,
-	0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x01, 0x42, 0x15, 0x5a,
+	0x13, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
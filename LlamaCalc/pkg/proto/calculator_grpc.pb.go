@@ -30,7 +30,12 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// Calculator service for basic arithmetic operations
+// Calculator service for basic arithmetic operations.
+//
+// Deprecated: superseded by llamacalc.v1.Calculator. The server keeps serving
+// this service so that existing clients continue to work during migration.
+//
+// Deprecated: Do not use.
 type CalculatorClient interface {
 	// Add two numbers
 	Add(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
@@ -48,6 +53,7 @@ type calculatorClient struct {
 	cc grpc.ClientConnInterface
 }
 
+// Deprecated: Do not use.
 func NewCalculatorClient(cc grpc.ClientConnInterface) CalculatorClient {
 	return &calculatorClient{cc}
 }
@@ -106,7 +112,12 @@ func (c *calculatorClient) Health(ctx context.Context, in *HealthCheckRequest, o
 // All implementations must embed UnimplementedCalculatorServer
 // for forward compatibility.
 //
-// Calculator service for basic arithmetic operations
+// Calculator service for basic arithmetic operations.
+//
+// Deprecated: superseded by llamacalc.v1.Calculator. The server keeps serving
+// this service so that existing clients continue to work during migration.
+//
+// Deprecated: Do not use.
 type CalculatorServer interface {
 	// Add two numbers
 	Add(context.Context, *CalculationRequest) (*CalculationResponse, error)
@@ -153,6 +164,7 @@ type UnsafeCalculatorServer interface {
 	mustEmbedUnimplementedCalculatorServer()
 }
 
+// Deprecated: Do not use.
 func RegisterCalculatorServer(s grpc.ServiceRegistrar, srv CalculatorServer) {
 	// If the following call pancis, it indicates UnimplementedCalculatorServer was
 	// embedded by pointer and is nil.  This will cause panics if an
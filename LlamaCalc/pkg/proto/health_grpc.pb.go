@@ -26,7 +26,11 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// Health check service for gRPC
+// Health check service for gRPC.
+//
+// Deprecated: use the standard grpc.health.v1.Health service.
+//
+// Deprecated: Do not use.
 type HealthServiceClient interface {
 	// Health check RPC
 	Health(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
@@ -36,6 +40,7 @@ type healthServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
+// Deprecated: Do not use.
 func NewHealthServiceClient(cc grpc.ClientConnInterface) HealthServiceClient {
 	return &healthServiceClient{cc}
 }
@@ -54,7 +59,11 @@ func (c *healthServiceClient) Health(ctx context.Context, in *HealthCheckRequest
 // All implementations must embed UnimplementedHealthServiceServer
 // for forward compatibility.
 //
-// Health check service for gRPC
+// Health check service for gRPC.
+//
+// Deprecated: use the standard grpc.health.v1.Health service.
+//
+// Deprecated: Do not use.
 type HealthServiceServer interface {
 	// Health check RPC
 	Health(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
@@ -81,6 +90,7 @@ type UnsafeHealthServiceServer interface {
 	mustEmbedUnimplementedHealthServiceServer()
 }
 
+// Deprecated: Do not use.
 func RegisterHealthServiceServer(s grpc.ServiceRegistrar, srv HealthServiceServer) {
 	// If the following call pancis, it indicates UnimplementedHealthServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
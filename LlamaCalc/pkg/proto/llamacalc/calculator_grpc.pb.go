@@ -1,10 +1,10 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v5.29.3
-// source: proto/calculator.proto
+// - protoc             (unknown)
+// source: LlamaCalc/pkg/proto/llamacalc/calculator.proto
 
-package proto
+package llamacalc
 
 import (
 	context "context"
@@ -19,32 +19,41 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Calculator_Add_FullMethodName      = "/calculator.Calculator/Add"
-	Calculator_Subtract_FullMethodName = "/calculator.Calculator/Subtract"
-	Calculator_Multiply_FullMethodName = "/calculator.Calculator/Multiply"
-	Calculator_Divide_FullMethodName   = "/calculator.Calculator/Divide"
+	Calculator_Add_FullMethodName      = "/llamacalc.Calculator/Add"
+	Calculator_Subtract_FullMethodName = "/llamacalc.Calculator/Subtract"
+	Calculator_Multiply_FullMethodName = "/llamacalc.Calculator/Multiply"
+	Calculator_Divide_FullMethodName   = "/llamacalc.Calculator/Divide"
+	Calculator_Health_FullMethodName   = "/llamacalc.Calculator/Health"
 )
 
 // CalculatorClient is the client API for Calculator service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// LlamaCalc service definition
+// Calculator service of the first LlamaCalc API.
+//
+// Deprecated: superseded by llamacalc.v1.Calculator. The server keeps serving
+// this service so that existing clients continue to work during migration.
+//
+// Deprecated: Do not use.
 type CalculatorClient interface {
-	// Add operation - adds two numbers
+	// Add operation
 	Add(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
-	// Subtract operation - subtracts second number from first
+	// Subtract operation
 	Subtract(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
-	// Multiply operation - multiplies two numbers
+	// Multiply operation
 	Multiply(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
-	// Divide operation - divides first number by second
+	// Divide operation
 	Divide(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Health check
+	Health(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
 type calculatorClient struct {
 	cc grpc.ClientConnInterface
 }
 
+// Deprecated: Do not use.
 func NewCalculatorClient(cc grpc.ClientConnInterface) CalculatorClient {
 	return &calculatorClient{cc}
 }
@@ -89,20 +98,37 @@ func (c *calculatorClient) Divide(ctx context.Context, in *CalculationRequest, o
 	return out, nil
 }
 
+func (c *calculatorClient) Health(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, Calculator_Health_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CalculatorServer is the server API for Calculator service.
 // All implementations must embed UnimplementedCalculatorServer
 // for forward compatibility.
 //
-// LlamaCalc service definition
+// Calculator service of the first LlamaCalc API.
+//
+// Deprecated: superseded by llamacalc.v1.Calculator. The server keeps serving
+// this service so that existing clients continue to work during migration.
+//
+// Deprecated: Do not use.
 type CalculatorServer interface {
-	// Add operation - adds two numbers
+	// Add operation
 	Add(context.Context, *CalculationRequest) (*CalculationResponse, error)
-	// Subtract operation - subtracts second number from first
+	// Subtract operation
 	Subtract(context.Context, *CalculationRequest) (*CalculationResponse, error)
-	// Multiply operation - multiplies two numbers
+	// Multiply operation
 	Multiply(context.Context, *CalculationRequest) (*CalculationResponse, error)
-	// Divide operation - divides first number by second
+	// Divide operation
 	Divide(context.Context, *CalculationRequest) (*CalculationResponse, error)
+	// Health check
+	Health(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedCalculatorServer()
 }
 
@@ -125,6 +151,9 @@ func (UnimplementedCalculatorServer) Multiply(context.Context, *CalculationReque
 func (UnimplementedCalculatorServer) Divide(context.Context, *CalculationRequest) (*CalculationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
 }
+func (UnimplementedCalculatorServer) Health(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedCalculatorServer) mustEmbedUnimplementedCalculatorServer() {}
 func (UnimplementedCalculatorServer) testEmbeddedByValue()                    {}
 
@@ -135,6 +164,7 @@ type UnsafeCalculatorServer interface {
 	mustEmbedUnimplementedCalculatorServer()
 }
 
+// Deprecated: Do not use.
 func RegisterCalculatorServer(s grpc.ServiceRegistrar, srv CalculatorServer) {
 	// If the following call pancis, it indicates UnimplementedCalculatorServer was
 	// embedded by pointer and is nil.  This will cause panics if an
@@ -218,11 +248,29 @@ func _Calculator_Divide_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Calculator_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Health_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Health(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Calculator_ServiceDesc is the grpc.ServiceDesc for Calculator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Calculator_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "calculator.Calculator",
+	ServiceName: "llamacalc.Calculator",
 	HandlerType: (*CalculatorServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -241,7 +289,11 @@ var Calculator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Divide",
 			Handler:    _Calculator_Divide_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Calculator_Health_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/calculator.proto",
+	Metadata: "LlamaCalc/pkg/proto/llamacalc/calculator.proto",
 }
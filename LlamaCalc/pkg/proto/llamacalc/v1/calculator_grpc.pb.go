@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/calculator.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Calculator_Add_FullMethodName               = "/llamacalc.v1.Calculator/Add"
+	Calculator_Subtract_FullMethodName          = "/llamacalc.v1.Calculator/Subtract"
+	Calculator_Multiply_FullMethodName          = "/llamacalc.v1.Calculator/Multiply"
+	Calculator_Divide_FullMethodName            = "/llamacalc.v1.Calculator/Divide"
+	Calculator_Invoke_FullMethodName            = "/llamacalc.v1.Calculator/Invoke"
+	Calculator_Evaluate_FullMethodName          = "/llamacalc.v1.Calculator/Evaluate"
+	Calculator_ComplexCalculate_FullMethodName  = "/llamacalc.v1.Calculator/ComplexCalculate"
+	Calculator_RationalCalculate_FullMethodName = "/llamacalc.v1.Calculator/RationalCalculate"
+	Calculator_IntegerCalculate_FullMethodName  = "/llamacalc.v1.Calculator/IntegerCalculate"
+	Calculator_Statistics_FullMethodName        = "/llamacalc.v1.Calculator/Statistics"
+	Calculator_StatisticsStream_FullMethodName  = "/llamacalc.v1.Calculator/StatisticsStream"
+	Calculator_Fit_FullMethodName               = "/llamacalc.v1.Calculator/Fit"
+	Calculator_Convert_FullMethodName           = "/llamacalc.v1.Calculator/Convert"
+	Calculator_MoneyCalculate_FullMethodName    = "/llamacalc.v1.Calculator/MoneyCalculate"
+	Calculator_ConvertCurrency_FullMethodName   = "/llamacalc.v1.Calculator/ConvertCurrency"
+)
+
+// CalculatorClient is the client API for Calculator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Calculator service for basic arithmetic operations.
+//
+// Health is reported through the standard grpc.health.v1.Health service.
+type CalculatorClient interface {
+	// Add two numbers
+	Add(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Subtract second number from first
+	Subtract(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Multiply two numbers
+	Multiply(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Divide first number by second
+	Divide(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Invoke any registered operation by name, including custom operations
+	Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Evaluate an arithmetic expression such as "2 * sin(pi / 4)"
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Perform a registered operation on complex numbers
+	ComplexCalculate(ctx context.Context, in *ComplexRequest, opts ...grpc.CallOption) (*ComplexResponse, error)
+	// Perform a registered operation exactly on fractions such as "1/3"
+	RationalCalculate(ctx context.Context, in *RationalRequest, opts ...grpc.CallOption) (*RationalResponse, error)
+	// Perform a registered operation on integers of any size, such as
+	// "ModPow" or "Factorize"
+	IntegerCalculate(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerResponse, error)
+	// Compute descriptive statistics of a dataset
+	Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	// Compute descriptive statistics of a dataset sent in chunks, in bounded
+	// memory; the median and percentiles are estimated for large datasets
+	StatisticsStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StatisticsRequest, StatisticsResponse], error)
+	// Fit a model to data by least squares
+	Fit(ctx context.Context, in *FitRequest, opts ...grpc.CallOption) (*FitResponse, error)
+	// Convert a value between units of the same dimension, e.g. mi to km
+	Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*CalculationResponse, error)
+	// Perform an exact operation on amounts of money, e.g. Allocate
+	MoneyCalculate(ctx context.Context, in *MoneyRequest, opts ...grpc.CallOption) (*MoneyResponse, error)
+	// Convert an amount of money with the loaded exchange-rate table
+	ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*ConvertCurrencyResponse, error)
+}
+
+type calculatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalculatorClient(cc grpc.ClientConnInterface) CalculatorClient {
+	return &calculatorClient{cc}
+}
+
+func (c *calculatorClient) Add(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Add_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Subtract(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Subtract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Multiply(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Multiply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Divide(ctx context.Context, in *CalculationRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Divide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Invoke(ctx context.Context, in *InvokeRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Invoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ComplexCalculate(ctx context.Context, in *ComplexRequest, opts ...grpc.CallOption) (*ComplexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ComplexResponse)
+	err := c.cc.Invoke(ctx, Calculator_ComplexCalculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) RationalCalculate(ctx context.Context, in *RationalRequest, opts ...grpc.CallOption) (*RationalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RationalResponse)
+	err := c.cc.Invoke(ctx, Calculator_RationalCalculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) IntegerCalculate(ctx context.Context, in *IntegerRequest, opts ...grpc.CallOption) (*IntegerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntegerResponse)
+	err := c.cc.Invoke(ctx, Calculator_IntegerCalculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Statistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatisticsResponse)
+	err := c.cc.Invoke(ctx, Calculator_Statistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) StatisticsStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StatisticsRequest, StatisticsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Calculator_ServiceDesc.Streams[0], Calculator_StatisticsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatisticsRequest, StatisticsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Calculator_StatisticsStreamClient = grpc.ClientStreamingClient[StatisticsRequest, StatisticsResponse]
+
+func (c *calculatorClient) Fit(ctx context.Context, in *FitRequest, opts ...grpc.CallOption) (*FitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FitResponse)
+	err := c.cc.Invoke(ctx, Calculator_Fit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Convert(ctx context.Context, in *ConvertRequest, opts ...grpc.CallOption) (*CalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculationResponse)
+	err := c.cc.Invoke(ctx, Calculator_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) MoneyCalculate(ctx context.Context, in *MoneyRequest, opts ...grpc.CallOption) (*MoneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoneyResponse)
+	err := c.cc.Invoke(ctx, Calculator_MoneyCalculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ConvertCurrency(ctx context.Context, in *ConvertCurrencyRequest, opts ...grpc.CallOption) (*ConvertCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertCurrencyResponse)
+	err := c.cc.Invoke(ctx, Calculator_ConvertCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalculatorServer is the server API for Calculator service.
+// All implementations must embed UnimplementedCalculatorServer
+// for forward compatibility.
+//
+// Calculator service for basic arithmetic operations.
+//
+// Health is reported through the standard grpc.health.v1.Health service.
+type CalculatorServer interface {
+	// Add two numbers
+	Add(context.Context, *CalculationRequest) (*CalculationResponse, error)
+	// Subtract second number from first
+	Subtract(context.Context, *CalculationRequest) (*CalculationResponse, error)
+	// Multiply two numbers
+	Multiply(context.Context, *CalculationRequest) (*CalculationResponse, error)
+	// Divide first number by second
+	Divide(context.Context, *CalculationRequest) (*CalculationResponse, error)
+	// Invoke any registered operation by name, including custom operations
+	Invoke(context.Context, *InvokeRequest) (*CalculationResponse, error)
+	// Evaluate an arithmetic expression such as "2 * sin(pi / 4)"
+	Evaluate(context.Context, *EvaluateRequest) (*CalculationResponse, error)
+	// Perform a registered operation on complex numbers
+	ComplexCalculate(context.Context, *ComplexRequest) (*ComplexResponse, error)
+	// Perform a registered operation exactly on fractions such as "1/3"
+	RationalCalculate(context.Context, *RationalRequest) (*RationalResponse, error)
+	// Perform a registered operation on integers of any size, such as
+	// "ModPow" or "Factorize"
+	IntegerCalculate(context.Context, *IntegerRequest) (*IntegerResponse, error)
+	// Compute descriptive statistics of a dataset
+	Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
+	// Compute descriptive statistics of a dataset sent in chunks, in bounded
+	// memory; the median and percentiles are estimated for large datasets
+	StatisticsStream(grpc.ClientStreamingServer[StatisticsRequest, StatisticsResponse]) error
+	// Fit a model to data by least squares
+	Fit(context.Context, *FitRequest) (*FitResponse, error)
+	// Convert a value between units of the same dimension, e.g. mi to km
+	Convert(context.Context, *ConvertRequest) (*CalculationResponse, error)
+	// Perform an exact operation on amounts of money, e.g. Allocate
+	MoneyCalculate(context.Context, *MoneyRequest) (*MoneyResponse, error)
+	// Convert an amount of money with the loaded exchange-rate table
+	ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*ConvertCurrencyResponse, error)
+	mustEmbedUnimplementedCalculatorServer()
+}
+
+// UnimplementedCalculatorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCalculatorServer struct{}
+
+func (UnimplementedCalculatorServer) Add(context.Context, *CalculationRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedCalculatorServer) Subtract(context.Context, *CalculationRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (UnimplementedCalculatorServer) Multiply(context.Context, *CalculationRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (UnimplementedCalculatorServer) Divide(context.Context, *CalculationRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (UnimplementedCalculatorServer) Invoke(context.Context, *InvokeRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedCalculatorServer) Evaluate(context.Context, *EvaluateRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedCalculatorServer) ComplexCalculate(context.Context, *ComplexRequest) (*ComplexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComplexCalculate not implemented")
+}
+func (UnimplementedCalculatorServer) RationalCalculate(context.Context, *RationalRequest) (*RationalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RationalCalculate not implemented")
+}
+func (UnimplementedCalculatorServer) IntegerCalculate(context.Context, *IntegerRequest) (*IntegerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegerCalculate not implemented")
+}
+func (UnimplementedCalculatorServer) Statistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Statistics not implemented")
+}
+func (UnimplementedCalculatorServer) StatisticsStream(grpc.ClientStreamingServer[StatisticsRequest, StatisticsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StatisticsStream not implemented")
+}
+func (UnimplementedCalculatorServer) Fit(context.Context, *FitRequest) (*FitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fit not implemented")
+}
+func (UnimplementedCalculatorServer) Convert(context.Context, *ConvertRequest) (*CalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedCalculatorServer) MoneyCalculate(context.Context, *MoneyRequest) (*MoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoneyCalculate not implemented")
+}
+func (UnimplementedCalculatorServer) ConvertCurrency(context.Context, *ConvertCurrencyRequest) (*ConvertCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCurrency not implemented")
+}
+func (UnimplementedCalculatorServer) mustEmbedUnimplementedCalculatorServer() {}
+func (UnimplementedCalculatorServer) testEmbeddedByValue()                    {}
+
+// UnsafeCalculatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalculatorServer will
+// result in compilation errors.
+type UnsafeCalculatorServer interface {
+	mustEmbedUnimplementedCalculatorServer()
+}
+
+func RegisterCalculatorServer(s grpc.ServiceRegistrar, srv CalculatorServer) {
+	// If the following call pancis, it indicates UnimplementedCalculatorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Calculator_ServiceDesc, srv)
+}
+
+func _Calculator_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Add_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Add(ctx, req.(*CalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Subtract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Subtract(ctx, req.(*CalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Multiply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Multiply(ctx, req.(*CalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Divide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Divide(ctx, req.(*CalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Invoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Invoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Invoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Invoke(ctx, req.(*InvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ComplexCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComplexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ComplexCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_ComplexCalculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ComplexCalculate(ctx, req.(*ComplexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_RationalCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RationalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).RationalCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_RationalCalculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).RationalCalculate(ctx, req.(*RationalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_IntegerCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).IntegerCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_IntegerCalculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).IntegerCalculate(ctx, req.(*IntegerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Statistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Statistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Statistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Statistics(ctx, req.(*StatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_StatisticsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).StatisticsStream(&grpc.GenericServerStream[StatisticsRequest, StatisticsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Calculator_StatisticsStreamServer = grpc.ClientStreamingServer[StatisticsRequest, StatisticsResponse]
+
+func _Calculator_Fit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Fit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Fit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Fit(ctx, req.(*FitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Convert(ctx, req.(*ConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_MoneyCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).MoneyCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_MoneyCalculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).MoneyCalculate(ctx, req.(*MoneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ConvertCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ConvertCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Calculator_ConvertCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ConvertCurrency(ctx, req.(*ConvertCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Calculator_ServiceDesc is the grpc.ServiceDesc for Calculator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Calculator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Calculator",
+	HandlerType: (*CalculatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _Calculator_Add_Handler,
+		},
+		{
+			MethodName: "Subtract",
+			Handler:    _Calculator_Subtract_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Calculator_Multiply_Handler,
+		},
+		{
+			MethodName: "Divide",
+			Handler:    _Calculator_Divide_Handler,
+		},
+		{
+			MethodName: "Invoke",
+			Handler:    _Calculator_Invoke_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _Calculator_Evaluate_Handler,
+		},
+		{
+			MethodName: "ComplexCalculate",
+			Handler:    _Calculator_ComplexCalculate_Handler,
+		},
+		{
+			MethodName: "RationalCalculate",
+			Handler:    _Calculator_RationalCalculate_Handler,
+		},
+		{
+			MethodName: "IntegerCalculate",
+			Handler:    _Calculator_IntegerCalculate_Handler,
+		},
+		{
+			MethodName: "Statistics",
+			Handler:    _Calculator_Statistics_Handler,
+		},
+		{
+			MethodName: "Fit",
+			Handler:    _Calculator_Fit_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _Calculator_Convert_Handler,
+		},
+		{
+			MethodName: "MoneyCalculate",
+			Handler:    _Calculator_MoneyCalculate_Handler,
+		},
+		{
+			MethodName: "ConvertCurrency",
+			Handler:    _Calculator_ConvertCurrency_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StatisticsStream",
+			Handler:       _Calculator_StatisticsStream_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "llamacalc/v1/calculator.proto",
+}
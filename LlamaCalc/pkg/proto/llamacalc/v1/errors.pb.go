@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: llamacalc/v1/errors.proto
+
+package llamacalcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Kind of calculation error.
+//
+// Failed calls return a gRPC status error carrying a google.rpc.ErrorInfo
+// with domain "llamacalc" whose reason is the ErrorKind name without its
+// ERROR_KIND_ prefix, and a google.rpc.BadRequest listing the offending
+// fields when there are any.
+type ErrorKind int32
+
+const (
+	// Not a calculation error
+	ErrorKind_ERROR_KIND_UNSPECIFIED ErrorKind = 0
+	// An operand is NaN, infinite or otherwise not acceptable
+	ErrorKind_ERROR_KIND_INVALID_INPUT ErrorKind = 1
+	// The divisor is zero
+	ErrorKind_ERROR_KIND_DIVIDE_BY_ZERO ErrorKind = 2
+	// The result is too large to represent
+	ErrorKind_ERROR_KIND_OVERFLOW ErrorKind = 3
+	// The result is negative and too large in magnitude to represent, e.g.
+	// -1e308 * 10. Results too close to zero are rounded, not reported.
+	ErrorKind_ERROR_KIND_UNDERFLOW ErrorKind = 4
+	// The requested operation is not registered
+	ErrorKind_ERROR_KIND_UNKNOWN_OPERATION ErrorKind = 5
+	// An argument is outside the domain of the function, e.g. sqrt(-1)
+	ErrorKind_ERROR_KIND_DOMAIN ErrorKind = 6
+	// A matrix is singular or too ill-conditioned to invert; the ErrorInfo
+	// metadata "condition_number" holds an estimate of its condition number
+	ErrorKind_ERROR_KIND_SINGULAR_MATRIX ErrorKind = 7
+	// The units of the arguments have different dimensions, e.g. meters and
+	// seconds in an addition
+	ErrorKind_ERROR_KIND_INCOMPATIBLE_UNITS ErrorKind = 8
+	// Amounts of money in different currencies were combined
+	ErrorKind_ERROR_KIND_CURRENCY_MISMATCH ErrorKind = 9
+	// An iterative method such as IRR did not converge; the ErrorInfo
+	// metadata holds the "iterations", the last "estimate" and its "residual"
+	ErrorKind_ERROR_KIND_NOT_CONVERGED ErrorKind = 10
+)
+
+// Enum value maps for ErrorKind.
+var (
+	ErrorKind_name = map[int32]string{
+		0:  "ERROR_KIND_UNSPECIFIED",
+		1:  "ERROR_KIND_INVALID_INPUT",
+		2:  "ERROR_KIND_DIVIDE_BY_ZERO",
+		3:  "ERROR_KIND_OVERFLOW",
+		4:  "ERROR_KIND_UNDERFLOW",
+		5:  "ERROR_KIND_UNKNOWN_OPERATION",
+		6:  "ERROR_KIND_DOMAIN",
+		7:  "ERROR_KIND_SINGULAR_MATRIX",
+		8:  "ERROR_KIND_INCOMPATIBLE_UNITS",
+		9:  "ERROR_KIND_CURRENCY_MISMATCH",
+		10: "ERROR_KIND_NOT_CONVERGED",
+	}
+	ErrorKind_value = map[string]int32{
+		"ERROR_KIND_UNSPECIFIED":        0,
+		"ERROR_KIND_INVALID_INPUT":      1,
+		"ERROR_KIND_DIVIDE_BY_ZERO":     2,
+		"ERROR_KIND_OVERFLOW":           3,
+		"ERROR_KIND_UNDERFLOW":          4,
+		"ERROR_KIND_UNKNOWN_OPERATION":  5,
+		"ERROR_KIND_DOMAIN":             6,
+		"ERROR_KIND_SINGULAR_MATRIX":    7,
+		"ERROR_KIND_INCOMPATIBLE_UNITS": 8,
+		"ERROR_KIND_CURRENCY_MISMATCH":  9,
+		"ERROR_KIND_NOT_CONVERGED":      10,
+	}
+)
+
+func (x ErrorKind) Enum() *ErrorKind {
+	p := new(ErrorKind)
+	*p = x
+	return p
+}
+
+func (x ErrorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_llamacalc_v1_errors_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorKind) Type() protoreflect.EnumType {
+	return &file_llamacalc_v1_errors_proto_enumTypes[0]
+}
+
+func (x ErrorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorKind.Descriptor instead.
+func (ErrorKind) EnumDescriptor() ([]byte, []int) {
+	return file_llamacalc_v1_errors_proto_rawDescGZIP(), []int{0}
+}
+
+var File_llamacalc_v1_errors_proto protoreflect.FileDescriptor
+
+var file_llamacalc_v1_errors_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2a, 0xd3, 0x02, 0x0a, 0x09, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x5f, 0x42, 0x59, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f,
+	0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x46, 0x4c, 0x4f,
+	0x57, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x55,
+	0x4c, 0x41, 0x52, 0x5f, 0x4d, 0x41, 0x54, 0x52, 0x49, 0x58, 0x10, 0x07, 0x12, 0x21, 0x0a, 0x1d,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d,
+	0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x53, 0x10, 0x08, 0x12,
+	0x20, 0x0a, 0x1c, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x55,
+	0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10,
+	0x09, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x52, 0x47, 0x45, 0x44, 0x10, 0x0a, 0x42,
+	0x2e, 0x5a, 0x2c, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_llamacalc_v1_errors_proto_rawDescOnce sync.Once
+	file_llamacalc_v1_errors_proto_rawDescData []byte
+)
+
+func file_llamacalc_v1_errors_proto_rawDescGZIP() []byte {
+	file_llamacalc_v1_errors_proto_rawDescOnce.Do(func() {
+		file_llamacalc_v1_errors_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_llamacalc_v1_errors_proto_rawDesc), len(file_llamacalc_v1_errors_proto_rawDesc)))
+	})
+	return file_llamacalc_v1_errors_proto_rawDescData
+}
+
+var file_llamacalc_v1_errors_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_llamacalc_v1_errors_proto_goTypes = []any{
+	(ErrorKind)(0), // 0: llamacalc.v1.ErrorKind
+}
+var file_llamacalc_v1_errors_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_llamacalc_v1_errors_proto_init() }
+func file_llamacalc_v1_errors_proto_init() {
+	if File_llamacalc_v1_errors_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llamacalc_v1_errors_proto_rawDesc), len(file_llamacalc_v1_errors_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_llamacalc_v1_errors_proto_goTypes,
+		DependencyIndexes: file_llamacalc_v1_errors_proto_depIdxs,
+		EnumInfos:         file_llamacalc_v1_errors_proto_enumTypes,
+	}.Build()
+	File_llamacalc_v1_errors_proto = out.File
+	file_llamacalc_v1_errors_proto_goTypes = nil
+	file_llamacalc_v1_errors_proto_depIdxs = nil
+}
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/finance.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Finance_PresentValue_FullMethodName     = "/llamacalc.v1.Finance/PresentValue"
+	Finance_FutureValue_FullMethodName      = "/llamacalc.v1.Finance/FutureValue"
+	Finance_Payment_FullMethodName          = "/llamacalc.v1.Finance/Payment"
+	Finance_Periods_FullMethodName          = "/llamacalc.v1.Finance/Periods"
+	Finance_Rate_FullMethodName             = "/llamacalc.v1.Finance/Rate"
+	Finance_NetPresentValue_FullMethodName  = "/llamacalc.v1.Finance/NetPresentValue"
+	Finance_InternalRate_FullMethodName     = "/llamacalc.v1.Finance/InternalRate"
+	Finance_CompoundInterest_FullMethodName = "/llamacalc.v1.Finance/CompoundInterest"
+	Finance_Amortize_FullMethodName         = "/llamacalc.v1.Finance/Amortize"
+)
+
+// FinanceClient is the client API for Finance service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Finance service for spreadsheet-compatible financial functions.
+//
+// Numbers are decimal strings such as "-1000.50" and results are rounded to
+// the requested decimal places. Signs follow the spreadsheet convention:
+// money received is positive and money paid out is negative. Empty numbers
+// are 0. Failed calls are reported as gRPC status errors, see ErrorKind.
+type FinanceClient interface {
+	// Present value of a series of payments, like PV
+	PresentValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Future value of a series of payments, like FV
+	FutureValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Payment per period of a loan or annuity, like PMT
+	Payment(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Number of periods of a loan or annuity, like NPER
+	Periods(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Interest rate per period of a loan or annuity, like RATE
+	Rate(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Net present value of periodic cash flows, like NPV, or of dated cash
+	// flows, like XNPV
+	NetPresentValue(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Internal rate of return of periodic cash flows, like IRR, or of dated
+	// cash flows, like XIRR
+	InternalRate(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*FinanceResponse, error)
+	// Amount a principal grows to with compound interest
+	CompoundInterest(ctx context.Context, in *CompoundInterestRequest, opts ...grpc.CallOption) (*CompoundInterestResponse, error)
+	// Amortization schedule of a loan
+	Amortize(ctx context.Context, in *AmortizeRequest, opts ...grpc.CallOption) (*AmortizeResponse, error)
+}
+
+type financeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFinanceClient(cc grpc.ClientConnInterface) FinanceClient {
+	return &financeClient{cc}
+}
+
+func (c *financeClient) PresentValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_PresentValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) FutureValue(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_FutureValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) Payment(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_Payment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) Periods(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_Periods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) Rate(ctx context.Context, in *TimeValueRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_Rate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) NetPresentValue(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_NetPresentValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) InternalRate(ctx context.Context, in *CashFlowRequest, opts ...grpc.CallOption) (*FinanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinanceResponse)
+	err := c.cc.Invoke(ctx, Finance_InternalRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) CompoundInterest(ctx context.Context, in *CompoundInterestRequest, opts ...grpc.CallOption) (*CompoundInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompoundInterestResponse)
+	err := c.cc.Invoke(ctx, Finance_CompoundInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *financeClient) Amortize(ctx context.Context, in *AmortizeRequest, opts ...grpc.CallOption) (*AmortizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmortizeResponse)
+	err := c.cc.Invoke(ctx, Finance_Amortize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FinanceServer is the server API for Finance service.
+// All implementations must embed UnimplementedFinanceServer
+// for forward compatibility.
+//
+// Finance service for spreadsheet-compatible financial functions.
+//
+// Numbers are decimal strings such as "-1000.50" and results are rounded to
+// the requested decimal places. Signs follow the spreadsheet convention:
+// money received is positive and money paid out is negative. Empty numbers
+// are 0. Failed calls are reported as gRPC status errors, see ErrorKind.
+type FinanceServer interface {
+	// Present value of a series of payments, like PV
+	PresentValue(context.Context, *TimeValueRequest) (*FinanceResponse, error)
+	// Future value of a series of payments, like FV
+	FutureValue(context.Context, *TimeValueRequest) (*FinanceResponse, error)
+	// Payment per period of a loan or annuity, like PMT
+	Payment(context.Context, *TimeValueRequest) (*FinanceResponse, error)
+	// Number of periods of a loan or annuity, like NPER
+	Periods(context.Context, *TimeValueRequest) (*FinanceResponse, error)
+	// Interest rate per period of a loan or annuity, like RATE
+	Rate(context.Context, *TimeValueRequest) (*FinanceResponse, error)
+	// Net present value of periodic cash flows, like NPV, or of dated cash
+	// flows, like XNPV
+	NetPresentValue(context.Context, *CashFlowRequest) (*FinanceResponse, error)
+	// Internal rate of return of periodic cash flows, like IRR, or of dated
+	// cash flows, like XIRR
+	InternalRate(context.Context, *CashFlowRequest) (*FinanceResponse, error)
+	// Amount a principal grows to with compound interest
+	CompoundInterest(context.Context, *CompoundInterestRequest) (*CompoundInterestResponse, error)
+	// Amortization schedule of a loan
+	Amortize(context.Context, *AmortizeRequest) (*AmortizeResponse, error)
+	mustEmbedUnimplementedFinanceServer()
+}
+
+// UnimplementedFinanceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFinanceServer struct{}
+
+func (UnimplementedFinanceServer) PresentValue(context.Context, *TimeValueRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresentValue not implemented")
+}
+func (UnimplementedFinanceServer) FutureValue(context.Context, *TimeValueRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FutureValue not implemented")
+}
+func (UnimplementedFinanceServer) Payment(context.Context, *TimeValueRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payment not implemented")
+}
+func (UnimplementedFinanceServer) Periods(context.Context, *TimeValueRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Periods not implemented")
+}
+func (UnimplementedFinanceServer) Rate(context.Context, *TimeValueRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
+}
+func (UnimplementedFinanceServer) NetPresentValue(context.Context, *CashFlowRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetPresentValue not implemented")
+}
+func (UnimplementedFinanceServer) InternalRate(context.Context, *CashFlowRequest) (*FinanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InternalRate not implemented")
+}
+func (UnimplementedFinanceServer) CompoundInterest(context.Context, *CompoundInterestRequest) (*CompoundInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompoundInterest not implemented")
+}
+func (UnimplementedFinanceServer) Amortize(context.Context, *AmortizeRequest) (*AmortizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Amortize not implemented")
+}
+func (UnimplementedFinanceServer) mustEmbedUnimplementedFinanceServer() {}
+func (UnimplementedFinanceServer) testEmbeddedByValue()                 {}
+
+// UnsafeFinanceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FinanceServer will
+// result in compilation errors.
+type UnsafeFinanceServer interface {
+	mustEmbedUnimplementedFinanceServer()
+}
+
+func RegisterFinanceServer(s grpc.ServiceRegistrar, srv FinanceServer) {
+	// If the following call pancis, it indicates UnimplementedFinanceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Finance_ServiceDesc, srv)
+}
+
+func _Finance_PresentValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).PresentValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_PresentValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).PresentValue(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_FutureValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).FutureValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_FutureValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).FutureValue(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_Payment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).Payment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_Payment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).Payment(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_Periods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).Periods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_Periods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).Periods(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_Rate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).Rate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_Rate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).Rate(ctx, req.(*TimeValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_NetPresentValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).NetPresentValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_NetPresentValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).NetPresentValue(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_InternalRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).InternalRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_InternalRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).InternalRate(ctx, req.(*CashFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_CompoundInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompoundInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).CompoundInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_CompoundInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).CompoundInterest(ctx, req.(*CompoundInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Finance_Amortize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmortizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinanceServer).Amortize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Finance_Amortize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinanceServer).Amortize(ctx, req.(*AmortizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Finance_ServiceDesc is the grpc.ServiceDesc for Finance service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Finance_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Finance",
+	HandlerType: (*FinanceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PresentValue",
+			Handler:    _Finance_PresentValue_Handler,
+		},
+		{
+			MethodName: "FutureValue",
+			Handler:    _Finance_FutureValue_Handler,
+		},
+		{
+			MethodName: "Payment",
+			Handler:    _Finance_Payment_Handler,
+		},
+		{
+			MethodName: "Periods",
+			Handler:    _Finance_Periods_Handler,
+		},
+		{
+			MethodName: "Rate",
+			Handler:    _Finance_Rate_Handler,
+		},
+		{
+			MethodName: "NetPresentValue",
+			Handler:    _Finance_NetPresentValue_Handler,
+		},
+		{
+			MethodName: "InternalRate",
+			Handler:    _Finance_InternalRate_Handler,
+		},
+		{
+			MethodName: "CompoundInterest",
+			Handler:    _Finance_CompoundInterest_Handler,
+		},
+		{
+			MethodName: "Amortize",
+			Handler:    _Finance_Amortize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/finance.proto",
+}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/library.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Library_DefineFunction_FullMethodName   = "/llamacalc.v1.Library/DefineFunction"
+	Library_DefineConstant_FullMethodName   = "/llamacalc.v1.Library/DefineConstant"
+	Library_DeleteDefinition_FullMethodName = "/llamacalc.v1.Library/DeleteDefinition"
+	Library_ListDefinitions_FullMethodName  = "/llamacalc.v1.Library/ListDefinitions"
+)
+
+// LibraryClient is the client API for Library service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Library service for user-defined functions and constants.
+//
+// Definitions belong to the tenant of the caller, which is the tenant claim
+// of its token or the organization of its certificate, or else the caller
+// alone. Expressions evaluated by the tenant can call its functions like
+// registered operations and use its constants like pi. Calling a definition
+// requires its role and the roles of the operations it uses; defining one
+// requires its role too. Every change increments the library version; a
+// change with a base_version other than the current one is ABORTED.
+type LibraryClient interface {
+	// Define or replace a function, e.g. "margin(p, c) = (p - c)/p"
+	DefineFunction(ctx context.Context, in *DefineFunctionRequest, opts ...grpc.CallOption) (*DefinitionResponse, error)
+	// Define or replace a constant
+	DefineConstant(ctx context.Context, in *DefineConstantRequest, opts ...grpc.CallOption) (*DefinitionResponse, error)
+	// Delete a definition that no other definition uses
+	DeleteDefinition(ctx context.Context, in *DeleteDefinitionRequest, opts ...grpc.CallOption) (*DeleteDefinitionResponse, error)
+	// List the definitions of the tenant
+	ListDefinitions(ctx context.Context, in *ListDefinitionsRequest, opts ...grpc.CallOption) (*ListDefinitionsResponse, error)
+}
+
+type libraryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLibraryClient(cc grpc.ClientConnInterface) LibraryClient {
+	return &libraryClient{cc}
+}
+
+func (c *libraryClient) DefineFunction(ctx context.Context, in *DefineFunctionRequest, opts ...grpc.CallOption) (*DefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefinitionResponse)
+	err := c.cc.Invoke(ctx, Library_DefineFunction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DefineConstant(ctx context.Context, in *DefineConstantRequest, opts ...grpc.CallOption) (*DefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefinitionResponse)
+	err := c.cc.Invoke(ctx, Library_DefineConstant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) DeleteDefinition(ctx context.Context, in *DeleteDefinitionRequest, opts ...grpc.CallOption) (*DeleteDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDefinitionResponse)
+	err := c.cc.Invoke(ctx, Library_DeleteDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) ListDefinitions(ctx context.Context, in *ListDefinitionsRequest, opts ...grpc.CallOption) (*ListDefinitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDefinitionsResponse)
+	err := c.cc.Invoke(ctx, Library_ListDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LibraryServer is the server API for Library service.
+// All implementations must embed UnimplementedLibraryServer
+// for forward compatibility.
+//
+// Library service for user-defined functions and constants.
+//
+// Definitions belong to the tenant of the caller, which is the tenant claim
+// of its token or the organization of its certificate, or else the caller
+// alone. Expressions evaluated by the tenant can call its functions like
+// registered operations and use its constants like pi. Calling a definition
+// requires its role and the roles of the operations it uses; defining one
+// requires its role too. Every change increments the library version; a
+// change with a base_version other than the current one is ABORTED.
+type LibraryServer interface {
+	// Define or replace a function, e.g. "margin(p, c) = (p - c)/p"
+	DefineFunction(context.Context, *DefineFunctionRequest) (*DefinitionResponse, error)
+	// Define or replace a constant
+	DefineConstant(context.Context, *DefineConstantRequest) (*DefinitionResponse, error)
+	// Delete a definition that no other definition uses
+	DeleteDefinition(context.Context, *DeleteDefinitionRequest) (*DeleteDefinitionResponse, error)
+	// List the definitions of the tenant
+	ListDefinitions(context.Context, *ListDefinitionsRequest) (*ListDefinitionsResponse, error)
+	mustEmbedUnimplementedLibraryServer()
+}
+
+// UnimplementedLibraryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLibraryServer struct{}
+
+func (UnimplementedLibraryServer) DefineFunction(context.Context, *DefineFunctionRequest) (*DefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineFunction not implemented")
+}
+func (UnimplementedLibraryServer) DefineConstant(context.Context, *DefineConstantRequest) (*DefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineConstant not implemented")
+}
+func (UnimplementedLibraryServer) DeleteDefinition(context.Context, *DeleteDefinitionRequest) (*DeleteDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDefinition not implemented")
+}
+func (UnimplementedLibraryServer) ListDefinitions(context.Context, *ListDefinitionsRequest) (*ListDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDefinitions not implemented")
+}
+func (UnimplementedLibraryServer) mustEmbedUnimplementedLibraryServer() {}
+func (UnimplementedLibraryServer) testEmbeddedByValue()                 {}
+
+// UnsafeLibraryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LibraryServer will
+// result in compilation errors.
+type UnsafeLibraryServer interface {
+	mustEmbedUnimplementedLibraryServer()
+}
+
+func RegisterLibraryServer(s grpc.ServiceRegistrar, srv LibraryServer) {
+	// If the following call pancis, it indicates UnimplementedLibraryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Library_ServiceDesc, srv)
+}
+
+func _Library_DefineFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DefineFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DefineFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DefineFunction(ctx, req.(*DefineFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DefineConstant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineConstantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DefineConstant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DefineConstant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DefineConstant(ctx, req.(*DefineConstantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_DeleteDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).DeleteDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_DeleteDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).DeleteDefinition(ctx, req.(*DeleteDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_ListDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDefinitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).ListDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_ListDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).ListDefinitions(ctx, req.(*ListDefinitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Library_ServiceDesc is the grpc.ServiceDesc for Library service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Library_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Library",
+	HandlerType: (*LibraryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DefineFunction",
+			Handler:    _Library_DefineFunction_Handler,
+		},
+		{
+			MethodName: "DefineConstant",
+			Handler:    _Library_DefineConstant_Handler,
+		},
+		{
+			MethodName: "DeleteDefinition",
+			Handler:    _Library_DeleteDefinition_Handler,
+		},
+		{
+			MethodName: "ListDefinitions",
+			Handler:    _Library_ListDefinitions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/library.proto",
+}
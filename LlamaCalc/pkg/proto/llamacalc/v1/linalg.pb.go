@@ -0,0 +1,829 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: llamacalc/v1/linalg.proto
+
+package llamacalcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Element-wise operation
+type ElementwiseOperation int32
+
+const (
+	// Not a valid operation
+	ElementwiseOperation_ELEMENTWISE_OPERATION_UNSPECIFIED ElementwiseOperation = 0
+	// a + b
+	ElementwiseOperation_ELEMENTWISE_OPERATION_ADD ElementwiseOperation = 1
+	// a - b
+	ElementwiseOperation_ELEMENTWISE_OPERATION_SUBTRACT ElementwiseOperation = 2
+	// Hadamard product
+	ElementwiseOperation_ELEMENTWISE_OPERATION_MULTIPLY ElementwiseOperation = 3
+	// Element-wise quotient
+	ElementwiseOperation_ELEMENTWISE_OPERATION_DIVIDE ElementwiseOperation = 4
+)
+
+// Enum value maps for ElementwiseOperation.
+var (
+	ElementwiseOperation_name = map[int32]string{
+		0: "ELEMENTWISE_OPERATION_UNSPECIFIED",
+		1: "ELEMENTWISE_OPERATION_ADD",
+		2: "ELEMENTWISE_OPERATION_SUBTRACT",
+		3: "ELEMENTWISE_OPERATION_MULTIPLY",
+		4: "ELEMENTWISE_OPERATION_DIVIDE",
+	}
+	ElementwiseOperation_value = map[string]int32{
+		"ELEMENTWISE_OPERATION_UNSPECIFIED": 0,
+		"ELEMENTWISE_OPERATION_ADD":         1,
+		"ELEMENTWISE_OPERATION_SUBTRACT":    2,
+		"ELEMENTWISE_OPERATION_MULTIPLY":    3,
+		"ELEMENTWISE_OPERATION_DIVIDE":      4,
+	}
+)
+
+func (x ElementwiseOperation) Enum() *ElementwiseOperation {
+	p := new(ElementwiseOperation)
+	*p = x
+	return p
+}
+
+func (x ElementwiseOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElementwiseOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_llamacalc_v1_linalg_proto_enumTypes[0].Descriptor()
+}
+
+func (ElementwiseOperation) Type() protoreflect.EnumType {
+	return &file_llamacalc_v1_linalg_proto_enumTypes[0]
+}
+
+func (x ElementwiseOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElementwiseOperation.Descriptor instead.
+func (ElementwiseOperation) EnumDescriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{0}
+}
+
+// Dense matrix in row-major order. Vectors are matrices with one column.
+type Matrix struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of rows
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Number of columns
+	Cols uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
+	// Elements; there must be exactly rows * cols of them
+	Data          []float64 `protobuf:"fixed64,3,rep,packed,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Matrix) Reset() {
+	*x = Matrix{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Matrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Matrix) ProtoMessage() {}
+
+func (x *Matrix) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Matrix.ProtoReflect.Descriptor instead.
+func (*Matrix) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Matrix) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Matrix) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *Matrix) GetData() []float64 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request message with a single matrix
+type MatrixRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operand
+	Matrix *Matrix `protobuf:"bytes,1,opt,name=matrix,proto3" json:"matrix,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixRequest) Reset() {
+	*x = MatrixRequest{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixRequest) ProtoMessage() {}
+
+func (x *MatrixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixRequest.ProtoReflect.Descriptor instead.
+func (*MatrixRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatrixRequest) GetMatrix() *Matrix {
+	if x != nil {
+		return x.Matrix
+	}
+	return nil
+}
+
+func (x *MatrixRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message with two matrices
+type MatrixPairRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Left operand, or the coefficients for Solve
+	A *Matrix `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	// Right operand, or the right-hand sides for Solve, one per column
+	B *Matrix `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixPairRequest) Reset() {
+	*x = MatrixPairRequest{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixPairRequest) ProtoMessage() {}
+
+func (x *MatrixPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixPairRequest.ProtoReflect.Descriptor instead.
+func (*MatrixPairRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MatrixPairRequest) GetA() *Matrix {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *MatrixPairRequest) GetB() *Matrix {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *MatrixPairRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for an element-wise operation
+type ElementwiseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operation to apply
+	Operation ElementwiseOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=llamacalc.v1.ElementwiseOperation" json:"operation,omitempty"`
+	// Left operand
+	A *Matrix `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
+	// Right operand
+	B *Matrix `protobuf:"bytes,3,opt,name=b,proto3" json:"b,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElementwiseRequest) Reset() {
+	*x = ElementwiseRequest{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElementwiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElementwiseRequest) ProtoMessage() {}
+
+func (x *ElementwiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElementwiseRequest.ProtoReflect.Descriptor instead.
+func (*ElementwiseRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ElementwiseRequest) GetOperation() ElementwiseOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return ElementwiseOperation_ELEMENTWISE_OPERATION_UNSPECIFIED
+}
+
+func (x *ElementwiseRequest) GetA() *Matrix {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *ElementwiseRequest) GetB() *Matrix {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *ElementwiseRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Response message containing a matrix
+type MatrixResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Result of the operation
+	Result *Matrix `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// 1-norm condition number of the operand, for Inverse and Solve
+	ConditionNumber float64 `protobuf:"fixed64,2,opt,name=condition_number,json=conditionNumber,proto3" json:"condition_number,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,3,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatrixResponse) Reset() {
+	*x = MatrixResponse{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatrixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatrixResponse) ProtoMessage() {}
+
+func (x *MatrixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatrixResponse.ProtoReflect.Descriptor instead.
+func (*MatrixResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MatrixResponse) GetResult() *Matrix {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *MatrixResponse) GetConditionNumber() float64 {
+	if x != nil {
+		return x.ConditionNumber
+	}
+	return 0
+}
+
+func (x *MatrixResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+// Response message containing a determinant
+type DeterminantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Determinant of the matrix
+	Determinant float64 `protobuf:"fixed64,1,opt,name=determinant,proto3" json:"determinant,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,2,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeterminantResponse) Reset() {
+	*x = DeterminantResponse{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeterminantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeterminantResponse) ProtoMessage() {}
+
+func (x *DeterminantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeterminantResponse.ProtoReflect.Descriptor instead.
+func (*DeterminantResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeterminantResponse) GetDeterminant() float64 {
+	if x != nil {
+		return x.Determinant
+	}
+	return 0
+}
+
+func (x *DeterminantResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+// Response message containing an LU decomposition P*A = L*U
+type LUResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit lower triangular factor
+	L *Matrix `protobuf:"bytes,1,opt,name=l,proto3" json:"l,omitempty"`
+	// Upper triangular factor
+	U *Matrix `protobuf:"bytes,2,opt,name=u,proto3" json:"u,omitempty"`
+	// Row of A at each row of P*A
+	Pivot []uint32 `protobuf:"varint,3,rep,packed,name=pivot,proto3" json:"pivot,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,4,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LUResponse) Reset() {
+	*x = LUResponse{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LUResponse) ProtoMessage() {}
+
+func (x *LUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LUResponse.ProtoReflect.Descriptor instead.
+func (*LUResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LUResponse) GetL() *Matrix {
+	if x != nil {
+		return x.L
+	}
+	return nil
+}
+
+func (x *LUResponse) GetU() *Matrix {
+	if x != nil {
+		return x.U
+	}
+	return nil
+}
+
+func (x *LUResponse) GetPivot() []uint32 {
+	if x != nil {
+		return x.Pivot
+	}
+	return nil
+}
+
+func (x *LUResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+// Response message containing a QR decomposition A = Q*R
+type QRResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Orthogonal factor
+	Q *Matrix `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// Upper triangular factor
+	R *Matrix `protobuf:"bytes,2,opt,name=r,proto3" json:"r,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,3,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QRResponse) Reset() {
+	*x = QRResponse{}
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QRResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QRResponse) ProtoMessage() {}
+
+func (x *QRResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_linalg_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QRResponse.ProtoReflect.Descriptor instead.
+func (*QRResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_linalg_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QRResponse) GetQ() *Matrix {
+	if x != nil {
+		return x.Q
+	}
+	return nil
+}
+
+func (x *QRResponse) GetR() *Matrix {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *QRResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+var File_llamacalc_v1_linalg_proto protoreflect.FileDescriptor
+
+var file_llamacalc_v1_linalg_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x69, 0x6e, 0x61, 0x6c, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x22, 0x44, 0x0a, 0x06, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xc1, 0x01, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x6d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x12,
+	0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x01, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01, 0x61, 0x12, 0x22, 0x0a,
+	0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61,
+	0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01,
+	0x62, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x77, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x40, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x77, 0x69, 0x73, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x01, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x52, 0x01, 0x61, 0x12, 0x22, 0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01, 0x62, 0x12, 0x4a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6c,
+	0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x77, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73,
+	0x22, 0x58, 0x0a, 0x13, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x4c,
+	0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x01, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01, 0x6c, 0x12, 0x22, 0x0a,
+	0x01, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61,
+	0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01,
+	0x75, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x05, 0x70, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x22, 0x75, 0x0a, 0x0a, 0x51, 0x52, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01, 0x71, 0x12, 0x22, 0x0a, 0x01, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x01, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x2a,
+	0xc6, 0x01, 0x0a, 0x14, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x77, 0x69, 0x73, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x4c, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54,
+	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x57, 0x49, 0x53,
+	0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x10, 0x04, 0x32, 0xea, 0x04, 0x0a, 0x0d, 0x4c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x41, 0x6c, 0x67, 0x65, 0x62, 0x72, 0x61, 0x12, 0x4f, 0x0a, 0x0b, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x77, 0x69, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x6c, 0x6c, 0x61, 0x6d,
+	0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x77, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6c,
+	0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x79, 0x12, 0x1f, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63,
+	0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61,
+	0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0b, 0x44, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x1b,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6c,
+	0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x44,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x4c, 0x55, 0x12, 0x1b, 0x2e, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63,
+	0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x55, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65,
+	0x51, 0x52, 0x12, 0x1b, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x52, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x05, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x12, 0x1f, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x72, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63,
+	0x61, 0x6c, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_llamacalc_v1_linalg_proto_rawDescOnce sync.Once
+	file_llamacalc_v1_linalg_proto_rawDescData []byte
+)
+
+func file_llamacalc_v1_linalg_proto_rawDescGZIP() []byte {
+	file_llamacalc_v1_linalg_proto_rawDescOnce.Do(func() {
+		file_llamacalc_v1_linalg_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_llamacalc_v1_linalg_proto_rawDesc), len(file_llamacalc_v1_linalg_proto_rawDesc)))
+	})
+	return file_llamacalc_v1_linalg_proto_rawDescData
+}
+
+var file_llamacalc_v1_linalg_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_llamacalc_v1_linalg_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_llamacalc_v1_linalg_proto_goTypes = []any{
+	(ElementwiseOperation)(0),   // 0: llamacalc.v1.ElementwiseOperation
+	(*Matrix)(nil),              // 1: llamacalc.v1.Matrix
+	(*MatrixRequest)(nil),       // 2: llamacalc.v1.MatrixRequest
+	(*MatrixPairRequest)(nil),   // 3: llamacalc.v1.MatrixPairRequest
+	(*ElementwiseRequest)(nil),  // 4: llamacalc.v1.ElementwiseRequest
+	(*MatrixResponse)(nil),      // 5: llamacalc.v1.MatrixResponse
+	(*DeterminantResponse)(nil), // 6: llamacalc.v1.DeterminantResponse
+	(*LUResponse)(nil),          // 7: llamacalc.v1.LUResponse
+	(*QRResponse)(nil),          // 8: llamacalc.v1.QRResponse
+	nil,                         // 9: llamacalc.v1.MatrixRequest.MetadataEntry
+	nil,                         // 10: llamacalc.v1.MatrixPairRequest.MetadataEntry
+	nil,                         // 11: llamacalc.v1.ElementwiseRequest.MetadataEntry
+}
+var file_llamacalc_v1_linalg_proto_depIdxs = []int32{
+	1,  // 0: llamacalc.v1.MatrixRequest.matrix:type_name -> llamacalc.v1.Matrix
+	9,  // 1: llamacalc.v1.MatrixRequest.metadata:type_name -> llamacalc.v1.MatrixRequest.MetadataEntry
+	1,  // 2: llamacalc.v1.MatrixPairRequest.a:type_name -> llamacalc.v1.Matrix
+	1,  // 3: llamacalc.v1.MatrixPairRequest.b:type_name -> llamacalc.v1.Matrix
+	10, // 4: llamacalc.v1.MatrixPairRequest.metadata:type_name -> llamacalc.v1.MatrixPairRequest.MetadataEntry
+	0,  // 5: llamacalc.v1.ElementwiseRequest.operation:type_name -> llamacalc.v1.ElementwiseOperation
+	1,  // 6: llamacalc.v1.ElementwiseRequest.a:type_name -> llamacalc.v1.Matrix
+	1,  // 7: llamacalc.v1.ElementwiseRequest.b:type_name -> llamacalc.v1.Matrix
+	11, // 8: llamacalc.v1.ElementwiseRequest.metadata:type_name -> llamacalc.v1.ElementwiseRequest.MetadataEntry
+	1,  // 9: llamacalc.v1.MatrixResponse.result:type_name -> llamacalc.v1.Matrix
+	1,  // 10: llamacalc.v1.LUResponse.l:type_name -> llamacalc.v1.Matrix
+	1,  // 11: llamacalc.v1.LUResponse.u:type_name -> llamacalc.v1.Matrix
+	1,  // 12: llamacalc.v1.QRResponse.q:type_name -> llamacalc.v1.Matrix
+	1,  // 13: llamacalc.v1.QRResponse.r:type_name -> llamacalc.v1.Matrix
+	4,  // 14: llamacalc.v1.LinearAlgebra.Elementwise:input_type -> llamacalc.v1.ElementwiseRequest
+	3,  // 15: llamacalc.v1.LinearAlgebra.Multiply:input_type -> llamacalc.v1.MatrixPairRequest
+	2,  // 16: llamacalc.v1.LinearAlgebra.Transpose:input_type -> llamacalc.v1.MatrixRequest
+	2,  // 17: llamacalc.v1.LinearAlgebra.Determinant:input_type -> llamacalc.v1.MatrixRequest
+	2,  // 18: llamacalc.v1.LinearAlgebra.Inverse:input_type -> llamacalc.v1.MatrixRequest
+	2,  // 19: llamacalc.v1.LinearAlgebra.DecomposeLU:input_type -> llamacalc.v1.MatrixRequest
+	2,  // 20: llamacalc.v1.LinearAlgebra.DecomposeQR:input_type -> llamacalc.v1.MatrixRequest
+	3,  // 21: llamacalc.v1.LinearAlgebra.Solve:input_type -> llamacalc.v1.MatrixPairRequest
+	5,  // 22: llamacalc.v1.LinearAlgebra.Elementwise:output_type -> llamacalc.v1.MatrixResponse
+	5,  // 23: llamacalc.v1.LinearAlgebra.Multiply:output_type -> llamacalc.v1.MatrixResponse
+	5,  // 24: llamacalc.v1.LinearAlgebra.Transpose:output_type -> llamacalc.v1.MatrixResponse
+	6,  // 25: llamacalc.v1.LinearAlgebra.Determinant:output_type -> llamacalc.v1.DeterminantResponse
+	5,  // 26: llamacalc.v1.LinearAlgebra.Inverse:output_type -> llamacalc.v1.MatrixResponse
+	7,  // 27: llamacalc.v1.LinearAlgebra.DecomposeLU:output_type -> llamacalc.v1.LUResponse
+	8,  // 28: llamacalc.v1.LinearAlgebra.DecomposeQR:output_type -> llamacalc.v1.QRResponse
+	5,  // 29: llamacalc.v1.LinearAlgebra.Solve:output_type -> llamacalc.v1.MatrixResponse
+	22, // [22:30] is the sub-list for method output_type
+	14, // [14:22] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_llamacalc_v1_linalg_proto_init() }
+func file_llamacalc_v1_linalg_proto_init() {
+	if File_llamacalc_v1_linalg_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llamacalc_v1_linalg_proto_rawDesc), len(file_llamacalc_v1_linalg_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_llamacalc_v1_linalg_proto_goTypes,
+		DependencyIndexes: file_llamacalc_v1_linalg_proto_depIdxs,
+		EnumInfos:         file_llamacalc_v1_linalg_proto_enumTypes,
+		MessageInfos:      file_llamacalc_v1_linalg_proto_msgTypes,
+	}.Build()
+	File_llamacalc_v1_linalg_proto = out.File
+	file_llamacalc_v1_linalg_proto_goTypes = nil
+	file_llamacalc_v1_linalg_proto_depIdxs = nil
+}
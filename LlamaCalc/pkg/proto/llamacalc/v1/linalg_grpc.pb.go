@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/linalg.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LinearAlgebra_Elementwise_FullMethodName = "/llamacalc.v1.LinearAlgebra/Elementwise"
+	LinearAlgebra_Multiply_FullMethodName    = "/llamacalc.v1.LinearAlgebra/Multiply"
+	LinearAlgebra_Transpose_FullMethodName   = "/llamacalc.v1.LinearAlgebra/Transpose"
+	LinearAlgebra_Determinant_FullMethodName = "/llamacalc.v1.LinearAlgebra/Determinant"
+	LinearAlgebra_Inverse_FullMethodName     = "/llamacalc.v1.LinearAlgebra/Inverse"
+	LinearAlgebra_DecomposeLU_FullMethodName = "/llamacalc.v1.LinearAlgebra/DecomposeLU"
+	LinearAlgebra_DecomposeQR_FullMethodName = "/llamacalc.v1.LinearAlgebra/DecomposeQR"
+	LinearAlgebra_Solve_FullMethodName       = "/llamacalc.v1.LinearAlgebra/Solve"
+)
+
+// LinearAlgebraClient is the client API for LinearAlgebra service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LinearAlgebra service for dense matrix operations.
+//
+// The number of elements of every matrix, including results, is limited by
+// the maximum message size of the server. Failed calls are reported as gRPC
+// status errors, see ErrorKind.
+type LinearAlgebraClient interface {
+	// Apply an element-wise operation to two matrices of equal dimensions
+	Elementwise(ctx context.Context, in *ElementwiseRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+	// Multiply two matrices
+	Multiply(ctx context.Context, in *MatrixPairRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+	// Transpose a matrix
+	Transpose(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+	// Compute the determinant of a square matrix
+	Determinant(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*DeterminantResponse, error)
+	// Invert a square matrix
+	Inverse(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+	// Decompose a square matrix into P*A = L*U with partial pivoting
+	DecomposeLU(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*LUResponse, error)
+	// Decompose a matrix into A = Q*R
+	DecomposeQR(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*QRResponse, error)
+	// Solve A*X = B for a square matrix A
+	Solve(ctx context.Context, in *MatrixPairRequest, opts ...grpc.CallOption) (*MatrixResponse, error)
+}
+
+type linearAlgebraClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLinearAlgebraClient(cc grpc.ClientConnInterface) LinearAlgebraClient {
+	return &linearAlgebraClient{cc}
+}
+
+func (c *linearAlgebraClient) Elementwise(ctx context.Context, in *ElementwiseRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Elementwise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Multiply(ctx context.Context, in *MatrixPairRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Multiply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Transpose(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Transpose_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Determinant(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*DeterminantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeterminantResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Determinant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Inverse(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Inverse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) DecomposeLU(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*LUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LUResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_DecomposeLU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) DecomposeQR(ctx context.Context, in *MatrixRequest, opts ...grpc.CallOption) (*QRResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QRResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_DecomposeQR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *linearAlgebraClient) Solve(ctx context.Context, in *MatrixPairRequest, opts ...grpc.CallOption) (*MatrixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatrixResponse)
+	err := c.cc.Invoke(ctx, LinearAlgebra_Solve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LinearAlgebraServer is the server API for LinearAlgebra service.
+// All implementations must embed UnimplementedLinearAlgebraServer
+// for forward compatibility.
+//
+// LinearAlgebra service for dense matrix operations.
+//
+// The number of elements of every matrix, including results, is limited by
+// the maximum message size of the server. Failed calls are reported as gRPC
+// status errors, see ErrorKind.
+type LinearAlgebraServer interface {
+	// Apply an element-wise operation to two matrices of equal dimensions
+	Elementwise(context.Context, *ElementwiseRequest) (*MatrixResponse, error)
+	// Multiply two matrices
+	Multiply(context.Context, *MatrixPairRequest) (*MatrixResponse, error)
+	// Transpose a matrix
+	Transpose(context.Context, *MatrixRequest) (*MatrixResponse, error)
+	// Compute the determinant of a square matrix
+	Determinant(context.Context, *MatrixRequest) (*DeterminantResponse, error)
+	// Invert a square matrix
+	Inverse(context.Context, *MatrixRequest) (*MatrixResponse, error)
+	// Decompose a square matrix into P*A = L*U with partial pivoting
+	DecomposeLU(context.Context, *MatrixRequest) (*LUResponse, error)
+	// Decompose a matrix into A = Q*R
+	DecomposeQR(context.Context, *MatrixRequest) (*QRResponse, error)
+	// Solve A*X = B for a square matrix A
+	Solve(context.Context, *MatrixPairRequest) (*MatrixResponse, error)
+	mustEmbedUnimplementedLinearAlgebraServer()
+}
+
+// UnimplementedLinearAlgebraServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLinearAlgebraServer struct{}
+
+func (UnimplementedLinearAlgebraServer) Elementwise(context.Context, *ElementwiseRequest) (*MatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Elementwise not implemented")
+}
+func (UnimplementedLinearAlgebraServer) Multiply(context.Context, *MatrixPairRequest) (*MatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (UnimplementedLinearAlgebraServer) Transpose(context.Context, *MatrixRequest) (*MatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transpose not implemented")
+}
+func (UnimplementedLinearAlgebraServer) Determinant(context.Context, *MatrixRequest) (*DeterminantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Determinant not implemented")
+}
+func (UnimplementedLinearAlgebraServer) Inverse(context.Context, *MatrixRequest) (*MatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inverse not implemented")
+}
+func (UnimplementedLinearAlgebraServer) DecomposeLU(context.Context, *MatrixRequest) (*LUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecomposeLU not implemented")
+}
+func (UnimplementedLinearAlgebraServer) DecomposeQR(context.Context, *MatrixRequest) (*QRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecomposeQR not implemented")
+}
+func (UnimplementedLinearAlgebraServer) Solve(context.Context, *MatrixPairRequest) (*MatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+func (UnimplementedLinearAlgebraServer) mustEmbedUnimplementedLinearAlgebraServer() {}
+func (UnimplementedLinearAlgebraServer) testEmbeddedByValue()                       {}
+
+// UnsafeLinearAlgebraServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LinearAlgebraServer will
+// result in compilation errors.
+type UnsafeLinearAlgebraServer interface {
+	mustEmbedUnimplementedLinearAlgebraServer()
+}
+
+func RegisterLinearAlgebraServer(s grpc.ServiceRegistrar, srv LinearAlgebraServer) {
+	// If the following call pancis, it indicates UnimplementedLinearAlgebraServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LinearAlgebra_ServiceDesc, srv)
+}
+
+func _LinearAlgebra_Elementwise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementwiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Elementwise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Elementwise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Elementwise(ctx, req.(*ElementwiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Multiply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Multiply(ctx, req.(*MatrixPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Transpose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Transpose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Transpose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Transpose(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Determinant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Determinant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Determinant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Determinant(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Inverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Inverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Inverse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Inverse(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_DecomposeLU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).DecomposeLU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_DecomposeLU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).DecomposeLU(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_DecomposeQR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).DecomposeQR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_DecomposeQR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).DecomposeQR(ctx, req.(*MatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LinearAlgebra_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatrixPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LinearAlgebraServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LinearAlgebra_Solve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LinearAlgebraServer).Solve(ctx, req.(*MatrixPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LinearAlgebra_ServiceDesc is the grpc.ServiceDesc for LinearAlgebra service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LinearAlgebra_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.LinearAlgebra",
+	HandlerType: (*LinearAlgebraServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Elementwise",
+			Handler:    _LinearAlgebra_Elementwise_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _LinearAlgebra_Multiply_Handler,
+		},
+		{
+			MethodName: "Transpose",
+			Handler:    _LinearAlgebra_Transpose_Handler,
+		},
+		{
+			MethodName: "Determinant",
+			Handler:    _LinearAlgebra_Determinant_Handler,
+		},
+		{
+			MethodName: "Inverse",
+			Handler:    _LinearAlgebra_Inverse_Handler,
+		},
+		{
+			MethodName: "DecomposeLU",
+			Handler:    _LinearAlgebra_DecomposeLU_Handler,
+		},
+		{
+			MethodName: "DecomposeQR",
+			Handler:    _LinearAlgebra_DecomposeQR_Handler,
+		},
+		{
+			MethodName: "Solve",
+			Handler:    _LinearAlgebra_Solve_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/linalg.proto",
+}
@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: llamacalc/v1/numerics.proto
+
+package llamacalcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Method of finding roots
+type RootMethod int32
+
+const (
+	// Default, same as ROOT_METHOD_BRENT
+	RootMethod_ROOT_METHOD_UNSPECIFIED RootMethod = 0
+	// Bisection of an interval where the function changes sign
+	RootMethod_ROOT_METHOD_BISECTION RootMethod = 1
+	// Brent's method on an interval where the function changes sign
+	RootMethod_ROOT_METHOD_BRENT RootMethod = 2
+	// Newton's method from a starting point
+	RootMethod_ROOT_METHOD_NEWTON RootMethod = 3
+)
+
+// Enum value maps for RootMethod.
+var (
+	RootMethod_name = map[int32]string{
+		0: "ROOT_METHOD_UNSPECIFIED",
+		1: "ROOT_METHOD_BISECTION",
+		2: "ROOT_METHOD_BRENT",
+		3: "ROOT_METHOD_NEWTON",
+	}
+	RootMethod_value = map[string]int32{
+		"ROOT_METHOD_UNSPECIFIED": 0,
+		"ROOT_METHOD_BISECTION":   1,
+		"ROOT_METHOD_BRENT":       2,
+		"ROOT_METHOD_NEWTON":      3,
+	}
+)
+
+func (x RootMethod) Enum() *RootMethod {
+	p := new(RootMethod)
+	*p = x
+	return p
+}
+
+func (x RootMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RootMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_llamacalc_v1_numerics_proto_enumTypes[0].Descriptor()
+}
+
+func (RootMethod) Type() protoreflect.EnumType {
+	return &file_llamacalc_v1_numerics_proto_enumTypes[0]
+}
+
+func (x RootMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RootMethod.Descriptor instead.
+func (RootMethod) EnumDescriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{0}
+}
+
+// Method of numerical integration
+type IntegrationMethod int32
+
+const (
+	// Default, same as INTEGRATION_METHOD_GAUSS_KRONROD
+	IntegrationMethod_INTEGRATION_METHOD_UNSPECIFIED IntegrationMethod = 0
+	// Adaptive 15-point Gauss-Kronrod rule
+	IntegrationMethod_INTEGRATION_METHOD_GAUSS_KRONROD IntegrationMethod = 1
+	// Adaptive Simpson rule
+	IntegrationMethod_INTEGRATION_METHOD_ADAPTIVE_SIMPSON IntegrationMethod = 2
+)
+
+// Enum value maps for IntegrationMethod.
+var (
+	IntegrationMethod_name = map[int32]string{
+		0: "INTEGRATION_METHOD_UNSPECIFIED",
+		1: "INTEGRATION_METHOD_GAUSS_KRONROD",
+		2: "INTEGRATION_METHOD_ADAPTIVE_SIMPSON",
+	}
+	IntegrationMethod_value = map[string]int32{
+		"INTEGRATION_METHOD_UNSPECIFIED":      0,
+		"INTEGRATION_METHOD_GAUSS_KRONROD":    1,
+		"INTEGRATION_METHOD_ADAPTIVE_SIMPSON": 2,
+	}
+)
+
+func (x IntegrationMethod) Enum() *IntegrationMethod {
+	p := new(IntegrationMethod)
+	*p = x
+	return p
+}
+
+func (x IntegrationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntegrationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_llamacalc_v1_numerics_proto_enumTypes[1].Descriptor()
+}
+
+func (IntegrationMethod) Type() protoreflect.EnumType {
+	return &file_llamacalc_v1_numerics_proto_enumTypes[1]
+}
+
+func (x IntegrationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntegrationMethod.Descriptor instead.
+func (IntegrationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{1}
+}
+
+// Request message for finding a root
+type FindRootRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression of the function
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Name of the variable; empty means "x"
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// Unit of angles for trigonometric functions
+	AngleUnit AngleUnit `protobuf:"varint,3,opt,name=angle_unit,json=angleUnit,proto3,enum=llamacalc.v1.AngleUnit" json:"angle_unit,omitempty"`
+	// Method of finding the root
+	Method RootMethod `protobuf:"varint,4,opt,name=method,proto3,enum=llamacalc.v1.RootMethod" json:"method,omitempty"`
+	// Lower bound of the interval of bisection and Brent's method
+	Lower float64 `protobuf:"fixed64,5,opt,name=lower,proto3" json:"lower,omitempty"`
+	// Upper bound of the interval of bisection and Brent's method
+	Upper float64 `protobuf:"fixed64,6,opt,name=upper,proto3" json:"upper,omitempty"`
+	// Starting point of Newton's method
+	Guess float64 `protobuf:"fixed64,7,opt,name=guess,proto3" json:"guess,omitempty"`
+	// Optional expression of the derivative for Newton's method; if empty,
+	// the derivative is approximated with central differences
+	Derivative string `protobuf:"bytes,8,opt,name=derivative,proto3" json:"derivative,omitempty"`
+	// Tolerance; 0 means 1e-10
+	Tolerance float64 `protobuf:"fixed64,9,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Iteration limit, at most 100000; 0 means 1000
+	MaxIterations uint32 `protobuf:"varint,10,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindRootRequest) Reset() {
+	*x = FindRootRequest{}
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindRootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindRootRequest) ProtoMessage() {}
+
+func (x *FindRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindRootRequest.ProtoReflect.Descriptor instead.
+func (*FindRootRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindRootRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *FindRootRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *FindRootRequest) GetAngleUnit() AngleUnit {
+	if x != nil {
+		return x.AngleUnit
+	}
+	return AngleUnit_ANGLE_UNIT_UNSPECIFIED
+}
+
+func (x *FindRootRequest) GetMethod() RootMethod {
+	if x != nil {
+		return x.Method
+	}
+	return RootMethod_ROOT_METHOD_UNSPECIFIED
+}
+
+func (x *FindRootRequest) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *FindRootRequest) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *FindRootRequest) GetGuess() float64 {
+	if x != nil {
+		return x.Guess
+	}
+	return 0
+}
+
+func (x *FindRootRequest) GetDerivative() string {
+	if x != nil {
+		return x.Derivative
+	}
+	return ""
+}
+
+func (x *FindRootRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *FindRootRequest) GetMaxIterations() uint32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *FindRootRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for integrating a function
+type IntegrateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression of the function
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Name of the variable; empty means "x"
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// Unit of angles for trigonometric functions
+	AngleUnit AngleUnit `protobuf:"varint,3,opt,name=angle_unit,json=angleUnit,proto3,enum=llamacalc.v1.AngleUnit" json:"angle_unit,omitempty"`
+	// Method of integration
+	Method IntegrationMethod `protobuf:"varint,4,opt,name=method,proto3,enum=llamacalc.v1.IntegrationMethod" json:"method,omitempty"`
+	// Lower limit of integration
+	Lower float64 `protobuf:"fixed64,5,opt,name=lower,proto3" json:"lower,omitempty"`
+	// Upper limit of integration; the integral changes sign if it is below
+	// the lower limit
+	Upper float64 `protobuf:"fixed64,6,opt,name=upper,proto3" json:"upper,omitempty"`
+	// Tolerance; 0 means 1e-10
+	Tolerance float64 `protobuf:"fixed64,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Iteration limit, at most 100000; 0 means 1000
+	MaxIterations uint32 `protobuf:"varint,8,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrateRequest) Reset() {
+	*x = IntegrateRequest{}
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrateRequest) ProtoMessage() {}
+
+func (x *IntegrateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrateRequest.ProtoReflect.Descriptor instead.
+func (*IntegrateRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IntegrateRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *IntegrateRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *IntegrateRequest) GetAngleUnit() AngleUnit {
+	if x != nil {
+		return x.AngleUnit
+	}
+	return AngleUnit_ANGLE_UNIT_UNSPECIFIED
+}
+
+func (x *IntegrateRequest) GetMethod() IntegrationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return IntegrationMethod_INTEGRATION_METHOD_UNSPECIFIED
+}
+
+func (x *IntegrateRequest) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetMaxIterations() uint32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *IntegrateRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for the derivative of a function
+type DerivativeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression of the function
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Name of the variable; empty means "x"
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// Unit of angles for trigonometric functions
+	AngleUnit AngleUnit `protobuf:"varint,3,opt,name=angle_unit,json=angleUnit,proto3,enum=llamacalc.v1.AngleUnit" json:"angle_unit,omitempty"`
+	// Point at which to differentiate
+	X float64 `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	// Order of the derivative, 1 or 2; 0 means 1
+	Order uint32 `protobuf:"varint,5,opt,name=order,proto3" json:"order,omitempty"`
+	// Tolerance; 0 means 1e-10
+	Tolerance float64 `protobuf:"fixed64,6,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	// Iteration limit; 0 means 1000, but at most 20 iterations are taken
+	MaxIterations uint32 `protobuf:"varint,7,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,8,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DerivativeRequest) Reset() {
+	*x = DerivativeRequest{}
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DerivativeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivativeRequest) ProtoMessage() {}
+
+func (x *DerivativeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivativeRequest.ProtoReflect.Descriptor instead.
+func (*DerivativeRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DerivativeRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *DerivativeRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *DerivativeRequest) GetAngleUnit() AngleUnit {
+	if x != nil {
+		return x.AngleUnit
+	}
+	return AngleUnit_ANGLE_UNIT_UNSPECIFIED
+}
+
+func (x *DerivativeRequest) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *DerivativeRequest) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *DerivativeRequest) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *DerivativeRequest) GetMaxIterations() uint32 {
+	if x != nil {
+		return x.MaxIterations
+	}
+	return 0
+}
+
+func (x *DerivativeRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Response message containing the result of a numerical method
+type NumericsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Root, integral or derivative
+	Result float64 `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	// Estimate of the absolute error of the result
+	ErrorEstimate float64 `protobuf:"fixed64,2,opt,name=error_estimate,json=errorEstimate,proto3" json:"error_estimate,omitempty"`
+	// Value of the function at a root; 0 for integrals and derivatives
+	Residual float64 `protobuf:"fixed64,3,opt,name=residual,proto3" json:"residual,omitempty"`
+	// Number of iterations performed
+	Iterations uint32 `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	// Number of function evaluations
+	Evaluations uint32 `protobuf:"varint,5,opt,name=evaluations,proto3" json:"evaluations,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,6,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumericsResponse) Reset() {
+	*x = NumericsResponse{}
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumericsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumericsResponse) ProtoMessage() {}
+
+func (x *NumericsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_numerics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumericsResponse.ProtoReflect.Descriptor instead.
+func (*NumericsResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_numerics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NumericsResponse) GetResult() float64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *NumericsResponse) GetErrorEstimate() float64 {
+	if x != nil {
+		return x.ErrorEstimate
+	}
+	return 0
+}
+
+func (x *NumericsResponse) GetResidual() float64 {
+	if x != nil {
+		return x.Residual
+	}
+	return 0
+}
+
+func (x *NumericsResponse) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *NumericsResponse) GetEvaluations() uint32 {
+	if x != nil {
+		return x.Evaluations
+	}
+	return 0
+}
+
+func (x *NumericsResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+var File_llamacalc_v1_numerics_proto protoreflect.FileDescriptor
+
+var file_llamacalc_v1_numerics_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
+	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c,
+	0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x1d, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x03, 0x0a, 0x0f, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x6e,
+	0x67, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e,
+	0x67, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70,
+	0x70, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x67, 0x75, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb7, 0x03, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x09, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x75, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf8, 0x02, 0x0a, 0x11,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x36, 0x0a,
+	0x0a, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x49,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x4e, 0x75, 0x6d, 0x65, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x2a, 0x73, 0x0a, 0x0a, 0x52, 0x6f, 0x6f,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x4f, 0x54, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x42, 0x49, 0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x42,
+	0x52, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d,
+	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4e, 0x45, 0x57, 0x54, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x86,
+	0x01, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x54, 0x45,
+	0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x47,
+	0x41, 0x55, 0x53, 0x53, 0x5f, 0x4b, 0x52, 0x4f, 0x4e, 0x52, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x27,
+	0x0a, 0x23, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x49,
+	0x4d, 0x50, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x32, 0xf7, 0x01, 0x0a, 0x08, 0x4e, 0x75, 0x6d, 0x65,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x1d, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1e,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75,
+	0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1f,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_llamacalc_v1_numerics_proto_rawDescOnce sync.Once
+	file_llamacalc_v1_numerics_proto_rawDescData []byte
+)
+
+func file_llamacalc_v1_numerics_proto_rawDescGZIP() []byte {
+	file_llamacalc_v1_numerics_proto_rawDescOnce.Do(func() {
+		file_llamacalc_v1_numerics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_llamacalc_v1_numerics_proto_rawDesc), len(file_llamacalc_v1_numerics_proto_rawDesc)))
+	})
+	return file_llamacalc_v1_numerics_proto_rawDescData
+}
+
+var file_llamacalc_v1_numerics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_llamacalc_v1_numerics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_llamacalc_v1_numerics_proto_goTypes = []any{
+	(RootMethod)(0),           // 0: llamacalc.v1.RootMethod
+	(IntegrationMethod)(0),    // 1: llamacalc.v1.IntegrationMethod
+	(*FindRootRequest)(nil),   // 2: llamacalc.v1.FindRootRequest
+	(*IntegrateRequest)(nil),  // 3: llamacalc.v1.IntegrateRequest
+	(*DerivativeRequest)(nil), // 4: llamacalc.v1.DerivativeRequest
+	(*NumericsResponse)(nil),  // 5: llamacalc.v1.NumericsResponse
+	nil,                       // 6: llamacalc.v1.FindRootRequest.MetadataEntry
+	nil,                       // 7: llamacalc.v1.IntegrateRequest.MetadataEntry
+	nil,                       // 8: llamacalc.v1.DerivativeRequest.MetadataEntry
+	(AngleUnit)(0),            // 9: llamacalc.v1.AngleUnit
+}
+var file_llamacalc_v1_numerics_proto_depIdxs = []int32{
+	9,  // 0: llamacalc.v1.FindRootRequest.angle_unit:type_name -> llamacalc.v1.AngleUnit
+	0,  // 1: llamacalc.v1.FindRootRequest.method:type_name -> llamacalc.v1.RootMethod
+	6,  // 2: llamacalc.v1.FindRootRequest.metadata:type_name -> llamacalc.v1.FindRootRequest.MetadataEntry
+	9,  // 3: llamacalc.v1.IntegrateRequest.angle_unit:type_name -> llamacalc.v1.AngleUnit
+	1,  // 4: llamacalc.v1.IntegrateRequest.method:type_name -> llamacalc.v1.IntegrationMethod
+	7,  // 5: llamacalc.v1.IntegrateRequest.metadata:type_name -> llamacalc.v1.IntegrateRequest.MetadataEntry
+	9,  // 6: llamacalc.v1.DerivativeRequest.angle_unit:type_name -> llamacalc.v1.AngleUnit
+	8,  // 7: llamacalc.v1.DerivativeRequest.metadata:type_name -> llamacalc.v1.DerivativeRequest.MetadataEntry
+	2,  // 8: llamacalc.v1.Numerics.FindRoot:input_type -> llamacalc.v1.FindRootRequest
+	3,  // 9: llamacalc.v1.Numerics.Integrate:input_type -> llamacalc.v1.IntegrateRequest
+	4,  // 10: llamacalc.v1.Numerics.Derivative:input_type -> llamacalc.v1.DerivativeRequest
+	5,  // 11: llamacalc.v1.Numerics.FindRoot:output_type -> llamacalc.v1.NumericsResponse
+	5,  // 12: llamacalc.v1.Numerics.Integrate:output_type -> llamacalc.v1.NumericsResponse
+	5,  // 13: llamacalc.v1.Numerics.Derivative:output_type -> llamacalc.v1.NumericsResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_llamacalc_v1_numerics_proto_init() }
+func file_llamacalc_v1_numerics_proto_init() {
+	if File_llamacalc_v1_numerics_proto != nil {
+		return
+	}
+	file_llamacalc_v1_calculator_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llamacalc_v1_numerics_proto_rawDesc), len(file_llamacalc_v1_numerics_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_llamacalc_v1_numerics_proto_goTypes,
+		DependencyIndexes: file_llamacalc_v1_numerics_proto_depIdxs,
+		EnumInfos:         file_llamacalc_v1_numerics_proto_enumTypes,
+		MessageInfos:      file_llamacalc_v1_numerics_proto_msgTypes,
+	}.Build()
+	File_llamacalc_v1_numerics_proto = out.File
+	file_llamacalc_v1_numerics_proto_goTypes = nil
+	file_llamacalc_v1_numerics_proto_depIdxs = nil
+}
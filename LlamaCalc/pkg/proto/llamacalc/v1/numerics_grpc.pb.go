@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/numerics.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Numerics_FindRoot_FullMethodName   = "/llamacalc.v1.Numerics/FindRoot"
+	Numerics_Integrate_FullMethodName  = "/llamacalc.v1.Numerics/Integrate"
+	Numerics_Derivative_FullMethodName = "/llamacalc.v1.Numerics/Derivative"
+)
+
+// NumericsClient is the client API for Numerics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Numerics service for numerical methods on functions of one variable.
+//
+// Functions are expressions like those of Evaluate in a variable, e.g.
+// "x^2 - 2". A method converges when its error estimate is at most
+// tolerance*max(1, |result|). Methods that do not converge within their
+// iteration limit fail with ERROR_KIND_NOT_CONVERGED and report the last
+// estimate in the error metadata. Failed calls are reported as gRPC status
+// errors, see ErrorKind.
+type NumericsClient interface {
+	// Find a root of a function
+	FindRoot(ctx context.Context, in *FindRootRequest, opts ...grpc.CallOption) (*NumericsResponse, error)
+	// Integrate a function over an interval
+	Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*NumericsResponse, error)
+	// Derivative of a function at a point
+	Derivative(ctx context.Context, in *DerivativeRequest, opts ...grpc.CallOption) (*NumericsResponse, error)
+}
+
+type numericsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNumericsClient(cc grpc.ClientConnInterface) NumericsClient {
+	return &numericsClient{cc}
+}
+
+func (c *numericsClient) FindRoot(ctx context.Context, in *FindRootRequest, opts ...grpc.CallOption) (*NumericsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NumericsResponse)
+	err := c.cc.Invoke(ctx, Numerics_FindRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numericsClient) Integrate(ctx context.Context, in *IntegrateRequest, opts ...grpc.CallOption) (*NumericsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NumericsResponse)
+	err := c.cc.Invoke(ctx, Numerics_Integrate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *numericsClient) Derivative(ctx context.Context, in *DerivativeRequest, opts ...grpc.CallOption) (*NumericsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NumericsResponse)
+	err := c.cc.Invoke(ctx, Numerics_Derivative_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NumericsServer is the server API for Numerics service.
+// All implementations must embed UnimplementedNumericsServer
+// for forward compatibility.
+//
+// Numerics service for numerical methods on functions of one variable.
+//
+// Functions are expressions like those of Evaluate in a variable, e.g.
+// "x^2 - 2". A method converges when its error estimate is at most
+// tolerance*max(1, |result|). Methods that do not converge within their
+// iteration limit fail with ERROR_KIND_NOT_CONVERGED and report the last
+// estimate in the error metadata. Failed calls are reported as gRPC status
+// errors, see ErrorKind.
+type NumericsServer interface {
+	// Find a root of a function
+	FindRoot(context.Context, *FindRootRequest) (*NumericsResponse, error)
+	// Integrate a function over an interval
+	Integrate(context.Context, *IntegrateRequest) (*NumericsResponse, error)
+	// Derivative of a function at a point
+	Derivative(context.Context, *DerivativeRequest) (*NumericsResponse, error)
+	mustEmbedUnimplementedNumericsServer()
+}
+
+// UnimplementedNumericsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNumericsServer struct{}
+
+func (UnimplementedNumericsServer) FindRoot(context.Context, *FindRootRequest) (*NumericsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRoot not implemented")
+}
+func (UnimplementedNumericsServer) Integrate(context.Context, *IntegrateRequest) (*NumericsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrate not implemented")
+}
+func (UnimplementedNumericsServer) Derivative(context.Context, *DerivativeRequest) (*NumericsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Derivative not implemented")
+}
+func (UnimplementedNumericsServer) mustEmbedUnimplementedNumericsServer() {}
+func (UnimplementedNumericsServer) testEmbeddedByValue()                  {}
+
+// UnsafeNumericsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NumericsServer will
+// result in compilation errors.
+type UnsafeNumericsServer interface {
+	mustEmbedUnimplementedNumericsServer()
+}
+
+func RegisterNumericsServer(s grpc.ServiceRegistrar, srv NumericsServer) {
+	// If the following call pancis, it indicates UnimplementedNumericsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Numerics_ServiceDesc, srv)
+}
+
+func _Numerics_FindRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumericsServer).FindRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Numerics_FindRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumericsServer).FindRoot(ctx, req.(*FindRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Numerics_Integrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumericsServer).Integrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Numerics_Integrate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumericsServer).Integrate(ctx, req.(*IntegrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Numerics_Derivative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DerivativeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NumericsServer).Derivative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Numerics_Derivative_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NumericsServer).Derivative(ctx, req.(*DerivativeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Numerics_ServiceDesc is the grpc.ServiceDesc for Numerics service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Numerics_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Numerics",
+	HandlerType: (*NumericsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindRoot",
+			Handler:    _Numerics_FindRoot_Handler,
+		},
+		{
+			MethodName: "Integrate",
+			Handler:    _Numerics_Integrate_Handler,
+		},
+		{
+			MethodName: "Derivative",
+			Handler:    _Numerics_Derivative_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/numerics.proto",
+}
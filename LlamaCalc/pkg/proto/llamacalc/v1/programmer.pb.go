@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: llamacalc/v1/programmer.proto
+
+package llamacalcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Fixed-width integer type
+type IntegerType int32
+
+const (
+	// Not a valid type
+	IntegerType_INTEGER_TYPE_UNSPECIFIED IntegerType = 0
+	// 8-bit signed integer
+	IntegerType_INTEGER_TYPE_INT8 IntegerType = 1
+	// 16-bit signed integer
+	IntegerType_INTEGER_TYPE_INT16 IntegerType = 2
+	// 32-bit signed integer
+	IntegerType_INTEGER_TYPE_INT32 IntegerType = 3
+	// 64-bit signed integer
+	IntegerType_INTEGER_TYPE_INT64 IntegerType = 4
+	// 8-bit unsigned integer
+	IntegerType_INTEGER_TYPE_UINT8 IntegerType = 5
+	// 16-bit unsigned integer
+	IntegerType_INTEGER_TYPE_UINT16 IntegerType = 6
+	// 32-bit unsigned integer
+	IntegerType_INTEGER_TYPE_UINT32 IntegerType = 7
+	// 64-bit unsigned integer
+	IntegerType_INTEGER_TYPE_UINT64 IntegerType = 8
+)
+
+// Enum value maps for IntegerType.
+var (
+	IntegerType_name = map[int32]string{
+		0: "INTEGER_TYPE_UNSPECIFIED",
+		1: "INTEGER_TYPE_INT8",
+		2: "INTEGER_TYPE_INT16",
+		3: "INTEGER_TYPE_INT32",
+		4: "INTEGER_TYPE_INT64",
+		5: "INTEGER_TYPE_UINT8",
+		6: "INTEGER_TYPE_UINT16",
+		7: "INTEGER_TYPE_UINT32",
+		8: "INTEGER_TYPE_UINT64",
+	}
+	IntegerType_value = map[string]int32{
+		"INTEGER_TYPE_UNSPECIFIED": 0,
+		"INTEGER_TYPE_INT8":        1,
+		"INTEGER_TYPE_INT16":       2,
+		"INTEGER_TYPE_INT32":       3,
+		"INTEGER_TYPE_INT64":       4,
+		"INTEGER_TYPE_UINT8":       5,
+		"INTEGER_TYPE_UINT16":      6,
+		"INTEGER_TYPE_UINT32":      7,
+		"INTEGER_TYPE_UINT64":      8,
+	}
+)
+
+func (x IntegerType) Enum() *IntegerType {
+	p := new(IntegerType)
+	*p = x
+	return p
+}
+
+func (x IntegerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntegerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_llamacalc_v1_programmer_proto_enumTypes[0].Descriptor()
+}
+
+func (IntegerType) Type() protoreflect.EnumType {
+	return &file_llamacalc_v1_programmer_proto_enumTypes[0]
+}
+
+func (x IntegerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntegerType.Descriptor instead.
+func (IntegerType) EnumDescriptor() ([]byte, []int) {
+	return file_llamacalc_v1_programmer_proto_rawDescGZIP(), []int{0}
+}
+
+// Request message for an operation
+type ProgrammerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the arguments and the result
+	Type IntegerType `protobuf:"varint,1,opt,name=type,proto3,enum=llamacalc.v1.IntegerType" json:"type,omitempty"`
+	// Operation to perform (e.g., "And", "Rotl", "ByteSwap")
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Arguments of the operation
+	Args []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgrammerRequest) Reset() {
+	*x = ProgrammerRequest{}
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgrammerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgrammerRequest) ProtoMessage() {}
+
+func (x *ProgrammerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgrammerRequest.ProtoReflect.Descriptor instead.
+func (*ProgrammerRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_programmer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProgrammerRequest) GetType() IntegerType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegerType_INTEGER_TYPE_UNSPECIFIED
+}
+
+func (x *ProgrammerRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ProgrammerRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ProgrammerRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for an expression
+type ProgrammerEvaluateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of every value in the expression
+	Type IntegerType `protobuf:"varint,1,opt,name=type,proto3,enum=llamacalc.v1.IntegerType" json:"type,omitempty"`
+	// Expression to evaluate
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgrammerEvaluateRequest) Reset() {
+	*x = ProgrammerEvaluateRequest{}
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgrammerEvaluateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgrammerEvaluateRequest) ProtoMessage() {}
+
+func (x *ProgrammerEvaluateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgrammerEvaluateRequest.ProtoReflect.Descriptor instead.
+func (*ProgrammerEvaluateRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_programmer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProgrammerEvaluateRequest) GetType() IntegerType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegerType_INTEGER_TYPE_UNSPECIFIED
+}
+
+func (x *ProgrammerEvaluateRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ProgrammerEvaluateRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for a base conversion
+type ProgrammerConvertRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the value
+	Type IntegerType `protobuf:"varint,1,opt,name=type,proto3,enum=llamacalc.v1.IntegerType" json:"type,omitempty"`
+	// Value to convert
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgrammerConvertRequest) Reset() {
+	*x = ProgrammerConvertRequest{}
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgrammerConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgrammerConvertRequest) ProtoMessage() {}
+
+func (x *ProgrammerConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgrammerConvertRequest.ProtoReflect.Descriptor instead.
+func (*ProgrammerConvertRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_programmer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProgrammerConvertRequest) GetType() IntegerType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegerType_INTEGER_TYPE_UNSPECIFIED
+}
+
+func (x *ProgrammerConvertRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ProgrammerConvertRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Response message containing a value in every base
+type ProgrammerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value in decimal
+	Decimal string `protobuf:"bytes,1,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	// Bit pattern in hexadecimal, padded to the width of the type
+	Hex string `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	// Bit pattern in octal
+	Octal string `protobuf:"bytes,3,opt,name=octal,proto3" json:"octal,omitempty"`
+	// Bit pattern in binary, padded to the width of the type
+	Binary string `protobuf:"bytes,4,opt,name=binary,proto3" json:"binary,omitempty"`
+	// Whether the exact result was out of range and wrapped around
+	Overflow bool `protobuf:"varint,5,opt,name=overflow,proto3" json:"overflow,omitempty"`
+	// Type of the value
+	Type IntegerType `protobuf:"varint,6,opt,name=type,proto3,enum=llamacalc.v1.IntegerType" json:"type,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,7,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProgrammerResponse) Reset() {
+	*x = ProgrammerResponse{}
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgrammerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgrammerResponse) ProtoMessage() {}
+
+func (x *ProgrammerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_programmer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgrammerResponse.ProtoReflect.Descriptor instead.
+func (*ProgrammerResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_programmer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProgrammerResponse) GetDecimal() string {
+	if x != nil {
+		return x.Decimal
+	}
+	return ""
+}
+
+func (x *ProgrammerResponse) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *ProgrammerResponse) GetOctal() string {
+	if x != nil {
+		return x.Octal
+	}
+	return ""
+}
+
+func (x *ProgrammerResponse) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *ProgrammerResponse) GetOverflow() bool {
+	if x != nil {
+		return x.Overflow
+	}
+	return false
+}
+
+func (x *ProgrammerResponse) GetType() IntegerType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegerType_INTEGER_TYPE_UNSPECIFIED
+}
+
+func (x *ProgrammerResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+var File_llamacalc_v1_programmer_proto protoreflect.FileDescriptor
+
+var file_llamacalc_v1_programmer_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0c, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x22, 0xfc, 0x01,
+	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfa, 0x01, 0x0a,
+	0x19, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61,
+	0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x6c,
+	0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x6d, 0x65, 0x72, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x68,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x63, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x63,
+	0x74, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
+	0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x73, 0x2a, 0xed, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x54, 0x45, 0x47,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54,
+	0x31, 0x36, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54,
+	0x36, 0x34, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x38, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13,
+	0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e,
+	0x54, 0x31, 0x36, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x07, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x08, 0x32, 0x8e, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x08, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x6c,
+	0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x6c, 0x6c, 0x61, 0x6d,
+	0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6c, 0x61,
+	0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_llamacalc_v1_programmer_proto_rawDescOnce sync.Once
+	file_llamacalc_v1_programmer_proto_rawDescData []byte
+)
+
+func file_llamacalc_v1_programmer_proto_rawDescGZIP() []byte {
+	file_llamacalc_v1_programmer_proto_rawDescOnce.Do(func() {
+		file_llamacalc_v1_programmer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_llamacalc_v1_programmer_proto_rawDesc), len(file_llamacalc_v1_programmer_proto_rawDesc)))
+	})
+	return file_llamacalc_v1_programmer_proto_rawDescData
+}
+
+var file_llamacalc_v1_programmer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_llamacalc_v1_programmer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_llamacalc_v1_programmer_proto_goTypes = []any{
+	(IntegerType)(0),                  // 0: llamacalc.v1.IntegerType
+	(*ProgrammerRequest)(nil),         // 1: llamacalc.v1.ProgrammerRequest
+	(*ProgrammerEvaluateRequest)(nil), // 2: llamacalc.v1.ProgrammerEvaluateRequest
+	(*ProgrammerConvertRequest)(nil),  // 3: llamacalc.v1.ProgrammerConvertRequest
+	(*ProgrammerResponse)(nil),        // 4: llamacalc.v1.ProgrammerResponse
+	nil,                               // 5: llamacalc.v1.ProgrammerRequest.MetadataEntry
+	nil,                               // 6: llamacalc.v1.ProgrammerEvaluateRequest.MetadataEntry
+	nil,                               // 7: llamacalc.v1.ProgrammerConvertRequest.MetadataEntry
+}
+var file_llamacalc_v1_programmer_proto_depIdxs = []int32{
+	0,  // 0: llamacalc.v1.ProgrammerRequest.type:type_name -> llamacalc.v1.IntegerType
+	5,  // 1: llamacalc.v1.ProgrammerRequest.metadata:type_name -> llamacalc.v1.ProgrammerRequest.MetadataEntry
+	0,  // 2: llamacalc.v1.ProgrammerEvaluateRequest.type:type_name -> llamacalc.v1.IntegerType
+	6,  // 3: llamacalc.v1.ProgrammerEvaluateRequest.metadata:type_name -> llamacalc.v1.ProgrammerEvaluateRequest.MetadataEntry
+	0,  // 4: llamacalc.v1.ProgrammerConvertRequest.type:type_name -> llamacalc.v1.IntegerType
+	7,  // 5: llamacalc.v1.ProgrammerConvertRequest.metadata:type_name -> llamacalc.v1.ProgrammerConvertRequest.MetadataEntry
+	0,  // 6: llamacalc.v1.ProgrammerResponse.type:type_name -> llamacalc.v1.IntegerType
+	1,  // 7: llamacalc.v1.Programmer.Calculate:input_type -> llamacalc.v1.ProgrammerRequest
+	2,  // 8: llamacalc.v1.Programmer.Evaluate:input_type -> llamacalc.v1.ProgrammerEvaluateRequest
+	3,  // 9: llamacalc.v1.Programmer.Convert:input_type -> llamacalc.v1.ProgrammerConvertRequest
+	4,  // 10: llamacalc.v1.Programmer.Calculate:output_type -> llamacalc.v1.ProgrammerResponse
+	4,  // 11: llamacalc.v1.Programmer.Evaluate:output_type -> llamacalc.v1.ProgrammerResponse
+	4,  // 12: llamacalc.v1.Programmer.Convert:output_type -> llamacalc.v1.ProgrammerResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_llamacalc_v1_programmer_proto_init() }
+func file_llamacalc_v1_programmer_proto_init() {
+	if File_llamacalc_v1_programmer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llamacalc_v1_programmer_proto_rawDesc), len(file_llamacalc_v1_programmer_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_llamacalc_v1_programmer_proto_goTypes,
+		DependencyIndexes: file_llamacalc_v1_programmer_proto_depIdxs,
+		EnumInfos:         file_llamacalc_v1_programmer_proto_enumTypes,
+		MessageInfos:      file_llamacalc_v1_programmer_proto_msgTypes,
+	}.Build()
+	File_llamacalc_v1_programmer_proto = out.File
+	file_llamacalc_v1_programmer_proto_goTypes = nil
+	file_llamacalc_v1_programmer_proto_depIdxs = nil
+}
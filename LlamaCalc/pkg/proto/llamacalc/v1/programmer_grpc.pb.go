@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/programmer.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Programmer_Calculate_FullMethodName = "/llamacalc.v1.Programmer/Calculate"
+	Programmer_Evaluate_FullMethodName  = "/llamacalc.v1.Programmer/Evaluate"
+	Programmer_Convert_FullMethodName   = "/llamacalc.v1.Programmer/Convert"
+)
+
+// ProgrammerClient is the client API for Programmer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Programmer service for bitwise arithmetic on fixed-width integers.
+//
+// Values are written in decimal, or as bit patterns with a 0x, 0b or 0o
+// prefix. Arithmetic wraps around in two's complement and reports overflow.
+// Failed calls are reported as gRPC status errors, see ErrorKind.
+type ProgrammerClient interface {
+	// Apply an operation to values of an integer type
+	Calculate(ctx context.Context, in *ProgrammerRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error)
+	// Evaluate an expression such as "(0xf0 | 0b0101) << 2"
+	Evaluate(ctx context.Context, in *ProgrammerEvaluateRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error)
+	// Convert a value to every base
+	Convert(ctx context.Context, in *ProgrammerConvertRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error)
+}
+
+type programmerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProgrammerClient(cc grpc.ClientConnInterface) ProgrammerClient {
+	return &programmerClient{cc}
+}
+
+func (c *programmerClient) Calculate(ctx context.Context, in *ProgrammerRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProgrammerResponse)
+	err := c.cc.Invoke(ctx, Programmer_Calculate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmerClient) Evaluate(ctx context.Context, in *ProgrammerEvaluateRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProgrammerResponse)
+	err := c.cc.Invoke(ctx, Programmer_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmerClient) Convert(ctx context.Context, in *ProgrammerConvertRequest, opts ...grpc.CallOption) (*ProgrammerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProgrammerResponse)
+	err := c.cc.Invoke(ctx, Programmer_Convert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProgrammerServer is the server API for Programmer service.
+// All implementations must embed UnimplementedProgrammerServer
+// for forward compatibility.
+//
+// Programmer service for bitwise arithmetic on fixed-width integers.
+//
+// Values are written in decimal, or as bit patterns with a 0x, 0b or 0o
+// prefix. Arithmetic wraps around in two's complement and reports overflow.
+// Failed calls are reported as gRPC status errors, see ErrorKind.
+type ProgrammerServer interface {
+	// Apply an operation to values of an integer type
+	Calculate(context.Context, *ProgrammerRequest) (*ProgrammerResponse, error)
+	// Evaluate an expression such as "(0xf0 | 0b0101) << 2"
+	Evaluate(context.Context, *ProgrammerEvaluateRequest) (*ProgrammerResponse, error)
+	// Convert a value to every base
+	Convert(context.Context, *ProgrammerConvertRequest) (*ProgrammerResponse, error)
+	mustEmbedUnimplementedProgrammerServer()
+}
+
+// UnimplementedProgrammerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProgrammerServer struct{}
+
+func (UnimplementedProgrammerServer) Calculate(context.Context, *ProgrammerRequest) (*ProgrammerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Calculate not implemented")
+}
+func (UnimplementedProgrammerServer) Evaluate(context.Context, *ProgrammerEvaluateRequest) (*ProgrammerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedProgrammerServer) Convert(context.Context, *ProgrammerConvertRequest) (*ProgrammerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Convert not implemented")
+}
+func (UnimplementedProgrammerServer) mustEmbedUnimplementedProgrammerServer() {}
+func (UnimplementedProgrammerServer) testEmbeddedByValue()                    {}
+
+// UnsafeProgrammerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProgrammerServer will
+// result in compilation errors.
+type UnsafeProgrammerServer interface {
+	mustEmbedUnimplementedProgrammerServer()
+}
+
+func RegisterProgrammerServer(s grpc.ServiceRegistrar, srv ProgrammerServer) {
+	// If the following call pancis, it indicates UnimplementedProgrammerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Programmer_ServiceDesc, srv)
+}
+
+func _Programmer_Calculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgrammerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammerServer).Calculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Programmer_Calculate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammerServer).Calculate(ctx, req.(*ProgrammerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Programmer_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgrammerEvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammerServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Programmer_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammerServer).Evaluate(ctx, req.(*ProgrammerEvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Programmer_Convert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgrammerConvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammerServer).Convert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Programmer_Convert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammerServer).Convert(ctx, req.(*ProgrammerConvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Programmer_ServiceDesc is the grpc.ServiceDesc for Programmer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Programmer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Programmer",
+	HandlerType: (*ProgrammerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Calculate",
+			Handler:    _Programmer_Calculate_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _Programmer_Evaluate_Handler,
+		},
+		{
+			MethodName: "Convert",
+			Handler:    _Programmer_Convert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/programmer.proto",
+}
@@ -0,0 +1,755 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: llamacalc/v1/symbolic.proto
+
+package llamacalcv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request message for differentiating an expression
+type DifferentiateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression to differentiate
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Name of the variable; empty means "x"
+	Variable string `protobuf:"bytes,2,opt,name=variable,proto3" json:"variable,omitempty"`
+	// Order of the derivative, at most 10; 0 means 1
+	Order uint32 `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DifferentiateRequest) Reset() {
+	*x = DifferentiateRequest{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DifferentiateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DifferentiateRequest) ProtoMessage() {}
+
+func (x *DifferentiateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DifferentiateRequest.ProtoReflect.Descriptor instead.
+func (*DifferentiateRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DifferentiateRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *DifferentiateRequest) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *DifferentiateRequest) GetOrder() uint32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *DifferentiateRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Request message for simplifying an expression
+type SimplifyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression to simplify
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Optional caller metadata
+	Metadata      map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimplifyRequest) Reset() {
+	*x = SimplifyRequest{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimplifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimplifyRequest) ProtoMessage() {}
+
+func (x *SimplifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimplifyRequest.ProtoReflect.Descriptor instead.
+func (*SimplifyRequest) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SimplifyRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SimplifyRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Response message containing a simplified expression
+type SymbolicResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression in canonical form
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Syntax tree of the expression
+	Tree *ExpressionNode `protobuf:"bytes,2,opt,name=tree,proto3" json:"tree,omitempty"`
+	// Duration of calculation in nanoseconds
+	DurationNs    int64 `protobuf:"varint,3,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolicResponse) Reset() {
+	*x = SymbolicResponse{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolicResponse) ProtoMessage() {}
+
+func (x *SymbolicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolicResponse.ProtoReflect.Descriptor instead.
+func (*SymbolicResponse) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SymbolicResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *SymbolicResponse) GetTree() *ExpressionNode {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *SymbolicResponse) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+// Node of the syntax tree of an expression
+type ExpressionNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Node:
+	//
+	//	*ExpressionNode_Number
+	//	*ExpressionNode_Identifier
+	//	*ExpressionNode_Unary
+	//	*ExpressionNode_Binary
+	//	*ExpressionNode_Call
+	Node          isExpressionNode_Node `protobuf_oneof:"node"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpressionNode) Reset() {
+	*x = ExpressionNode{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpressionNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpressionNode) ProtoMessage() {}
+
+func (x *ExpressionNode) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpressionNode.ProtoReflect.Descriptor instead.
+func (*ExpressionNode) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExpressionNode) GetNode() isExpressionNode_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ExpressionNode) GetNumber() *NumberNode {
+	if x != nil {
+		if x, ok := x.Node.(*ExpressionNode_Number); ok {
+			return x.Number
+		}
+	}
+	return nil
+}
+
+func (x *ExpressionNode) GetIdentifier() string {
+	if x != nil {
+		if x, ok := x.Node.(*ExpressionNode_Identifier); ok {
+			return x.Identifier
+		}
+	}
+	return ""
+}
+
+func (x *ExpressionNode) GetUnary() *UnaryNode {
+	if x != nil {
+		if x, ok := x.Node.(*ExpressionNode_Unary); ok {
+			return x.Unary
+		}
+	}
+	return nil
+}
+
+func (x *ExpressionNode) GetBinary() *BinaryNode {
+	if x != nil {
+		if x, ok := x.Node.(*ExpressionNode_Binary); ok {
+			return x.Binary
+		}
+	}
+	return nil
+}
+
+func (x *ExpressionNode) GetCall() *CallNode {
+	if x != nil {
+		if x, ok := x.Node.(*ExpressionNode_Call); ok {
+			return x.Call
+		}
+	}
+	return nil
+}
+
+type isExpressionNode_Node interface {
+	isExpressionNode_Node()
+}
+
+type ExpressionNode_Number struct {
+	// Non-negative number
+	Number *NumberNode `protobuf:"bytes,1,opt,name=number,proto3,oneof"`
+}
+
+type ExpressionNode_Identifier struct {
+	// Name of a constant or variable
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3,oneof"`
+}
+
+type ExpressionNode_Unary struct {
+	// Unary operation
+	Unary *UnaryNode `protobuf:"bytes,3,opt,name=unary,proto3,oneof"`
+}
+
+type ExpressionNode_Binary struct {
+	// Binary operation
+	Binary *BinaryNode `protobuf:"bytes,4,opt,name=binary,proto3,oneof"`
+}
+
+type ExpressionNode_Call struct {
+	// Function call
+	Call *CallNode `protobuf:"bytes,5,opt,name=call,proto3,oneof"`
+}
+
+func (*ExpressionNode_Number) isExpressionNode_Node() {}
+
+func (*ExpressionNode_Identifier) isExpressionNode_Node() {}
+
+func (*ExpressionNode_Unary) isExpressionNode_Node() {}
+
+func (*ExpressionNode_Binary) isExpressionNode_Node() {}
+
+func (*ExpressionNode_Call) isExpressionNode_Node() {}
+
+// Number of a syntax tree
+type NumberNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Value of the number
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Number as written, which is exact where the value is not
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumberNode) Reset() {
+	*x = NumberNode{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumberNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberNode) ProtoMessage() {}
+
+func (x *NumberNode) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberNode.ProtoReflect.Descriptor instead.
+func (*NumberNode) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NumberNode) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *NumberNode) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// Unary operation of a syntax tree, e.g. -x
+type UnaryNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operator, "-", "+" or "~"
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// Operand
+	Operand       *ExpressionNode `protobuf:"bytes,2,opt,name=operand,proto3" json:"operand,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnaryNode) Reset() {
+	*x = UnaryNode{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnaryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnaryNode) ProtoMessage() {}
+
+func (x *UnaryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnaryNode.ProtoReflect.Descriptor instead.
+func (*UnaryNode) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnaryNode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *UnaryNode) GetOperand() *ExpressionNode {
+	if x != nil {
+		return x.Operand
+	}
+	return nil
+}
+
+// Binary operation of a syntax tree, e.g. x + 1
+type BinaryNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Operator, e.g. "+" or "^"
+	Op string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	// Left operand
+	Left *ExpressionNode `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	// Right operand
+	Right         *ExpressionNode `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinaryNode) Reset() {
+	*x = BinaryNode{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryNode) ProtoMessage() {}
+
+func (x *BinaryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryNode.ProtoReflect.Descriptor instead.
+func (*BinaryNode) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BinaryNode) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BinaryNode) GetLeft() *ExpressionNode {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *BinaryNode) GetRight() *ExpressionNode {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+// Function call of a syntax tree, e.g. sin(x)
+type CallNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the function
+	Function string `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	// Arguments
+	Args          []*ExpressionNode `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallNode) Reset() {
+	*x = CallNode{}
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallNode) ProtoMessage() {}
+
+func (x *CallNode) ProtoReflect() protoreflect.Message {
+	mi := &file_llamacalc_v1_symbolic_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallNode.ProtoReflect.Descriptor instead.
+func (*CallNode) Descriptor() ([]byte, []int) {
+	return file_llamacalc_v1_symbolic_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CallNode) GetFunction() string {
+	if x != nil {
+		return x.Function
+	}
+	return ""
+}
+
+func (x *CallNode) GetArgs() []*ExpressionNode {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+var File_llamacalc_v1_symbolic_proto protoreflect.FileDescriptor
+
+var file_llamacalc_v1_symbolic_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6c,
+	0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x22, 0xf3, 0x01, 0x0a, 0x14,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61,
+	0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63,
+	0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x10,
+	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x74, 0x72,
+	0x65, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x05,
+	0x75, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6c,
+	0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x61, 0x72, 0x79,
+	0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x05, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x32, 0x0a,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x12, 0x2c, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x42,
+	0x06, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x0a, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x53, 0x0a, 0x09, 0x55, 0x6e, 0x61, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x36, 0x0a, 0x07,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x6e, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x0a, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x6f, 0x70, 0x12, 0x30, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6c, 0x65, 0x66, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x22, 0x58, 0x0a, 0x08, 0x43, 0x61, 0x6c,
+	0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x32, 0xae, 0x01, 0x0a, 0x08, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63,
+	0x12, 0x55, 0x0a, 0x0d, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x12, 0x22, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x66, 0x79, 0x12, 0x1d, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x2e, 0x5a, 0x2c, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61, 0x6c,
+	0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x6c, 0x61, 0x6d,
+	0x61, 0x63, 0x61, 0x6c, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6c, 0x61, 0x6d, 0x61, 0x63, 0x61,
+	0x6c, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_llamacalc_v1_symbolic_proto_rawDescOnce sync.Once
+	file_llamacalc_v1_symbolic_proto_rawDescData []byte
+)
+
+func file_llamacalc_v1_symbolic_proto_rawDescGZIP() []byte {
+	file_llamacalc_v1_symbolic_proto_rawDescOnce.Do(func() {
+		file_llamacalc_v1_symbolic_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_llamacalc_v1_symbolic_proto_rawDesc), len(file_llamacalc_v1_symbolic_proto_rawDesc)))
+	})
+	return file_llamacalc_v1_symbolic_proto_rawDescData
+}
+
+var file_llamacalc_v1_symbolic_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_llamacalc_v1_symbolic_proto_goTypes = []any{
+	(*DifferentiateRequest)(nil), // 0: llamacalc.v1.DifferentiateRequest
+	(*SimplifyRequest)(nil),      // 1: llamacalc.v1.SimplifyRequest
+	(*SymbolicResponse)(nil),     // 2: llamacalc.v1.SymbolicResponse
+	(*ExpressionNode)(nil),       // 3: llamacalc.v1.ExpressionNode
+	(*NumberNode)(nil),           // 4: llamacalc.v1.NumberNode
+	(*UnaryNode)(nil),            // 5: llamacalc.v1.UnaryNode
+	(*BinaryNode)(nil),           // 6: llamacalc.v1.BinaryNode
+	(*CallNode)(nil),             // 7: llamacalc.v1.CallNode
+	nil,                          // 8: llamacalc.v1.DifferentiateRequest.MetadataEntry
+	nil,                          // 9: llamacalc.v1.SimplifyRequest.MetadataEntry
+}
+var file_llamacalc_v1_symbolic_proto_depIdxs = []int32{
+	8,  // 0: llamacalc.v1.DifferentiateRequest.metadata:type_name -> llamacalc.v1.DifferentiateRequest.MetadataEntry
+	9,  // 1: llamacalc.v1.SimplifyRequest.metadata:type_name -> llamacalc.v1.SimplifyRequest.MetadataEntry
+	3,  // 2: llamacalc.v1.SymbolicResponse.tree:type_name -> llamacalc.v1.ExpressionNode
+	4,  // 3: llamacalc.v1.ExpressionNode.number:type_name -> llamacalc.v1.NumberNode
+	5,  // 4: llamacalc.v1.ExpressionNode.unary:type_name -> llamacalc.v1.UnaryNode
+	6,  // 5: llamacalc.v1.ExpressionNode.binary:type_name -> llamacalc.v1.BinaryNode
+	7,  // 6: llamacalc.v1.ExpressionNode.call:type_name -> llamacalc.v1.CallNode
+	3,  // 7: llamacalc.v1.UnaryNode.operand:type_name -> llamacalc.v1.ExpressionNode
+	3,  // 8: llamacalc.v1.BinaryNode.left:type_name -> llamacalc.v1.ExpressionNode
+	3,  // 9: llamacalc.v1.BinaryNode.right:type_name -> llamacalc.v1.ExpressionNode
+	3,  // 10: llamacalc.v1.CallNode.args:type_name -> llamacalc.v1.ExpressionNode
+	0,  // 11: llamacalc.v1.Symbolic.Differentiate:input_type -> llamacalc.v1.DifferentiateRequest
+	1,  // 12: llamacalc.v1.Symbolic.Simplify:input_type -> llamacalc.v1.SimplifyRequest
+	2,  // 13: llamacalc.v1.Symbolic.Differentiate:output_type -> llamacalc.v1.SymbolicResponse
+	2,  // 14: llamacalc.v1.Symbolic.Simplify:output_type -> llamacalc.v1.SymbolicResponse
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_llamacalc_v1_symbolic_proto_init() }
+func file_llamacalc_v1_symbolic_proto_init() {
+	if File_llamacalc_v1_symbolic_proto != nil {
+		return
+	}
+	file_llamacalc_v1_symbolic_proto_msgTypes[3].OneofWrappers = []any{
+		(*ExpressionNode_Number)(nil),
+		(*ExpressionNode_Identifier)(nil),
+		(*ExpressionNode_Unary)(nil),
+		(*ExpressionNode_Binary)(nil),
+		(*ExpressionNode_Call)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_llamacalc_v1_symbolic_proto_rawDesc), len(file_llamacalc_v1_symbolic_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_llamacalc_v1_symbolic_proto_goTypes,
+		DependencyIndexes: file_llamacalc_v1_symbolic_proto_depIdxs,
+		MessageInfos:      file_llamacalc_v1_symbolic_proto_msgTypes,
+	}.Build()
+	File_llamacalc_v1_symbolic_proto = out.File
+	file_llamacalc_v1_symbolic_proto_goTypes = nil
+	file_llamacalc_v1_symbolic_proto_depIdxs = nil
+}
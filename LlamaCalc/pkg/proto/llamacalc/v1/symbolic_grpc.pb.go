@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: llamacalc/v1/symbolic.proto
+
+package llamacalcv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Symbolic_Differentiate_FullMethodName = "/llamacalc.v1.Symbolic/Differentiate"
+	Symbolic_Simplify_FullMethodName      = "/llamacalc.v1.Symbolic/Simplify"
+)
+
+// SymbolicClient is the client API for Symbolic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Symbolic service for differentiating and simplifying expressions.
+//
+// Expressions are those of Evaluate. Results are simplified exactly, with
+// numbers as fractions, and returned both in canonical form, e.g.
+// "3*x^2 - 2", and as a syntax tree. Derivatives of trigonometric
+// functions are in radians. Failed calls are reported as gRPC status
+// errors, see ErrorKind.
+type SymbolicClient interface {
+	// Derivative of an expression with respect to a variable
+	Differentiate(ctx context.Context, in *DifferentiateRequest, opts ...grpc.CallOption) (*SymbolicResponse, error)
+	// Simplify an expression
+	Simplify(ctx context.Context, in *SimplifyRequest, opts ...grpc.CallOption) (*SymbolicResponse, error)
+}
+
+type symbolicClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSymbolicClient(cc grpc.ClientConnInterface) SymbolicClient {
+	return &symbolicClient{cc}
+}
+
+func (c *symbolicClient) Differentiate(ctx context.Context, in *DifferentiateRequest, opts ...grpc.CallOption) (*SymbolicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SymbolicResponse)
+	err := c.cc.Invoke(ctx, Symbolic_Differentiate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolicClient) Simplify(ctx context.Context, in *SimplifyRequest, opts ...grpc.CallOption) (*SymbolicResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SymbolicResponse)
+	err := c.cc.Invoke(ctx, Symbolic_Simplify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SymbolicServer is the server API for Symbolic service.
+// All implementations must embed UnimplementedSymbolicServer
+// for forward compatibility.
+//
+// Symbolic service for differentiating and simplifying expressions.
+//
+// Expressions are those of Evaluate. Results are simplified exactly, with
+// numbers as fractions, and returned both in canonical form, e.g.
+// "3*x^2 - 2", and as a syntax tree. Derivatives of trigonometric
+// functions are in radians. Failed calls are reported as gRPC status
+// errors, see ErrorKind.
+type SymbolicServer interface {
+	// Derivative of an expression with respect to a variable
+	Differentiate(context.Context, *DifferentiateRequest) (*SymbolicResponse, error)
+	// Simplify an expression
+	Simplify(context.Context, *SimplifyRequest) (*SymbolicResponse, error)
+	mustEmbedUnimplementedSymbolicServer()
+}
+
+// UnimplementedSymbolicServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSymbolicServer struct{}
+
+func (UnimplementedSymbolicServer) Differentiate(context.Context, *DifferentiateRequest) (*SymbolicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Differentiate not implemented")
+}
+func (UnimplementedSymbolicServer) Simplify(context.Context, *SimplifyRequest) (*SymbolicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simplify not implemented")
+}
+func (UnimplementedSymbolicServer) mustEmbedUnimplementedSymbolicServer() {}
+func (UnimplementedSymbolicServer) testEmbeddedByValue()                  {}
+
+// UnsafeSymbolicServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SymbolicServer will
+// result in compilation errors.
+type UnsafeSymbolicServer interface {
+	mustEmbedUnimplementedSymbolicServer()
+}
+
+func RegisterSymbolicServer(s grpc.ServiceRegistrar, srv SymbolicServer) {
+	// If the following call pancis, it indicates UnimplementedSymbolicServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Symbolic_ServiceDesc, srv)
+}
+
+func _Symbolic_Differentiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DifferentiateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolicServer).Differentiate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Symbolic_Differentiate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolicServer).Differentiate(ctx, req.(*DifferentiateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Symbolic_Simplify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimplifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolicServer).Simplify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Symbolic_Simplify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolicServer).Simplify(ctx, req.(*SimplifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Symbolic_ServiceDesc is the grpc.ServiceDesc for Symbolic service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Symbolic_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "llamacalc.v1.Symbolic",
+	HandlerType: (*SymbolicServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Differentiate",
+			Handler:    _Symbolic_Differentiate_Handler,
+		},
+		{
+			MethodName: "Simplify",
+			Handler:    _Symbolic_Simplify_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "llamacalc/v1/symbolic.proto",
+}
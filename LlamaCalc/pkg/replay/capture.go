@@ -0,0 +1,115 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// captured prefix the methods that Capture records
+var captured = []string{"/llamacalc.v1.", "/proto.Calculator/", "/llamacalc.Calculator/"}
+
+// Capture returns an interceptor that writes the unary calls of the
+// LlamaCalc services to w, one JSON history.Record per line, numbered from
+// last+1; last is the number of the last record already in w, see LastSeq.
+// Streaming calls are not captured. engine describes the engine of the
+// server. Capturing is best effort: calls succeed even if they cannot be
+// written.
+func Capture(w io.Writer, engine history.Engine, last uint64) grpc.UnaryServerInterceptor {
+	var (
+		mu  sync.Mutex
+		seq = last
+	)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isCaptured(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		record := &history.Record{
+			Time:     start.UTC(),
+			Method:   info.FullMethod,
+			Request:  messageJSON(req),
+			Code:     status.Code(err).String(),
+			Engine:   engine,
+			Duration: time.Since(start),
+		}
+		if principal, ok := auth.PrincipalFromContext(ctx); ok {
+			record.Principal = principal.Name
+			record.Role = string(principal.Role)
+			record.Tenant = principal.Tenant
+		}
+		if err != nil {
+			record.Error = status.Convert(err).Message()
+			if kind := calcstatus.KindOf(calcstatus.FromStatus(err)); kind != pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+				record.ErrorKind = calcstatus.Reason(kind)
+			}
+		} else {
+			record.Response = messageJSON(resp)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		seq++
+		record.Seq = seq
+		if line, marshalErr := json.Marshal(record); marshalErr == nil {
+			w.Write(append(line, '\n'))
+		}
+		return resp, err
+	}
+}
+
+// LastSeq returns the number of the last record of a capture file, so that
+// a server appending to it continues the numbering, or 0 if the file does
+// not exist
+func LastSeq(path string) (uint64, error) {
+	var last uint64
+	err := history.ReadFile(path, func(r *history.Record) error {
+		last = r.Seq
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	return last, err
+}
+
+// isCaptured reports whether Capture records calls of a method
+func isCaptured(fullMethod string) bool {
+	for _, prefix := range captured {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return !strings.HasSuffix(fullMethod, "/Health")
+		}
+	}
+	return false
+}
+
+// messageJSON returns the JSON mapping of a message, or nil for other values
+func messageJSON(v interface{}) json.RawMessage {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	return data
+}
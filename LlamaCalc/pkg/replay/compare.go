@@ -0,0 +1,190 @@
+package replay
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// ignored are the response fields that differ between runs
+var ignored = map[protoreflect.Name]bool{
+	"duration_ns": true,
+}
+
+// Difference is a difference between a recorded and a replayed outcome
+type Difference struct {
+	// Path names the differing part, e.g. "result", "values[2]" or "code"
+	Path               string
+	Recorded, Replayed string
+	// Numeric is set for numbers, which differ by ULP units in the last
+	// place
+	Numeric bool
+	ULP     uint64
+}
+
+// Exceeds reports whether d is not a numeric difference of at most maxULP
+func (d Difference) Exceeds(maxULP uint64) bool {
+	return !d.Numeric || d.ULP > maxULP
+}
+
+// String describes d
+func (d Difference) String() string {
+	if d.Numeric {
+		return fmt.Sprintf("%s: recorded %s, replayed %s (%d ulp)", d.Path, d.Recorded, d.Replayed, d.ULP)
+	}
+	return fmt.Sprintf("%s: recorded %s, replayed %s", d.Path, d.Recorded, d.Replayed)
+}
+
+// ULP returns the number of representable float64 values between a and b,
+// which is 0 for equal values and math.MaxUint64 if one is NaN
+func ULP(a, b float64) uint64 {
+	switch {
+	case a == b, math.IsNaN(a) && math.IsNaN(b):
+		return 0
+	case math.IsNaN(a), math.IsNaN(b):
+		return math.MaxUint64
+	}
+	x, y := ordered(a), ordered(b)
+	if x < y {
+		x, y = y, x
+	}
+	return uint64(x) - uint64(y)
+}
+
+// ordered maps floats to integers of the same order, with consecutive
+// floats mapped to consecutive integers
+func ordered(f float64) int64 {
+	i := int64(math.Float64bits(f))
+	if i < 0 {
+		i = math.MinInt64 - i
+	}
+	return i
+}
+
+// compare returns the differences between the recorded outcome of r and
+// the replayed response or error
+func compare(r *history.Record, method protoreflect.MethodDescriptor, resp proto.Message, err error) ([]Difference, error) {
+	code := status.Code(err).String()
+	if code != r.Code {
+		recorded, replayed := r.Code, code
+		if r.Error != "" {
+			recorded += " (" + r.Error + ")"
+		}
+		if err != nil {
+			replayed += " (" + status.Convert(err).Message() + ")"
+		}
+		return []Difference{{Path: "code", Recorded: recorded, Replayed: replayed}}, nil
+	}
+
+	if err != nil {
+		kind := ""
+		if k := calcstatus.KindOf(calcstatus.FromStatus(err)); k != pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+			kind = calcstatus.Reason(k)
+		}
+		if kind != r.ErrorKind {
+			return []Difference{{Path: "error_kind", Recorded: r.ErrorKind, Replayed: kind}}, nil
+		}
+		return nil, nil
+	}
+
+	recorded, err := newMessage(method.Output())
+	if err == nil {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(r.Response, recorded)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var diffs []Difference
+	compareMessages(&diffs, "", recorded.ProtoReflect(), resp.ProtoReflect())
+	return diffs, nil
+}
+
+// compareMessages appends the differences between the fields of two
+// messages of the same type
+func compareMessages(diffs *[]Difference, prefix string, a, b protoreflect.Message) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if ignored[fd.Name()] {
+			continue
+		}
+		path := prefix + string(fd.Name())
+
+		switch {
+		case fd.IsList():
+			x, y := a.Get(fd).List(), b.Get(fd).List()
+			if x.Len() != y.Len() {
+				*diffs = append(*diffs, Difference{Path: path, Recorded: fmt.Sprintf("%d values", x.Len()), Replayed: fmt.Sprintf("%d values", y.Len())})
+				continue
+			}
+			for j := 0; j < x.Len(); j++ {
+				compareValues(diffs, fmt.Sprintf("%s[%d]", path, j), fd, x.Get(j), y.Get(j))
+			}
+
+		case fd.IsMap():
+			x, y := a.Get(fd).Map(), b.Get(fd).Map()
+			var keys []protoreflect.MapKey
+			x.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			y.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				if !x.Has(k) {
+					keys = append(keys, k)
+				}
+				return true
+			})
+			sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+			for _, k := range keys {
+				keyPath := fmt.Sprintf("%s[%s]", path, k.String())
+				if !x.Has(k) || !y.Has(k) {
+					*diffs = append(*diffs, Difference{Path: keyPath, Recorded: present(x.Has(k)), Replayed: present(y.Has(k))})
+					continue
+				}
+				compareValues(diffs, keyPath, fd.MapValue(), x.Get(k), y.Get(k))
+			}
+
+		default:
+			compareValues(diffs, path, fd, a.Get(fd), b.Get(fd))
+		}
+	}
+}
+
+// compareValues appends the difference between two values of a field
+func compareValues(diffs *[]Difference, path string, fd protoreflect.FieldDescriptor, x, y protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		compareMessages(diffs, path+".", x.Message(), y.Message())
+	case protoreflect.DoubleKind:
+		if ulp := ULP(x.Float(), y.Float()); ulp != 0 {
+			*diffs = append(*diffs, Difference{Path: path, Recorded: fmt.Sprint(x.Float()), Replayed: fmt.Sprint(y.Float()), Numeric: true, ULP: ulp})
+		}
+	case protoreflect.BytesKind:
+		if !bytes.Equal(x.Bytes(), y.Bytes()) {
+			*diffs = append(*diffs, Difference{Path: path, Recorded: fmt.Sprintf("%x", x.Bytes()), Replayed: fmt.Sprintf("%x", y.Bytes())})
+		}
+	default:
+		if x.Interface() != y.Interface() {
+			*diffs = append(*diffs, Difference{Path: path, Recorded: fmt.Sprint(x.Interface()), Replayed: fmt.Sprint(y.Interface())})
+		}
+	}
+}
+
+// present describes whether a map entry is present
+func present(ok bool) string {
+	if ok {
+		return "present"
+	}
+	return "absent"
+}
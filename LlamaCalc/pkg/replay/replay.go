@@ -0,0 +1,204 @@
+// Package replay runs recorded calculations again and compares the results,
+// e.g. to check that a new build computes the same numbers.
+//
+// Calculations are read as history.Records, from the segments of a history
+// store or from a capture file written by Capture. Each is replayed against
+// a Target, either a server or an engine in process, and the numbers of the
+// responses are compared by their distance in units in the last place.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calculator"
+	"llamacalc/pkg/history"
+	_ "llamacalc/pkg/proto"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// ErrNotReplayable reports a calculation that cannot be replayed, e.g. one
+// that depends on the state of a session
+var ErrNotReplayable = errors.New("not replayable")
+
+// stateful are the services whose calls depend on or change server state
+var stateful = map[string]bool{
+	pb.Session_ServiceDesc.ServiceName: true,
+	pb.Library_ServiceDesc.ServiceName: true,
+	pb.History_ServiceDesc.ServiceName: true,
+}
+
+// Target performs replayed calculations
+type Target interface {
+	// Invoke calls the method of r with req and returns the response
+	Invoke(ctx context.Context, r *history.Record, req proto.Message) (proto.Message, error)
+}
+
+// connTarget calls the methods of a server
+type connTarget struct {
+	conn grpc.ClientConnInterface
+}
+
+// ConnTarget returns a target that calls the methods on conn
+func ConnTarget(conn grpc.ClientConnInterface) Target {
+	return &connTarget{conn: conn}
+}
+
+// Invoke implements Target
+func (t *connTarget) Invoke(ctx context.Context, r *history.Record, req proto.Message) (proto.Message, error) {
+	method, err := lookupMethod(r.Method)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := newMessage(method.Output())
+	if err != nil {
+		return nil, err
+	}
+	if err := t.conn.Invoke(ctx, r.Method, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// engineTarget serves the Calculator service in process
+type engineTarget struct {
+	mu       sync.Mutex
+	services map[history.Engine]*calculator.Service
+}
+
+// EngineTarget returns a target that performs the methods of the
+// Calculator service with a calc.Calculator in process, using the rounding
+// settings of each record. Other methods are not replayable. Custom
+// operations, units and exchange rates of the recording server are not
+// known to it.
+func EngineTarget() Target {
+	return &engineTarget{services: make(map[history.Engine]*calculator.Service)}
+}
+
+// Invoke implements Target
+func (t *engineTarget) Invoke(ctx context.Context, r *history.Record, req proto.Message) (proto.Message, error) {
+	name, ok := strings.CutPrefix(r.Method, "/"+pb.Calculator_ServiceDesc.ServiceName+"/")
+	if !ok {
+		return nil, fmt.Errorf("%w in process: %s", ErrNotReplayable, r.Method)
+	}
+	for _, method := range pb.Calculator_ServiceDesc.Methods {
+		if method.MethodName != name {
+			continue
+		}
+		decode := func(v interface{}) error {
+			proto.Merge(v.(proto.Message), req)
+			return nil
+		}
+		resp, err := method.Handler(t.service(r.Engine), ctx, decode, nil)
+		if err != nil {
+			return nil, err
+		}
+		return resp.(proto.Message), nil
+	}
+	return nil, fmt.Errorf("%w in process: %s", ErrNotReplayable, r.Method)
+}
+
+// service returns the Calculator service of an engine configuration. The
+// settings of records without them are the defaults.
+func (t *engineTarget) service(engine history.Engine) *calculator.Service {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	engine.Version = ""
+	if s, ok := t.services[engine]; ok {
+		return s
+	}
+	c := calc.NewDefaultCalculator()
+	if engine.MaxPrecision != 0 || engine.MaxDecimalPlaces != 0 {
+		c = calc.NewCalculator(engine.MaxPrecision, engine.MaxDecimalPlaces, engine.OverflowCheck)
+	}
+	s := calculator.NewService(c)
+	t.services[engine] = s
+	return s
+}
+
+// Result is the outcome of replaying a record
+type Result struct {
+	Record *history.Record
+	// Err is why the record was not replayed, e.g. ErrNotReplayable
+	Err error
+	// Differences between the recorded and the replayed outcome
+	Differences []Difference
+}
+
+// Exceeds reports whether the result differs by more than maxULP units in
+// the last place, or in anything but numbers
+func (res *Result) Exceeds(maxULP uint64) bool {
+	for _, d := range res.Differences {
+		if d.Exceeds(maxULP) {
+			return true
+		}
+	}
+	return false
+}
+
+// Replay performs the calculation of r on target and compares the outcome
+// with the recorded one
+func Replay(ctx context.Context, target Target, r *history.Record) *Result {
+	res := &Result{Record: r}
+
+	method, err := lookupMethod(r.Method)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	if stateful[string(method.Parent().FullName())] || method.IsStreamingClient() || method.IsStreamingServer() {
+		res.Err = fmt.Errorf("%w: %s", ErrNotReplayable, r.Method)
+		return res
+	}
+
+	req, err := newMessage(method.Input())
+	if err == nil {
+		err = protojson.Unmarshal(r.Request, req)
+	}
+	if err != nil {
+		res.Err = fmt.Errorf("failed to decode request: %w", err)
+		return res
+	}
+
+	resp, err := target.Invoke(ctx, r, req)
+	if errors.Is(err, ErrNotReplayable) {
+		res.Err = err
+		return res
+	}
+	res.Differences, res.Err = compare(r, method, resp, err)
+	return res
+}
+
+// lookupMethod returns the descriptor of a full gRPC method
+func lookupMethod(fullMethod string) (protoreflect.MethodDescriptor, error) {
+	service, name, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if ok {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+		if service, isService := desc.(protoreflect.ServiceDescriptor); err == nil && isService {
+			if method := service.Methods().ByName(protoreflect.Name(name)); method != nil {
+				return method, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown method %s", ErrNotReplayable, fullMethod)
+}
+
+// newMessage returns an empty message of a type
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown message %s", ErrNotReplayable, desc.FullName())
+	}
+	return mt.New().Interface(), nil
+}
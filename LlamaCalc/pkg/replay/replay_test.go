@@ -0,0 +1,167 @@
+package replay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"llamacalc/pkg/calctest"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/replay"
+	"llamacalc/pkg/server"
+)
+
+func TestULP(t *testing.T) {
+	tests := []struct {
+		A, B float64
+		Want uint64
+	}{
+		{1, 1, 0},
+		{0, math.Copysign(0, -1), 0},
+		{1, math.Nextafter(1, 2), 1},
+		{1, math.Nextafter(math.Nextafter(1, 0), 0), 2},
+		{-1, math.Nextafter(-1, -2), 1},
+		{math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64, 2},
+		{math.MaxFloat64, math.Inf(1), 1},
+		{math.NaN(), math.NaN(), 0},
+		{math.NaN(), 1, math.MaxUint64},
+	}
+	for _, tc := range tests {
+		if got := replay.ULP(tc.A, tc.B); got != tc.Want {
+			t.Errorf("ULP(%g, %g) = %d, want %d", tc.A, tc.B, got, tc.Want)
+		}
+		if got := replay.ULP(tc.B, tc.A); got != tc.Want {
+			t.Errorf("ULP(%g, %g) = %d, want %d", tc.B, tc.A, got, tc.Want)
+		}
+	}
+}
+
+// capture records calculations of a test server and returns the records
+// and the server
+func capture(t *testing.T) ([]*history.Record, *calctest.Server) {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	s := calctest.NewServer(t, calctest.WithServerConfig(func(config *server.Config) {
+		config.UnaryInterceptors = append(config.UnaryInterceptors, replay.Capture(file, history.Engine{
+			MaxPrecision:     config.MaxPrecision,
+			MaxDecimalPlaces: config.MaxDecimalPlaces,
+			OverflowCheck:    config.OverflowCheckEnabled,
+		}, 0))
+	}))
+	c := s.Client()
+	ctx := context.Background()
+	c.Add(ctx, 0.1, 0.2)
+	c.Divide(ctx, 1, 0)
+	c.Evaluate(ctx, "sqrt(2) * sin(1)")
+	c.Statistics(ctx, []float64{1, 2, 3, 4})
+	c.Session().CreateSession(ctx, &pb.CreateSessionRequest{})
+
+	var records []*history.Record
+	err = history.ReadFile(path, func(r *history.Record) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 {
+		t.Fatalf("captured %d calculations, want 5", len(records))
+	}
+	return records, s
+}
+
+func TestCaptureAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "capture.jsonl")
+
+	// Each server appends to the file and continues the numbering
+	for run := 0; run < 2; run++ {
+		last, err := replay.LastSeq(path)
+		if err != nil || last != uint64(run) {
+			t.Fatalf("run %d: got last record %d, %v", run+1, last, err)
+		}
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := calctest.NewServer(t, calctest.WithServerConfig(func(config *server.Config) {
+			config.UnaryInterceptors = append(config.UnaryInterceptors, replay.Capture(file, history.Engine{}, last))
+		}))
+		s.Client().Add(context.Background(), 1, 2)
+		s.Close()
+		file.Close()
+	}
+
+	var seqs []uint64
+	err := history.ReadFile(path, func(r *history.Record) error {
+		seqs = append(seqs, r.Seq)
+		return nil
+	})
+	if err != nil || len(seqs) != 2 || seqs[0] != 1 || seqs[1] != 2 {
+		t.Errorf("got records %v, %v, want 1 and 2", seqs, err)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	records, s := capture(t)
+	targets := map[string]replay.Target{
+		"engine": replay.EngineTarget(),
+		"server": replay.ConnTarget(s.Client().Conn()),
+	}
+
+	for name, target := range targets {
+		t.Run(name, func(t *testing.T) {
+			for _, r := range records {
+				res := replay.Replay(context.Background(), target, r)
+				if r.Method == pb.Session_CreateSession_FullMethodName {
+					if !errors.Is(res.Err, replay.ErrNotReplayable) {
+						t.Errorf("%s: got %v, want ErrNotReplayable", r.Method, res.Err)
+					}
+					continue
+				}
+				if res.Err != nil || len(res.Differences) != 0 {
+					t.Errorf("%s: got %v, %v", r.Method, res.Differences, res.Err)
+				}
+			}
+		})
+	}
+}
+
+func TestDifferences(t *testing.T) {
+	records, _ := capture(t)
+	target := replay.EngineTarget()
+
+	// A result one ulp off is within a tolerance of one ulp
+	add := *records[0]
+	var resp map[string]interface{}
+	if err := json.Unmarshal(add.Response, &resp); err != nil {
+		t.Fatal(err)
+	}
+	resp["result"] = math.Nextafter(resp["result"].(float64), 1)
+	add.Response, _ = json.Marshal(resp)
+
+	res := replay.Replay(context.Background(), target, &add)
+	if res.Err != nil || len(res.Differences) != 1 || res.Differences[0].Path != "result" || res.Differences[0].ULP != 1 {
+		t.Fatalf("got %v, %v, want a difference of 1 ulp in result", res.Differences, res.Err)
+	}
+	if !res.Exceeds(0) || res.Exceeds(1) {
+		t.Errorf("Exceeds(0) = %v, Exceeds(1) = %v", res.Exceeds(0), res.Exceeds(1))
+	}
+
+	// A calculation that failed differently always exceeds the tolerance
+	divide := *records[1]
+	divide.Code, divide.ErrorKind = "OK", ""
+	res = replay.Replay(context.Background(), target, &divide)
+	if res.Err != nil || len(res.Differences) != 1 || res.Differences[0].Path != "code" || !res.Exceeds(math.MaxUint64) {
+		t.Errorf("got %v, %v, want a difference in code", res.Differences, res.Err)
+	}
+}
@@ -0,0 +1,307 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calculator"
+	"llamacalc/pkg/finance"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// financeService implements the Finance service with package finance
+type financeService struct {
+	pb.UnimplementedFinanceServer
+}
+
+// PresentValue implements the PresentValue RPC method
+func (s *financeService) PresentValue(ctx context.Context, req *pb.TimeValueRequest) (*pb.FinanceResponse, error) {
+	return s.timeValue(req, finance.DefaultPlaces, finance.PV)
+}
+
+// FutureValue implements the FutureValue RPC method
+func (s *financeService) FutureValue(ctx context.Context, req *pb.TimeValueRequest) (*pb.FinanceResponse, error) {
+	return s.timeValue(req, finance.DefaultPlaces, finance.FV)
+}
+
+// Payment implements the Payment RPC method
+func (s *financeService) Payment(ctx context.Context, req *pb.TimeValueRequest) (*pb.FinanceResponse, error) {
+	return s.timeValue(req, finance.DefaultPlaces, finance.PMT)
+}
+
+// Periods implements the Periods RPC method
+func (s *financeService) Periods(ctx context.Context, req *pb.TimeValueRequest) (*pb.FinanceResponse, error) {
+	return s.timeValue(req, finance.DefaultRatePlaces, finance.NPER)
+}
+
+// timeValue solves the time value of money equation with fn, rounding to
+// places decimal places unless the request sets them
+func (s *financeService) timeValue(req *pb.TimeValueRequest, places int, fn func(finance.TimeValue, finance.Rounding) (calc.Decimal, error)) (*pb.FinanceResponse, error) {
+	start := time.Now()
+
+	tv, err := timeValue(req)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	result, err := fn(tv, rounding(req.Rounding, places))
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.FinanceResponse{
+		Result:     result.String(),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// Rate implements the Rate RPC method
+func (s *financeService) Rate(ctx context.Context, req *pb.TimeValueRequest) (*pb.FinanceResponse, error) {
+	start := time.Now()
+
+	tv, err := timeValue(req)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	guess, err := parseGuess(req.Guess)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	solution, err := finance.RATE(tv, guess, rounding(req.Rounding, finance.DefaultRatePlaces))
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return solutionResponse(solution, start), nil
+}
+
+// NetPresentValue implements the NetPresentValue RPC method
+func (s *financeService) NetPresentValue(ctx context.Context, req *pb.CashFlowRequest) (*pb.FinanceResponse, error) {
+	start := time.Now()
+
+	values, dates, err := cashFlows(req)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	rate, err := parseDecimal("rate", req.Rate)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	rd := rounding(req.Rounding, finance.DefaultPlaces)
+	var result calc.Decimal
+	if dates == nil {
+		result, err = finance.NPV(rate, values, rd)
+	} else {
+		result, err = finance.XNPV(rate, values, dates, rd)
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.FinanceResponse{
+		Result:     result.String(),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// InternalRate implements the InternalRate RPC method
+func (s *financeService) InternalRate(ctx context.Context, req *pb.CashFlowRequest) (*pb.FinanceResponse, error) {
+	start := time.Now()
+
+	values, dates, err := cashFlows(req)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	guess, err := parseGuess(req.Guess)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	rd := rounding(req.Rounding, finance.DefaultRatePlaces)
+	var solution finance.Solution
+	if dates == nil {
+		solution, err = finance.IRR(values, guess, rd)
+	} else {
+		solution, err = finance.XIRR(values, dates, guess, rd)
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return solutionResponse(solution, start), nil
+}
+
+// CompoundInterest implements the CompoundInterest RPC method
+func (s *financeService) CompoundInterest(ctx context.Context, req *pb.CompoundInterestRequest) (*pb.CompoundInterestResponse, error) {
+	start := time.Now()
+
+	principal, err := parseDecimal("principal", req.Principal)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	rate, err := parseDecimal("rate", req.Rate)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	years, err := parseDecimal("years", req.Years)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	periodsPerYear := int(min(req.PeriodsPerYear, finance.MaxPeriods+1))
+	result, err := finance.CompoundInterest(principal, rate, periodsPerYear, years, rounding(req.Rounding, finance.DefaultPlaces))
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.CompoundInterestResponse{
+		Amount:     result.Amount.String(),
+		Interest:   result.Interest.String(),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// Amortize implements the Amortize RPC method
+func (s *financeService) Amortize(ctx context.Context, req *pb.AmortizeRequest) (*pb.AmortizeResponse, error) {
+	start := time.Now()
+
+	rate, err := parseDecimal("rate", req.Rate)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	principal, err := parseDecimal("principal", req.Principal)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	periods := int(min(req.Periods, finance.MaxPeriods+1))
+	schedule, err := finance.Amortize(rate, periods, principal, rounding(req.Rounding, finance.DefaultPlaces))
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	resp := &pb.AmortizeResponse{
+		Payment:       schedule.Payment.String(),
+		Installments:  make([]*pb.Installment, len(schedule.Installments)),
+		TotalPayments: schedule.TotalPayments.String(),
+		TotalInterest: schedule.TotalInterest.String(),
+	}
+	for i, installment := range schedule.Installments {
+		resp.Installments[i] = &pb.Installment{
+			Period:    uint32(installment.Period),
+			Payment:   installment.Payment.String(),
+			Interest:  installment.Interest.String(),
+			Principal: installment.Principal.String(),
+			Balance:   installment.Balance.String(),
+		}
+	}
+	resp.DurationNs = time.Since(start).Nanoseconds()
+	return resp, nil
+}
+
+// timeValue parses the terms of a time value request
+func timeValue(req *pb.TimeValueRequest) (finance.TimeValue, error) {
+	tv := finance.TimeValue{
+		Periods: int(min(req.Periods, finance.MaxPeriods+1)),
+		Due:     req.Due,
+	}
+	terms := []struct {
+		field string
+		value string
+		dest  *calc.Decimal
+	}{
+		{"rate", req.Rate, &tv.Rate},
+		{"payment", req.Payment, &tv.Payment},
+		{"present_value", req.PresentValue, &tv.Present},
+		{"future_value", req.FutureValue, &tv.Future},
+	}
+	for _, term := range terms {
+		d, err := parseDecimal(term.field, term.value)
+		if err != nil {
+			return finance.TimeValue{}, err
+		}
+		*term.dest = d
+	}
+	return tv, nil
+}
+
+// cashFlows parses the values and, if there are any, the dates of a cash
+// flow request
+func cashFlows(req *pb.CashFlowRequest) ([]calc.Decimal, []time.Time, error) {
+	if len(req.Values) > finance.MaxCashFlows {
+		return nil, nil, &calc.FieldError{Field: "values", Err: fmt.Errorf("%w: there must be from 1 to %d cash flows", calc.ErrInvalidInput, finance.MaxCashFlows)}
+	}
+	values := make([]calc.Decimal, len(req.Values))
+	for i, value := range req.Values {
+		d, err := parseDecimal(fmt.Sprintf("values[%d]", i), value)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[i] = d
+	}
+
+	if len(req.Dates) == 0 {
+		return values, nil, nil
+	}
+	dates := make([]time.Time, len(req.Dates))
+	for i, date := range req.Dates {
+		t, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return nil, nil, &calc.FieldError{
+				Field: fmt.Sprintf("dates[%d]", i),
+				Err:   fmt.Errorf("%w: %q is not a date YYYY-MM-DD", calc.ErrInvalidInput, date),
+			}
+		}
+		dates[i] = t
+	}
+	return values, dates, nil
+}
+
+// parseDecimal parses a decimal field, which is 0 if empty
+func parseDecimal(field, s string) (calc.Decimal, error) {
+	if s == "" {
+		return calc.Decimal{}, nil
+	}
+	d, err := calc.ParseDecimal(s)
+	if err != nil {
+		return calc.Decimal{}, &calc.FieldError{Field: field, Err: err}
+	}
+	return d, nil
+}
+
+// parseGuess parses the starting rate of a solver, which is
+// finance.DefaultGuess if empty
+func parseGuess(s string) (float64, error) {
+	if s == "" {
+		return finance.DefaultGuess, nil
+	}
+	guess, err := calc.ParseDecimal(s)
+	if err != nil {
+		return 0, &calc.FieldError{Field: "guess", Err: err}
+	}
+	return guess.Float64(), nil
+}
+
+// rounding returns the rounding of a request with places decimal places if
+// the request does not set them
+func rounding(r *pb.Rounding, places int) finance.Rounding {
+	rd := finance.Rounding{Places: places, Mode: calculator.FromRoundingMode(r.GetMode())}
+	if r != nil && r.Places != nil {
+		rd.Places = int(*r.Places)
+	}
+	return rd
+}
+
+// solutionResponse creates the response message of a solver result
+func solutionResponse(solution finance.Solution, start time.Time) *pb.FinanceResponse {
+	return &pb.FinanceResponse{
+		Result: solution.Value.String(),
+		Convergence: &pb.Convergence{
+			Iterations: uint32(solution.Iterations),
+			Residual:   solution.Residual,
+		},
+		DurationNs: time.Since(start).Nanoseconds(),
+	}
+}
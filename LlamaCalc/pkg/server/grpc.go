@@ -1,7 +1,6 @@
 package server
 
 import (
-	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -11,21 +10,28 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
+	"llamacalc/pkg/auth"
 	"llamacalc/pkg/calc"
-	pb "llamacalc/pkg/proto"
+	"llamacalc/pkg/calculator"
+	"llamacalc/pkg/history"
+	"llamacalc/pkg/library"
+	"llamacalc/pkg/monitoring"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/session"
 )
 
 // GRPCServer represents the LlamaCalc gRPC server
 type GRPCServer struct {
-	pb.UnimplementedCalculatorServer
-	pb.UnimplementedHealthServiceServer
+	*calculator.Service
 
 	calculator   *calc.Calculator
 	server       *grpc.Server
+	health       *health.Server
 	config       *Config
 	port         int
 	tlsEnabled   bool
@@ -33,6 +39,7 @@ type GRPCServer struct {
 	keyFile      string
 	caFile       string
 	interceptors []grpc.UnaryServerInterceptor
+	streams      []grpc.StreamServerInterceptor
 }
 
 // Config contains the configuration for the GRPCServer
@@ -54,19 +61,80 @@ type Config struct {
 	RateLimitEnabled     bool
 	AuthEnabled          bool
 	RBACEnabled          bool
+	JWTSecretKey         string
+	JWTTokenDuration     time.Duration
 	MaxPrecision         int
 	MaxDecimalPlaces     int
 	OverflowCheckEnabled bool
+
+	// Operations are registered with the engine in addition to the built-in
+	// ones. They can be called through Invoke and are covered by RBAC and
+	// metrics like the built-in operations.
+	Operations []*calc.Operation
+
+	// UnitsFile optionally names a unit definitions file whose units are
+	// added to the built-in ones, see calc.UnitRegistry.Load
+	UnitsFile string
+
+	// RatesFile optionally names an exchange-rate table used by
+	// ConvertCurrency, see calc.ParseRateTable
+	RatesFile string
+
+	// SessionStore keeps the sessions of the Session service; nil means a
+	// session.MemoryStore with the default TTL and capacity
+	SessionStore session.Store
+
+	// SessionLimits bound the sessions of each principal; zero limits mean
+	// session.DefaultLimits
+	SessionLimits session.Limits
+
+	// LibraryStore keeps the user-defined functions and constants of the
+	// Library service; nil means a library.MemoryStore
+	LibraryStore library.Store
+
+	// HistoryStore records the calls of every service but History for
+	// audit; nil records nothing
+	HistoryStore history.Store
+
+	// HistoryOperations switches recording on or off by metric operation
+	// label, e.g. {"STATISTICS": false}; operations missing from it are
+	// recorded
+	HistoryOperations map[string]bool
+
+	// EngineVersion is recorded with the calculations, e.g. the build
+	// version
+	EngineVersion string
+
+	// UnaryInterceptors are run after the built-in interceptors, in order
+	UnaryInterceptors []grpc.UnaryServerInterceptor
+	// StreamInterceptors are run after the built-in stream interceptors, in
+	// order
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 // NewGRPCServer creates a new gRPC server
 func NewGRPCServer(config *Config) (*GRPCServer, error) {
-	// Create calculator service
-	calculator := calc.NewCalculator(
+	// Create calculation engine
+	engine := calc.NewCalculator(
 		config.MaxPrecision,
 		config.MaxDecimalPlaces,
 		config.OverflowCheckEnabled,
 	)
+	if err := engine.Registry.Register(config.Operations...); err != nil {
+		return nil, fmt.Errorf("failed to register operations: %v", err)
+	}
+	if config.UnitsFile != "" {
+		if err := engine.Units.LoadFile(config.UnitsFile); err != nil {
+			return nil, fmt.Errorf("failed to load units: %v", err)
+		}
+	}
+	if config.RatesFile != "" {
+		rates, err := calc.LoadRateTable(config.RatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load exchange rates: %v", err)
+		}
+		engine.Rates = rates
+	}
 
 	// Initialize server options
 	var opts []grpc.ServerOption
@@ -99,13 +167,11 @@ func NewGRPCServer(config *Config) (*GRPCServer, error) {
 		opts = append(opts, grpc.Creds(creds))
 	}
 
-	// Create gRPC server
-	server := grpc.NewServer(opts...)
-
 	// Create server
 	s := &GRPCServer{
-		calculator: calculator,
-		server:     server,
+		Service:    calculator.NewService(engine),
+		calculator: engine,
+		health:     health.NewServer(),
 		config:     config,
 		port:       config.Port,
 		tlsEnabled: config.TLSEnabled,
@@ -114,9 +180,57 @@ func NewGRPCServer(config *Config) (*GRPCServer, error) {
 		caFile:     config.CAFile,
 	}
 
+	// Setup interceptors
+	if config.MetricsEnabled {
+		collector := monitoring.DefaultMetricsCollector()
+		s.interceptors = append(s.interceptors, collector.MetricsInterceptor(s.metricLabels))
+		s.streams = append(s.streams, collector.StreamMetricsInterceptor(s.metricLabels))
+	}
+	if config.AuthEnabled {
+		jwtManager := auth.NewJWTManager(config.JWTSecretKey, config.JWTTokenDuration)
+		authInterceptor := auth.NewAuthInterceptor(jwtManager, s.policy)
+		s.interceptors = append(s.interceptors, authInterceptor.Unary())
+		s.streams = append(s.streams, authInterceptor.Stream())
+	}
+	libraryStore := config.LibraryStore
+	if libraryStore == nil {
+		libraryStore = library.NewMemoryStore()
+	}
+	libraries := library.NewManager(libraryStore, engine.Registry)
+	s.interceptors = append(s.interceptors, s.libraryInterceptor(libraries))
+	if config.HistoryStore != nil {
+		s.interceptors = append(s.interceptors, s.historyInterceptor(config.HistoryStore))
+		s.streams = append(s.streams, s.historyStreamInterceptor(config.HistoryStore))
+	}
+	s.interceptors = append(s.interceptors, config.UnaryInterceptors...)
+	s.streams = append(s.streams, config.StreamInterceptors...)
+	opts = append(opts, grpc.ChainUnaryInterceptor(s.interceptors...))
+	opts = append(opts, grpc.ChainStreamInterceptor(s.streams...))
+
+	// Create gRPC server
+	server := grpc.NewServer(opts...)
+	s.server = server
+
 	// Register services
 	pb.RegisterCalculatorServer(server, s)
-	pb.RegisterHealthServiceServer(server, s)
+	pb.RegisterLinearAlgebraServer(server, newLinalgService(config.MaxRecvMsgSize))
+	pb.RegisterProgrammerServer(server, &programmerService{})
+	pb.RegisterFinanceServer(server, &financeService{})
+	pb.RegisterNumericsServer(server, &numericsService{engine: engine})
+	pb.RegisterSymbolicServer(server, &symbolicService{})
+	pb.RegisterSessionServer(server, &sessionService{sessions: session.NewManager(engine, config.SessionStore, config.SessionLimits)})
+	pb.RegisterLibraryServer(server, &libraryService{libraries: libraries})
+	pb.RegisterHistoryServer(server, &historyService{store: config.HistoryStore})
+	grpc_health_v1.RegisterHealthServer(server, s.health)
+
+	// Keep serving the deprecated service names during migration
+	registerLegacyServices(server, s, s.health)
+
+	// Report every registered service as serving until Stop is called.
+	// Clients use this for health-checked load balancing.
+	for name := range server.GetServiceInfo() {
+		s.health.SetServingStatus(name, grpc_health_v1.HealthCheckResponse_SERVING)
+	}
 
 	// Enable reflection if not in production
 	// This helps with debugging tools like grpcurl
@@ -136,7 +250,7 @@ func (s *GRPCServer) Start() error {
 	// Start server in a goroutine
 	go func() {
 		fmt.Printf("Starting LlamaCalc gRPC server on port %d...\n", s.port)
-		if err := s.server.Serve(lis); err != nil {
+		if err := s.Serve(lis); err != nil {
 			fmt.Printf("Failed to serve: %v\n", err)
 		}
 	}()
@@ -144,116 +258,19 @@ func (s *GRPCServer) Start() error {
 	return nil
 }
 
+// Serve accepts connections on lis and blocks until the server is stopped
+func (s *GRPCServer) Serve(lis net.Listener) error {
+	return s.server.Serve(lis)
+}
+
 // Stop stops the gRPC server
 func (s *GRPCServer) Stop() {
 	fmt.Println("Stopping LlamaCalc gRPC server...")
+	s.health.Shutdown()
 	s.server.GracefulStop()
 	fmt.Println("LlamaCalc gRPC server stopped")
 }
 
-// Health check implementation
-func (s *GRPCServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	// TODO: Implement real health checking logic
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	}, nil
-}
-
-func (s *GRPCServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
-	// TODO: Implement real health watching logic
-	return stream.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
-}
-
-// Add implements the Add RPC
-func (s *GRPCServer) Add(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	result := s.calculator.Add(ctx, req.A, req.B)
-
-	// Convert to gRPC response
-	response := &pb.CalculationResponse{
-		Result:     result.Value,
-		StatusCode: 200,
-		Operation:  result.Operation,
-		DurationNs: result.Duration.Nanoseconds(),
-	}
-
-	if result.Error != nil {
-		response.StatusCode = 400
-		response.ErrorMessage = result.Error.Error()
-	}
-
-	return response, nil
-}
-
-// Subtract implements the Subtract RPC
-func (s *GRPCServer) Subtract(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	result := s.calculator.Subtract(ctx, req.A, req.B)
-
-	// Convert to gRPC response
-	response := &pb.CalculationResponse{
-		Result:     result.Value,
-		StatusCode: 200,
-		Operation:  result.Operation,
-		DurationNs: result.Duration.Nanoseconds(),
-	}
-
-	if result.Error != nil {
-		response.StatusCode = 400
-		response.ErrorMessage = result.Error.Error()
-	}
-
-	return response, nil
-}
-
-// Multiply implements the Multiply RPC
-func (s *GRPCServer) Multiply(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	result := s.calculator.Multiply(ctx, req.A, req.B)
-
-	// Convert to gRPC response
-	response := &pb.CalculationResponse{
-		Result:     result.Value,
-		StatusCode: 200,
-		Operation:  result.Operation,
-		DurationNs: result.Duration.Nanoseconds(),
-	}
-
-	if result.Error != nil {
-		response.StatusCode = 400
-		response.ErrorMessage = result.Error.Error()
-	}
-
-	return response, nil
-}
-
-// Divide implements the Divide RPC
-func (s *GRPCServer) Divide(ctx context.Context, req *pb.CalculationRequest) (*pb.CalculationResponse, error) {
-	result := s.calculator.Divide(ctx, req.A, req.B)
-
-	// Convert to gRPC response
-	response := &pb.CalculationResponse{
-		Result:     result.Value,
-		StatusCode: 200,
-		Operation:  result.Operation,
-		DurationNs: result.Duration.Nanoseconds(),
-	}
-
-	if result.Error != nil {
-		response.StatusCode = 400
-		response.ErrorMessage = result.Error.Error()
-	}
-
-	return response, nil
-}
-
-// Health implements the Health RPC for the HealthService
-func (s *GRPCServer) Health(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
-	// TODO: Implement real health checking logic
-	return &pb.HealthCheckResponse{
-		Status: pb.HealthCheckResponse_SERVING,
-	}, nil
-}
-
 // Helper function to load TLS credentials
 func loadTLSCredentials(certFile, keyFile string) (credentials.TransportCredentials, error) {
 	// Load server key pair
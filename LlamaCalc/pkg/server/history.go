@@ -0,0 +1,275 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// historyService implements the History service with a history store.
+// Callers without the ADMIN role only see their own calculations.
+type historyService struct {
+	pb.UnimplementedHistoryServer
+	store history.Store
+}
+
+// QueryHistory implements the QueryHistory RPC method
+func (s *historyService) QueryHistory(ctx context.Context, req *pb.QueryHistoryRequest) (*pb.QueryHistoryResponse, error) {
+	if s.store == nil {
+		return nil, status.Error(codes.FailedPrecondition, "calculations are not recorded")
+	}
+
+	q := history.Query{
+		Principal: req.Principal,
+		Operation: strings.ToUpper(req.Operation),
+		Limit:     int(req.PageSize),
+	}
+	if req.PageSize < 0 {
+		return nil, calcstatus.ToStatus(&calc.FieldError{Field: "page_size", Err: fmt.Errorf("%w: page size must not be negative", calc.ErrInvalidInput)})
+	}
+	if req.PageToken != "" {
+		after, err := strconv.ParseUint(req.PageToken, 10, 64)
+		if err != nil {
+			return nil, calcstatus.ToStatus(&calc.FieldError{Field: "page_token", Err: fmt.Errorf("%w: invalid page token", calc.ErrInvalidInput)})
+		}
+		q.After = after
+	}
+	if req.StartTimeNs != 0 {
+		q.Since = time.Unix(0, req.StartTimeNs)
+	}
+	if req.EndTimeNs != 0 {
+		q.Until = time.Unix(0, req.EndTimeNs)
+	}
+	if req.ErrorKind != pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+		q.ErrorKind = calcstatus.Reason(req.ErrorKind)
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && !principal.Role.Allows(auth.RoleAdmin) {
+		if q.Principal != "" && q.Principal != principal.Name {
+			return nil, status.Error(codes.PermissionDenied, "no permission to query the calculations of other principals")
+		}
+		q.Principal = principal.Name
+	}
+
+	page, err := s.store.Query(ctx, q)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	resp := &pb.QueryHistoryResponse{Records: make([]*pb.CalculationRecord, len(page.Records))}
+	for i, record := range page.Records {
+		resp.Records[i] = calculationRecord(record)
+	}
+	if page.Next != 0 {
+		resp.NextPageToken = strconv.FormatUint(page.Next, 10)
+	}
+	return resp, nil
+}
+
+// historyInterceptor records the calls of the services other than History
+// in store, unless Config.HistoryOperations switches their operation off.
+// Calls fail if they cannot be recorded.
+func (s *GRPCServer) historyInterceptor(store history.Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !recordable(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		operation, module := s.metricLabels(info.FullMethod, req)
+		if on, ok := s.config.HistoryOperations[operation]; ok && !on {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		record := s.historyRecord(ctx, info.FullMethod, start, err)
+		record.Operation, record.Module = operation, module
+		record.Request = messageJSON(req)
+		if err == nil {
+			record.Response = messageJSON(resp)
+		}
+
+		if appendErr := store.Append(context.WithoutCancel(ctx), record); appendErr != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record calculation: %v", appendErr)
+		}
+		return resp, err
+	}
+}
+
+// historyStreamInterceptor records streaming calls like historyInterceptor.
+// The request of a record is the JSON array of the received messages, of
+// which those beyond Config.MaxRecvMsgSize bytes in total are left out, and
+// the response is the last message sent.
+func (s *GRPCServer) historyStreamInterceptor(store history.Store) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !recordable(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		operation, module := s.metricLabels(info.FullMethod, nil)
+		if on, ok := s.config.HistoryOperations[operation]; ok && !on {
+			return handler(srv, stream)
+		}
+
+		start := time.Now()
+		recorded := &recordedStream{ServerStream: stream, limit: s.config.MaxRecvMsgSize}
+		err := handler(srv, recorded)
+
+		ctx := stream.Context()
+		record := s.historyRecord(ctx, info.FullMethod, start, err)
+		record.Operation, record.Module = operation, module
+		record.Request = recorded.request()
+		if err == nil {
+			record.Response = recorded.response
+		}
+
+		if appendErr := store.Append(context.WithoutCancel(ctx), record); appendErr != nil {
+			return status.Errorf(codes.Internal, "failed to record calculation: %v", appendErr)
+		}
+		return err
+	}
+}
+
+// historyRecord creates the record of a call of fullMethod that started at
+// start and ended with err, without its labels and messages
+func (s *GRPCServer) historyRecord(ctx context.Context, fullMethod string, start time.Time, err error) *history.Record {
+	record := &history.Record{
+		Time:   start.UTC(),
+		Method: fullMethod,
+		Code:   status.Code(err).String(),
+		Engine: history.Engine{
+			Version:          s.config.EngineVersion,
+			MaxPrecision:     s.config.MaxPrecision,
+			MaxDecimalPlaces: s.config.MaxDecimalPlaces,
+			OverflowCheck:    s.config.OverflowCheckEnabled,
+		},
+		Duration: time.Since(start),
+	}
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		record.Principal = principal.Name
+		record.Role = string(principal.Role)
+		record.Tenant = principal.Tenant
+	}
+	if err != nil {
+		record.Error = status.Convert(err).Message()
+		if kind := calcstatus.KindOf(calcstatus.FromStatus(err)); kind != pb.ErrorKind_ERROR_KIND_UNSPECIFIED {
+			record.ErrorKind = calcstatus.Reason(kind)
+		}
+	}
+	return record
+}
+
+// recordedStream is a server stream that keeps the JSON mapping of the
+// messages it receives, up to limit bytes, and of the last one it sends
+type recordedStream struct {
+	grpc.ServerStream
+
+	limit    int
+	size     int
+	received []json.RawMessage
+	response json.RawMessage
+}
+
+// RecvMsg receives a message and keeps it if it is within the limit
+func (s *recordedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if data := messageJSON(m); data != nil && s.size+len(data) <= s.limit {
+		s.size += len(data)
+		s.received = append(s.received, data)
+	}
+	return nil
+}
+
+// SendMsg sends a message and keeps it as the response
+func (s *recordedStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	s.response = messageJSON(m)
+	return nil
+}
+
+// request returns the received messages as a JSON array
+func (s *recordedStream) request() json.RawMessage {
+	if len(s.received) == 0 {
+		return json.RawMessage("[]")
+	}
+	data, err := json.Marshal(s.received)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// recordable reports whether calls of a method are recorded: those of the
+// calculator, except health checks, and of the services other than History
+func recordable(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, "/"+pb.History_ServiceDesc.ServiceName+"/") {
+		return false
+	}
+	if _, _, ok := lookupService(fullMethod); ok {
+		return true
+	}
+	for _, prefix := range operationServices {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return !strings.HasSuffix(fullMethod, "/Health")
+		}
+	}
+	return false
+}
+
+// messageJSON returns the JSON mapping of a message, or nil for other values
+func messageJSON(v interface{}) json.RawMessage {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil
+	}
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// calculationRecord creates the message of a record
+func calculationRecord(record *history.Record) *pb.CalculationRecord {
+	msg := &pb.CalculationRecord{
+		Sequence:         record.Seq,
+		TimeNs:           record.Time.UnixNano(),
+		Principal:        record.Principal,
+		Role:             record.Role,
+		Tenant:           record.Tenant,
+		Method:           record.Method,
+		Operation:        record.Operation,
+		Module:           record.Module,
+		RequestJson:      string(record.Request),
+		ResponseJson:     string(record.Response),
+		Code:             record.Code,
+		Error:            record.Error,
+		EngineVersion:    record.Engine.Version,
+		MaxPrecision:     int32(record.Engine.MaxPrecision),
+		MaxDecimalPlaces: int32(record.Engine.MaxDecimalPlaces),
+		OverflowCheck:    record.Engine.OverflowCheck,
+		DurationNs:       record.Duration.Nanoseconds(),
+	}
+	if record.ErrorKind != "" {
+		msg.ErrorKind = pb.ErrorKind(pb.ErrorKind_value["ERROR_KIND_"+record.ErrorKind])
+	}
+	return msg
+}
@@ -0,0 +1,109 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"llamacalc/pkg/calctest"
+	"llamacalc/pkg/history"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/server"
+)
+
+// historyServer returns a test server recording into a history store
+// with the operations switched by operations
+func historyServer(t *testing.T, operations map[string]bool) *calctest.Server {
+	t.Helper()
+	store, err := history.OpenFileStore(t.TempDir(), history.FileOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return calctest.NewServer(t, calctest.WithServerConfig(func(config *server.Config) {
+		config.HistoryStore = store
+		config.HistoryOperations = operations
+	}))
+}
+
+func TestStreamHistory(t *testing.T) {
+	conn, err := historyServer(t, nil).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+	c := pb.NewCalculatorClient(conn)
+	ctx := context.Background()
+
+	stream, err := c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, data := range [][]float64{{1, 2}, {3, 4}} {
+		if err := stream.Send(&pb.StatisticsRequest{Data: data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil || resp.Count != 4 {
+		t.Fatalf("got %v, %v", resp, err)
+	}
+
+	page, err := pb.NewHistoryClient(conn).QueryHistory(ctx, &pb.QueryHistoryRequest{Operation: "statistics"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Records) != 1 {
+		t.Fatalf("got %d records, want 1", len(page.Records))
+	}
+	record := page.Records[0]
+	if record.Method != pb.Calculator_StatisticsStream_FullMethodName || record.Module != "stats" || record.Code != "OK" {
+		t.Errorf("got record %v", record)
+	}
+
+	// The request is the array of received messages
+	var chunks []struct{ Data []float64 }
+	if err := json.Unmarshal([]byte(record.RequestJson), &chunks); err != nil || len(chunks) != 2 || chunks[1].Data[1] != 4 {
+		t.Errorf("got request %s, %v", record.RequestJson, err)
+	}
+	var recorded struct{ Count string }
+	if err := json.Unmarshal([]byte(record.ResponseJson), &recorded); err != nil || recorded.Count != "4" {
+		t.Errorf("got response %s, %v", record.ResponseJson, err)
+	}
+
+	// Failed streams are recorded with their error
+	stream, err = c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.CloseAndRecv(); err == nil {
+		t.Fatal("empty stream succeeded")
+	}
+	page, err = pb.NewHistoryClient(conn).QueryHistory(ctx, &pb.QueryHistoryRequest{ErrorKind: pb.ErrorKind_ERROR_KIND_INVALID_INPUT})
+	if err != nil || len(page.Records) != 1 || page.Records[0].RequestJson != "[]" || page.Records[0].ResponseJson != "" {
+		t.Errorf("got %v, %v", page, err)
+	}
+}
+
+func TestStreamHistoryOperations(t *testing.T) {
+	conn, err := historyServer(t, map[string]bool{"STATISTICS": false}).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+	ctx := context.Background()
+
+	stream, err := pb.NewCalculatorClient(conn).StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Send(&pb.StatisticsRequest{Data: []float64{1}})
+	if _, err := stream.CloseAndRecv(); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := pb.NewHistoryClient(conn).QueryHistory(ctx, &pb.QueryHistoryRequest{})
+	if err != nil || len(page.Records) != 0 {
+		t.Errorf("got %v, %v, want no records", page, err)
+	}
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	legacypb "llamacalc/pkg/proto"
+	llamacalcpb "llamacalc/pkg/proto/llamacalc"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// legacyService serves the deprecated proto.Calculator and proto.HealthService
+// APIs on top of the llamacalc.v1 implementation, so that clients built
+// against the old service names keep working while they migrate. The
+// llamacalc.Calculator API is served by unversionedService.
+type legacyService struct {
+	legacypb.UnimplementedCalculatorServer
+	legacypb.UnimplementedHealthServiceServer
+
+	calculator pb.CalculatorServer
+	health     *health.Server
+}
+
+// registerLegacyServices registers the compatibility shim on server
+func registerLegacyServices(server *grpc.Server, calculator pb.CalculatorServer, health *health.Server) {
+	l := &legacyService{
+		calculator: calculator,
+		health:     health,
+	}
+
+	legacypb.RegisterCalculatorServer(server, l)
+	legacypb.RegisterHealthServiceServer(server, l)
+	llamacalcpb.RegisterCalculatorServer(server, &unversionedService{legacy: l})
+}
+
+// Add implements the deprecated proto.Calculator/Add RPC
+func (l *legacyService) Add(ctx context.Context, req *legacypb.CalculationRequest) (*legacypb.CalculationResponse, error) {
+	return fromV1Response(l.calculator.Add(ctx, toV1Request(req)))
+}
+
+// Subtract implements the deprecated proto.Calculator/Subtract RPC
+func (l *legacyService) Subtract(ctx context.Context, req *legacypb.CalculationRequest) (*legacypb.CalculationResponse, error) {
+	return fromV1Response(l.calculator.Subtract(ctx, toV1Request(req)))
+}
+
+// Multiply implements the deprecated proto.Calculator/Multiply RPC
+func (l *legacyService) Multiply(ctx context.Context, req *legacypb.CalculationRequest) (*legacypb.CalculationResponse, error) {
+	return fromV1Response(l.calculator.Multiply(ctx, toV1Request(req)))
+}
+
+// Divide implements the deprecated proto.Calculator/Divide RPC
+func (l *legacyService) Divide(ctx context.Context, req *legacypb.CalculationRequest) (*legacypb.CalculationResponse, error) {
+	return fromV1Response(l.calculator.Divide(ctx, toV1Request(req)))
+}
+
+// Health implements the deprecated Health RPCs of proto.Calculator and
+// proto.HealthService by asking the standard health server
+func (l *legacyService) Health(ctx context.Context, req *legacypb.HealthCheckRequest) (*legacypb.HealthCheckResponse, error) {
+	return &legacypb.HealthCheckResponse{
+		Status: legacypb.HealthCheckResponse_ServingStatus(l.servingStatus(ctx, req.Service)),
+	}, nil
+}
+
+// servingStatus asks the standard health server for the status of service.
+// Both deprecated APIs number their statuses like the standard one and add
+// SERVICE_UNKNOWN for services the server does not know.
+func (l *legacyService) servingStatus(ctx context.Context, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	resp, err := l.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN
+	}
+	if resp.Status == grpc_health_v1.HealthCheckResponse_SERVING {
+		return grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+}
+
+// toV1Request converts a deprecated request. The auth_token and role fields
+// were never honoured; credentials are taken from the call metadata.
+func toV1Request(req *legacypb.CalculationRequest) *pb.CalculationRequest {
+	return &pb.CalculationRequest{
+		A: req.A,
+		B: req.B,
+	}
+}
+
+// legacyStatusOK is the status_code of successful responses of the deprecated
+// services, which their clients check
+const legacyStatusOK = 200
+
+// fromV1Response converts a llamacalc.v1 response. Errors are already typed
+// status errors and are passed through unchanged.
+func fromV1Response(resp *pb.CalculationResponse, err error) (*legacypb.CalculationResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &legacypb.CalculationResponse{
+		Result:     resp.Result,
+		StatusCode: legacyStatusOK,
+		Operation:  resp.Operation,
+		DurationNs: resp.DurationNs,
+	}, nil
+}
+
+// unversionedService serves the deprecated llamacalc.Calculator API, which
+// predates the proto package, through the legacyService shim
+type unversionedService struct {
+	llamacalcpb.UnimplementedCalculatorServer
+
+	legacy *legacyService
+}
+
+// Add implements the deprecated llamacalc.Calculator/Add RPC
+func (u *unversionedService) Add(ctx context.Context, req *llamacalcpb.CalculationRequest) (*llamacalcpb.CalculationResponse, error) {
+	return fromUnversionedResponse(u.legacy.calculator.Add(ctx, toUnversionedV1Request(req)))
+}
+
+// Subtract implements the deprecated llamacalc.Calculator/Subtract RPC
+func (u *unversionedService) Subtract(ctx context.Context, req *llamacalcpb.CalculationRequest) (*llamacalcpb.CalculationResponse, error) {
+	return fromUnversionedResponse(u.legacy.calculator.Subtract(ctx, toUnversionedV1Request(req)))
+}
+
+// Multiply implements the deprecated llamacalc.Calculator/Multiply RPC
+func (u *unversionedService) Multiply(ctx context.Context, req *llamacalcpb.CalculationRequest) (*llamacalcpb.CalculationResponse, error) {
+	return fromUnversionedResponse(u.legacy.calculator.Multiply(ctx, toUnversionedV1Request(req)))
+}
+
+// Divide implements the deprecated llamacalc.Calculator/Divide RPC
+func (u *unversionedService) Divide(ctx context.Context, req *llamacalcpb.CalculationRequest) (*llamacalcpb.CalculationResponse, error) {
+	return fromUnversionedResponse(u.legacy.calculator.Divide(ctx, toUnversionedV1Request(req)))
+}
+
+// Health implements the deprecated llamacalc.Calculator/Health RPC
+func (u *unversionedService) Health(ctx context.Context, req *llamacalcpb.HealthCheckRequest) (*llamacalcpb.HealthCheckResponse, error) {
+	return &llamacalcpb.HealthCheckResponse{
+		Status: llamacalcpb.HealthCheckResponse_ServingStatus(u.legacy.servingStatus(ctx, req.Service)),
+	}, nil
+}
+
+// toUnversionedV1Request converts a llamacalc.Calculator request. Its
+// metadata was never interpreted and is dropped.
+func toUnversionedV1Request(req *llamacalcpb.CalculationRequest) *pb.CalculationRequest {
+	return &pb.CalculationRequest{
+		A: req.A,
+		B: req.B,
+	}
+}
+
+// fromUnversionedResponse converts a llamacalc.v1 response like
+// fromV1Response
+func fromUnversionedResponse(resp *pb.CalculationResponse, err error) (*llamacalcpb.CalculationResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &llamacalcpb.CalculationResponse{
+		Result:     resp.Result,
+		StatusCode: legacyStatusOK,
+		Operation:  resp.Operation,
+		DurationNs: resp.DurationNs,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/library"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// libraryService implements the Library service with a library manager.
+// Definitions belong to the tenant of the authenticated principal.
+type libraryService struct {
+	pb.UnimplementedLibraryServer
+	libraries *library.Manager
+}
+
+// DefineFunction implements the DefineFunction RPC method
+func (s *libraryService) DefineFunction(ctx context.Context, req *pb.DefineFunctionRequest) (*pb.DefinitionResponse, error) {
+	def, err := library.ParseFunction(req.Definition)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	def.Role = auth.Role(req.Role)
+	return s.define(ctx, def, req.BaseVersion)
+}
+
+// DefineConstant implements the DefineConstant RPC method
+func (s *libraryService) DefineConstant(ctx context.Context, req *pb.DefineConstantRequest) (*pb.DefinitionResponse, error) {
+	return s.define(ctx, &library.Definition{Name: req.Name, Value: req.Value, Role: auth.Role(req.Role)}, req.BaseVersion)
+}
+
+// define stores a definition on behalf of the caller
+func (s *libraryService) define(ctx context.Context, def *library.Definition, baseVersion int64) (*pb.DefinitionResponse, error) {
+	def.Author = owner(ctx)
+	stored, err := s.libraries.Define(ctx, tenant(ctx), callerRole(ctx), def, int(baseVersion))
+	if err != nil {
+		return nil, libraryStatus(err)
+	}
+	return &pb.DefinitionResponse{Definition: definition(stored), Version: int64(stored.Version)}, nil
+}
+
+// DeleteDefinition implements the DeleteDefinition RPC method
+func (s *libraryService) DeleteDefinition(ctx context.Context, req *pb.DeleteDefinitionRequest) (*pb.DeleteDefinitionResponse, error) {
+	if err := s.libraries.Delete(ctx, tenant(ctx), callerRole(ctx), req.Name, int(req.BaseVersion)); err != nil {
+		return nil, libraryStatus(err)
+	}
+	lib, _, err := s.libraries.Load(ctx, tenant(ctx))
+	if err != nil {
+		return nil, libraryStatus(err)
+	}
+	return &pb.DeleteDefinitionResponse{Version: int64(lib.Version)}, nil
+}
+
+// ListDefinitions implements the ListDefinitions RPC method
+func (s *libraryService) ListDefinitions(ctx context.Context, req *pb.ListDefinitionsRequest) (*pb.ListDefinitionsResponse, error) {
+	lib, _, err := s.libraries.Load(ctx, tenant(ctx))
+	if err != nil {
+		return nil, libraryStatus(err)
+	}
+
+	resp := &pb.ListDefinitionsResponse{Version: int64(lib.Version)}
+	for _, def := range lib.Sorted() {
+		resp.Definitions = append(resp.Definitions, definition(def))
+	}
+	return resp, nil
+}
+
+// libraryInterceptor makes the definitions of the caller's tenant available
+// to the expressions of a request. Callers need the roles of the
+// definitions that the expressions use and of the operations these use.
+func (s *GRPCServer) libraryInterceptor(libraries *library.Manager) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		expressions := expressions(req)
+		if len(expressions) == 0 {
+			return handler(ctx, req)
+		}
+
+		lib, defs, err := libraries.Load(ctx, tenant(ctx))
+		if err != nil {
+			return nil, libraryStatus(err)
+		}
+		if principal, ok := auth.PrincipalFromContext(ctx); ok {
+			if !principal.Role.Allows(s.definitionsRole(lib.Uses(expressions...))) {
+				return nil, status.Errorf(codes.PermissionDenied, "no permission to use these definitions")
+			}
+		}
+		return handler(calc.WithDefinitions(ctx, defs), req)
+	}
+}
+
+// definitionsRole returns the highest role required by defs and by the
+// operations used in their bodies
+func (s *GRPCServer) definitionsRole(defs []*library.Definition) auth.Role {
+	required := auth.RoleGuest
+	for _, def := range defs {
+		required = higherRole(required, requiredRole(string(def.Role)))
+		if !def.IsConstant() {
+			required = higherRole(required, s.expressionRole(def.Body))
+		}
+	}
+	return required
+}
+
+// tenant returns the library of the authenticated caller: that of its
+// tenant, or its own. Callers share one library without authentication.
+func tenant(ctx context.Context) string {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	switch {
+	case !ok:
+		return ""
+	case principal.Tenant != "":
+		return "tenant:" + principal.Tenant
+	}
+	return "user:" + principal.Name
+}
+
+// callerRole returns the role of the authenticated caller. Without
+// authentication, callers may do anything.
+func callerRole(ctx context.Context) auth.Role {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Role
+	}
+	return auth.RoleAdmin
+}
+
+// libraryStatus converts an error of the library manager into a gRPC status
+// error
+func libraryStatus(err error) error {
+	switch {
+	case errors.Is(err, library.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, library.ErrConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, library.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, library.ErrLimit):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return calcstatus.ToStatus(err)
+}
+
+// definition creates the message of a definition
+func definition(def *library.Definition) *pb.Definition {
+	return &pb.Definition{
+		Name:      def.Name,
+		Params:    def.Params,
+		Body:      def.Body,
+		Value:     def.Value,
+		Role:      string(def.Role),
+		Version:   int64(def.Version),
+		Author:    def.Author,
+		UpdatedNs: def.Updated.UnixNano(),
+	}
+}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/linalg"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// defaultMaxMsgSize is the gRPC default for the maximum received message size
+const defaultMaxMsgSize = 4 * 1024 * 1024
+
+// linalgService implements the LinearAlgebra service with package linalg
+type linalgService struct {
+	pb.UnimplementedLinearAlgebraServer
+
+	// maxElements limits the size of operands and results so that both fit
+	// into a message
+	maxElements int
+}
+
+// newLinalgService creates a LinearAlgebra service for messages of up to
+// maxMsgSize bytes
+func newLinalgService(maxMsgSize int) *linalgService {
+	if maxMsgSize <= 0 {
+		maxMsgSize = defaultMaxMsgSize
+	}
+	// Elements are packed doubles of 8 bytes each
+	return &linalgService{maxElements: maxMsgSize / 8}
+}
+
+// Elementwise implements the Elementwise RPC method
+func (s *linalgService) Elementwise(ctx context.Context, req *pb.ElementwiseRequest) (*pb.MatrixResponse, error) {
+	start := time.Now()
+
+	a, b, err := s.matrixPair(req.A, req.B)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	var result *linalg.Matrix
+	switch req.Operation {
+	case pb.ElementwiseOperation_ELEMENTWISE_OPERATION_ADD:
+		result, err = linalg.Add(a, b)
+	case pb.ElementwiseOperation_ELEMENTWISE_OPERATION_SUBTRACT:
+		result, err = linalg.Subtract(a, b)
+	case pb.ElementwiseOperation_ELEMENTWISE_OPERATION_MULTIPLY:
+		result, err = linalg.MultiplyElements(a, b)
+	case pb.ElementwiseOperation_ELEMENTWISE_OPERATION_DIVIDE:
+		result, err = linalg.DivideElements(a, b)
+	default:
+		err = &calc.FieldError{Field: "operation", Err: calc.ErrUnknownOperation}
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return matrixResponse(result, 0, start), nil
+}
+
+// Multiply implements the Multiply RPC method
+func (s *linalgService) Multiply(ctx context.Context, req *pb.MatrixPairRequest) (*pb.MatrixResponse, error) {
+	start := time.Now()
+
+	a, b, err := s.matrixPair(req.A, req.B)
+	if err == nil {
+		err = s.checkSize("result", a.Rows, b.Cols)
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	result, err := linalg.Multiply(a, b)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return matrixResponse(result, 0, start), nil
+}
+
+// Transpose implements the Transpose RPC method
+func (s *linalgService) Transpose(ctx context.Context, req *pb.MatrixRequest) (*pb.MatrixResponse, error) {
+	start := time.Now()
+
+	m, err := s.matrix("matrix", req.Matrix)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return matrixResponse(linalg.Transpose(m), 0, start), nil
+}
+
+// Determinant implements the Determinant RPC method
+func (s *linalgService) Determinant(ctx context.Context, req *pb.MatrixRequest) (*pb.DeterminantResponse, error) {
+	start := time.Now()
+
+	m, err := s.matrix("matrix", req.Matrix)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	det, err := linalg.Det(m)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.DeterminantResponse{
+		Determinant: det,
+		DurationNs:  time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// Inverse implements the Inverse RPC method
+func (s *linalgService) Inverse(ctx context.Context, req *pb.MatrixRequest) (*pb.MatrixResponse, error) {
+	start := time.Now()
+
+	m, err := s.matrix("matrix", req.Matrix)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	inv, cond, err := linalg.Inverse(m)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return matrixResponse(inv, cond, start), nil
+}
+
+// DecomposeLU implements the DecomposeLU RPC method
+func (s *linalgService) DecomposeLU(ctx context.Context, req *pb.MatrixRequest) (*pb.LUResponse, error) {
+	start := time.Now()
+
+	m, err := s.matrix("matrix", req.Matrix)
+	if err == nil {
+		// L and U together have twice the elements of the operand
+		err = s.checkSize("result", 2*m.Rows, m.Cols)
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	lu, err := linalg.DecomposeLU(m)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	pivot := make([]uint32, len(lu.Pivot))
+	for i, p := range lu.Pivot {
+		pivot[i] = uint32(p)
+	}
+
+	return &pb.LUResponse{
+		L:          toProtoMatrix(lu.L),
+		U:          toProtoMatrix(lu.U),
+		Pivot:      pivot,
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// DecomposeQR implements the DecomposeQR RPC method
+func (s *linalgService) DecomposeQR(ctx context.Context, req *pb.MatrixRequest) (*pb.QRResponse, error) {
+	start := time.Now()
+
+	m, err := s.matrix("matrix", req.Matrix)
+	if err == nil {
+		// Q is m x m and R is m x n
+		err = s.checkSize("result", m.Rows, m.Rows+m.Cols)
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	qr, err := linalg.DecomposeQR(m)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return &pb.QRResponse{
+		Q:          toProtoMatrix(qr.Q),
+		R:          toProtoMatrix(qr.R),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// Solve implements the Solve RPC method
+func (s *linalgService) Solve(ctx context.Context, req *pb.MatrixPairRequest) (*pb.MatrixResponse, error) {
+	start := time.Now()
+
+	a, b, err := s.matrixPair(req.A, req.B)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	x, cond, err := linalg.Solve(a, b)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return matrixResponse(x, cond, start), nil
+}
+
+// matrix converts the operand named field into a linalg.Matrix, checking
+// its dimensions, size and elements
+func (s *linalgService) matrix(field string, m *pb.Matrix) (*linalg.Matrix, error) {
+	if m == nil {
+		return nil, &calc.FieldError{Field: field, Err: fmt.Errorf("%w: matrix is required", calc.ErrInvalidInput)}
+	}
+	if err := s.checkSize(field, int(m.Rows), int(m.Cols)); err != nil {
+		return nil, err
+	}
+
+	result, err := linalg.New(int(m.Rows), int(m.Cols), m.Data)
+	if err != nil {
+		return nil, &calc.FieldError{Field: field, Err: err}
+	}
+	return result, nil
+}
+
+// matrixPair converts the operands a and b
+func (s *linalgService) matrixPair(a, b *pb.Matrix) (*linalg.Matrix, *linalg.Matrix, error) {
+	ma, err := s.matrix("a", a)
+	if err != nil {
+		return nil, nil, err
+	}
+	mb, err := s.matrix("b", b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ma, mb, nil
+}
+
+// checkSize checks that a rows x cols matrix fits into a message
+func (s *linalgService) checkSize(field string, rows, cols int) error {
+	if uint64(rows)*uint64(cols) > uint64(s.maxElements) {
+		return &calc.FieldError{
+			Field: field,
+			Err:   fmt.Errorf("%w: %dx%d matrix exceeds the limit of %d elements", calc.ErrInvalidInput, rows, cols, s.maxElements),
+		}
+	}
+	return nil
+}
+
+// matrixResponse creates the response for a matrix result
+func matrixResponse(m *linalg.Matrix, cond float64, start time.Time) *pb.MatrixResponse {
+	return &pb.MatrixResponse{
+		Result:          toProtoMatrix(m),
+		ConditionNumber: cond,
+		DurationNs:      time.Since(start).Nanoseconds(),
+	}
+}
+
+// toProtoMatrix converts a linalg.Matrix into its message
+func toProtoMatrix(m *linalg.Matrix) *pb.Matrix {
+	return &pb.Matrix{
+		Rows: uint32(m.Rows),
+		Cols: uint32(m.Cols),
+		Data: m.Data,
+	}
+}
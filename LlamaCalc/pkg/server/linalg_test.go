@@ -0,0 +1,165 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calctest"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/server"
+)
+
+// linalgClient returns a LinearAlgebra client of a server receiving messages
+// of up to maxMsgSize bytes
+func linalgClient(t *testing.T, maxMsgSize int) pb.LinearAlgebraClient {
+	t.Helper()
+	s := calctest.NewServer(t, calctest.WithServerConfig(func(config *server.Config) {
+		config.MaxRecvMsgSize = maxMsgSize
+	}))
+	conn, err := s.Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewLinearAlgebraClient(conn)
+}
+
+// ones returns a rows x cols matrix of ones
+func ones(rows, cols int) *pb.Matrix {
+	data := make([]float64, rows*cols)
+	for i := range data {
+		data[i] = 1
+	}
+	return &pb.Matrix{Rows: uint32(rows), Cols: uint32(cols), Data: data}
+}
+
+// assertError fails unless err is an InvalidArgument status for field
+// wrapping want
+func assertError(t *testing.T, err error, field string, want error) {
+	t.Helper()
+	var e *calcstatus.Error
+	if status.Code(err) != codes.InvalidArgument || !errors.As(calcstatus.FromStatus(err), &e) || e.Field != field || !errors.Is(e, want) {
+		t.Errorf("got %v, want %v for field %s", err, want, field)
+	}
+}
+
+func TestLinearAlgebra(t *testing.T) {
+	c := linalgClient(t, 4*1024*1024)
+	ctx := context.Background()
+
+	a := &pb.Matrix{Rows: 2, Cols: 2, Data: []float64{2, 1, 1, 3}}
+	b := &pb.Matrix{Rows: 2, Cols: 1, Data: []float64{3, 5}}
+	solved, err := c.Solve(ctx, &pb.MatrixPairRequest{A: a, B: b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := solved.Result.Data; len(x) != 2 || math.Abs(x[0]-0.8) > 1e-12 || math.Abs(x[1]-1.4) > 1e-12 {
+		t.Errorf("got solution %v, want [0.8 1.4]", x)
+	}
+	if math.Abs(solved.ConditionNumber-3.2) > 1e-12 {
+		t.Errorf("got condition number %g, want 3.2", solved.ConditionNumber)
+	}
+
+	det, err := c.Determinant(ctx, &pb.MatrixRequest{Matrix: a})
+	if err != nil || math.Abs(det.Determinant-5) > 1e-12 {
+		t.Errorf("got determinant %v, %v, want 5", det, err)
+	}
+
+	lu, err := c.DecomposeLU(ctx, &pb.MatrixRequest{Matrix: &pb.Matrix{Rows: 2, Cols: 2, Data: []float64{0, 1, 2, 3}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lu.Pivot) != 2 || lu.Pivot[0] != 1 || lu.Pivot[1] != 0 {
+		t.Errorf("got pivot %v, want [1 0]", lu.Pivot)
+	}
+
+	// Singular matrices are errors with the condition number in the details
+	singular := &pb.Matrix{Rows: 2, Cols: 2, Data: []float64{1, 2, 2, 4}}
+	for name, call := range map[string]func() error{
+		"Inverse": func() error {
+			_, err := c.Inverse(ctx, &pb.MatrixRequest{Matrix: singular})
+			return err
+		},
+		"Solve": func() error {
+			_, err := c.Solve(ctx, &pb.MatrixPairRequest{A: singular, B: b})
+			return err
+		},
+	} {
+		err := call()
+		var e *calcstatus.Error
+		if !errors.As(calcstatus.FromStatus(err), &e) || !errors.Is(e, calc.ErrSingular) || e.Metadata["condition_number"] != "+Inf" {
+			t.Errorf("%s: got %v, want a singular matrix error", name, err)
+		}
+	}
+
+	near := &pb.Matrix{Rows: 2, Cols: 2, Data: []float64{1, 1, 1, 1 + 0x1p-52}}
+	_, err = c.Inverse(ctx, &pb.MatrixRequest{Matrix: near})
+	var e *calcstatus.Error
+	if !errors.As(calcstatus.FromStatus(err), &e) || !errors.Is(e, calc.ErrSingular) || e.Metadata["condition_number"] == "+Inf" {
+		t.Errorf("got %v, want an ill-conditioned matrix error", err)
+	}
+
+	// Solve needs a square system
+	_, err = c.Solve(ctx, &pb.MatrixPairRequest{A: ones(2, 3), B: b})
+	assertError(t, err, "a", calc.ErrInvalidInput)
+	_, err = c.Solve(ctx, &pb.MatrixPairRequest{A: a, B: ones(3, 1)})
+	assertError(t, err, "b", calc.ErrInvalidInput)
+	_, err = c.Inverse(ctx, &pb.MatrixRequest{})
+	assertError(t, err, "matrix", calc.ErrInvalidInput)
+}
+
+func TestLinearAlgebraLimit(t *testing.T) {
+	// Messages of 1 KiB hold 128 elements
+	c := linalgClient(t, 1024)
+	ctx := context.Background()
+
+	tests := []struct {
+		Name  string
+		Call  func() error
+		Field string
+	}{
+		{"operand", func() error {
+			// The dimensions alone exceed the limit
+			_, err := c.Transpose(ctx, &pb.MatrixRequest{Matrix: &pb.Matrix{Rows: 100, Cols: 100}})
+			return err
+		}, "matrix"},
+		{"second operand", func() error {
+			_, err := c.Multiply(ctx, &pb.MatrixPairRequest{A: ones(1, 1), B: &pb.Matrix{Rows: 1 << 16, Cols: 1 << 16}})
+			return err
+		}, "b"},
+		{"product", func() error {
+			_, err := c.Multiply(ctx, &pb.MatrixPairRequest{A: ones(16, 1), B: ones(1, 16)})
+			return err
+		}, "result"},
+		{"LU", func() error {
+			_, err := c.DecomposeLU(ctx, &pb.MatrixRequest{Matrix: ones(9, 9)})
+			return err
+		}, "result"},
+		{"QR", func() error {
+			_, err := c.DecomposeQR(ctx, &pb.MatrixRequest{Matrix: ones(10, 6)})
+			return err
+		}, "result"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Call()
+			assertError(t, err, tc.Field, calc.ErrInvalidInput)
+			if !strings.Contains(status.Convert(err).Message(), "limit of 128 elements") {
+				t.Errorf("got %v, want the limit in the message", err)
+			}
+		})
+	}
+
+	// Results at the limit are allowed
+	if _, err := c.Multiply(ctx, &pb.MatrixPairRequest{A: ones(8, 1), B: ones(1, 16)}); err != nil {
+		t.Errorf("got %v for a result at the limit", err)
+	}
+}
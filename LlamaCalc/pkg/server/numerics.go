@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calculator"
+	"llamacalc/pkg/numerics"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// defaultVariable is the variable of functions if requests do not name one
+const defaultVariable = "x"
+
+// numericsService implements the Numerics service with package numerics on
+// functions evaluated by the engine
+type numericsService struct {
+	pb.UnimplementedNumericsServer
+	engine *calc.Calculator
+}
+
+// FindRoot implements the FindRoot RPC method
+func (s *numericsService) FindRoot(ctx context.Context, req *pb.FindRootRequest) (*pb.NumericsResponse, error) {
+	start := time.Now()
+
+	f, err := s.function(req.Expression, req.Variable)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	opts := numerics.Options{Tolerance: req.Tolerance, MaxIterations: maxIterations(req.MaxIterations)}
+
+	ctx = calculator.WithAngleUnit(ctx, req.AngleUnit)
+	var result numerics.Result
+	switch req.Method {
+	case pb.RootMethod_ROOT_METHOD_BISECTION:
+		result, err = numerics.Bisection(ctx, f, req.Lower, req.Upper, opts)
+	case pb.RootMethod_ROOT_METHOD_UNSPECIFIED, pb.RootMethod_ROOT_METHOD_BRENT:
+		result, err = numerics.Brent(ctx, f, req.Lower, req.Upper, opts)
+	case pb.RootMethod_ROOT_METHOD_NEWTON:
+		var df numerics.Func
+		if req.Derivative != "" {
+			if df, err = s.function(req.Derivative, req.Variable); err != nil {
+				return nil, calcstatus.ToStatus(renameField(err, "expression", "derivative"))
+			}
+		}
+		result, err = numerics.Newton(ctx, f, df, req.Guess, opts)
+	default:
+		err = &calc.FieldError{Field: "method", Err: calc.ErrInvalidInput}
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return numericsResponse(result, start), nil
+}
+
+// Integrate implements the Integrate RPC method
+func (s *numericsService) Integrate(ctx context.Context, req *pb.IntegrateRequest) (*pb.NumericsResponse, error) {
+	start := time.Now()
+
+	f, err := s.function(req.Expression, req.Variable)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	opts := numerics.Options{Tolerance: req.Tolerance, MaxIterations: maxIterations(req.MaxIterations)}
+
+	ctx = calculator.WithAngleUnit(ctx, req.AngleUnit)
+	var result numerics.Result
+	switch req.Method {
+	case pb.IntegrationMethod_INTEGRATION_METHOD_UNSPECIFIED, pb.IntegrationMethod_INTEGRATION_METHOD_GAUSS_KRONROD:
+		result, err = numerics.GaussKronrod(ctx, f, req.Lower, req.Upper, opts)
+	case pb.IntegrationMethod_INTEGRATION_METHOD_ADAPTIVE_SIMPSON:
+		result, err = numerics.AdaptiveSimpson(ctx, f, req.Lower, req.Upper, opts)
+	default:
+		err = &calc.FieldError{Field: "method", Err: calc.ErrInvalidInput}
+	}
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return numericsResponse(result, start), nil
+}
+
+// Derivative implements the Derivative RPC method
+func (s *numericsService) Derivative(ctx context.Context, req *pb.DerivativeRequest) (*pb.NumericsResponse, error) {
+	start := time.Now()
+
+	f, err := s.function(req.Expression, req.Variable)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	order := 1
+	if req.Order != 0 {
+		order = int(min(req.Order, numerics.MaxOrder+1))
+	}
+	opts := numerics.Options{Tolerance: req.Tolerance, MaxIterations: maxIterations(req.MaxIterations)}
+
+	ctx = calculator.WithAngleUnit(ctx, req.AngleUnit)
+	result, err := numerics.Derivative(ctx, f, req.X, order, opts)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return numericsResponse(result, start), nil
+}
+
+// function parses the expression of a function of variable, which is
+// defaultVariable if empty
+func (s *numericsService) function(expression, variable string) (numerics.Func, error) {
+	if variable == "" {
+		variable = defaultVariable
+	}
+	return s.engine.Function(expression, variable)
+}
+
+// maxIterations converts the iteration limit of a request, keeping limits
+// that are too large invalid
+func maxIterations(n uint32) int {
+	return int(min(n, numerics.MaxIterations+1))
+}
+
+// renameField reports an error about the field from for the field to
+// instead, e.g. when an expression is given as the derivative
+func renameField(err error, from, to string) error {
+	var fieldErr *calc.FieldError
+	if errors.As(err, &fieldErr) && fieldErr.Field == from {
+		return &calc.FieldError{Field: to, Err: fieldErr.Err}
+	}
+	return err
+}
+
+// numericsResponse creates the response message of a numerical result
+func numericsResponse(result numerics.Result, start time.Time) *pb.NumericsResponse {
+	return &pb.NumericsResponse{
+		Result:        result.Value,
+		ErrorEstimate: result.ErrorEstimate,
+		Residual:      result.Residual,
+		Iterations:    uint32(result.Iterations),
+		Evaluations:   uint32(result.Evaluations),
+		DurationNs:    time.Since(start).Nanoseconds(),
+	}
+}
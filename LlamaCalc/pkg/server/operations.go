@@ -0,0 +1,234 @@
+package server
+
+import (
+	"strings"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+	"llamacalc/pkg/monitoring"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/session"
+)
+
+// operationServices prefix the RPCs that are named after a registered
+// operation, e.g. "/llamacalc.v1.Calculator/Add"
+var operationServices = []string{
+	"/" + pb.Calculator_ServiceDesc.ServiceName + "/",
+	"/proto.Calculator/",
+	"/llamacalc.Calculator/",
+}
+
+// serviceInfo describes a service whose methods are not registered operations
+type serviceInfo struct {
+	// role is required by every method of the service
+	role auth.Role
+	// module is the metric module of the methods
+	module string
+}
+
+// services lists the services whose methods are not registered operations.
+// Programmer requires USER like the bitwise operations of the calculator, so
+// that guests cannot reach them through fixed-width arithmetic either.
+var services = map[string]serviceInfo{
+	pb.LinearAlgebra_ServiceDesc.ServiceName: {role: auth.RoleUser, module: "linalg"},
+	pb.Programmer_ServiceDesc.ServiceName:    {role: auth.RoleUser, module: "programmer"},
+	pb.Finance_ServiceDesc.ServiceName:       {role: auth.RoleUser, module: "finance"},
+	pb.Numerics_ServiceDesc.ServiceName:      {role: auth.RoleUser, module: "numerics"},
+	pb.Symbolic_ServiceDesc.ServiceName:      {role: auth.RoleUser, module: "symbolic"},
+	pb.Session_ServiceDesc.ServiceName:       {role: auth.RoleUser, module: "session"},
+	pb.Library_ServiceDesc.ServiceName:       {role: auth.RoleUser, module: "library"},
+	pb.History_ServiceDesc.ServiceName:       {role: auth.RoleUser, module: "history"},
+}
+
+// methodInfo describes a method that does not perform a registered operation
+type methodInfo struct {
+	// role is required to call the method
+	role auth.Role
+	// operation and module are the metric labels of the method
+	operation, module string
+}
+
+// methods lists the methods of operationServices that do not perform a
+// registered operation and need a role regardless of their request
+var methods = map[string]methodInfo{
+	pb.Calculator_Statistics_FullMethodName:       {role: auth.RoleGuest, operation: "STATISTICS", module: "stats"},
+	pb.Calculator_StatisticsStream_FullMethodName: {role: auth.RoleGuest, operation: "STATISTICS", module: "stats"},
+	pb.Calculator_Fit_FullMethodName:              {role: auth.RoleUser, operation: "FIT", module: "fit"},
+	pb.Calculator_Convert_FullMethodName:          {role: auth.RoleGuest, operation: "CONVERT", module: "units"},
+	pb.Calculator_MoneyCalculate_FullMethodName:   {role: auth.RoleUser, operation: "MONEY", module: "money"},
+	pb.Calculator_ConvertCurrency_FullMethodName:  {role: auth.RoleUser, operation: "CONVERT_CURRENCY", module: "money"},
+	pb.Library_ListDefinitions_FullMethodName:     {role: auth.RoleGuest, operation: "LIST_DEFINITIONS", module: "library"},
+}
+
+// lookupService returns the service and method name of a call to one of
+// services
+func lookupService(fullMethod string) (serviceInfo, string, bool) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return serviceInfo{}, "", false
+	}
+	info, ok := services[service]
+	return info, method, ok
+}
+
+// lookupOperation returns the registered operation performed by a call
+func (s *GRPCServer) lookupOperation(fullMethod string, req interface{}) (*calc.Operation, bool) {
+	if invoke, ok := req.(*pb.InvokeRequest); ok {
+		return s.calculator.Registry.Lookup(invoke.Operation)
+	}
+
+	for _, prefix := range operationServices {
+		if name, ok := strings.CutPrefix(fullMethod, prefix); ok {
+			return s.calculator.Registry.Lookup(name)
+		}
+	}
+
+	return nil, false
+}
+
+// policy requires the role of the operation performed by a call, the role of
+// its service, or the highest role of the operations used by an expression.
+// Invoke, Evaluate and ComplexCalculate require an authenticated caller even
+// for unknown operations; other methods, such as health checks, are public.
+func (s *GRPCServer) policy(fullMethod string, req interface{}) (auth.Role, bool) {
+	if info, ok := methods[fullMethod]; ok {
+		return info.role, true
+	}
+	if op, ok := s.lookupOperation(fullMethod, req); ok {
+		return operationRole(op), true
+	}
+	if info, _, ok := lookupService(fullMethod); ok {
+		return higherRole(info.role, s.functionRole(req)), true
+	}
+
+	switch r := req.(type) {
+	case *pb.InvokeRequest:
+		return auth.RoleGuest, true
+	case *pb.EvaluateRequest:
+		return s.expressionRole(r.Expression), true
+	case *pb.ComplexRequest:
+		if op, ok := s.calculator.Registry.LookupComplex(r.Operation); ok {
+			return requiredRole(op.Role), true
+		}
+		return auth.RoleGuest, true
+	case *pb.RationalRequest:
+		if op, ok := s.calculator.Registry.LookupRational(r.Operation); ok {
+			return requiredRole(op.Role), true
+		}
+		return auth.RoleGuest, true
+	case *pb.IntegerRequest:
+		if op, ok := s.calculator.Registry.LookupInteger(r.Operation); ok {
+			return requiredRole(op.Role), true
+		}
+		return auth.RoleGuest, true
+	}
+
+	return "", false
+}
+
+// expressionRole returns the highest role required by the operations used
+// in expression. Expressions that do not parse are rejected by the handler.
+func (s *GRPCServer) expressionRole(expression string) auth.Role {
+	required := auth.RoleGuest
+
+	node, err := expr.Parse(expression)
+	if err != nil {
+		return required
+	}
+
+	for _, name := range expr.Functions(node) {
+		op, ok := s.calculator.Registry.Lookup(name)
+		if !ok {
+			continue
+		}
+		if role := operationRole(op); !required.Allows(role) {
+			required = role
+		}
+	}
+
+	return required
+}
+
+// functionRole returns the highest role required by the operations used in
+// the functions of a Numerics request or the statement of a Session request
+func (s *GRPCServer) functionRole(req interface{}) auth.Role {
+	required := auth.RoleGuest
+	for _, expression := range expressions(req) {
+		required = higherRole(required, s.expressionRole(expression))
+	}
+	return required
+}
+
+// expressions returns the expressions evaluated by a request
+func expressions(req interface{}) []string {
+	switch r := req.(type) {
+	case *pb.EvaluateRequest:
+		return []string{r.Expression}
+	case *pb.EvaluateStatementRequest:
+		// Statements that do not parse are rejected by the handler
+		_, expression, err := session.ParseStatement(r.Statement)
+		if err != nil {
+			return nil
+		}
+		return []string{expression}
+	case *pb.FindRootRequest:
+		return []string{r.Expression, r.Derivative}
+	case *pb.IntegrateRequest:
+		return []string{r.Expression}
+	case *pb.DerivativeRequest:
+		return []string{r.Expression}
+	}
+	return nil
+}
+
+// higherRole returns the higher of two roles
+func higherRole(a, b auth.Role) auth.Role {
+	if a.Allows(b) {
+		return a
+	}
+	return b
+}
+
+// operationRole returns the role required by op
+func operationRole(op *calc.Operation) auth.Role {
+	return requiredRole(op.Role)
+}
+
+// requiredRole returns the auth role of an operation role
+func requiredRole(role string) auth.Role {
+	if role == "" {
+		return auth.RoleGuest
+	}
+	return auth.Role(role)
+}
+
+// metricLabels labels metrics with the operation performed by a call
+func (s *GRPCServer) metricLabels(fullMethod string, req interface{}) (string, string) {
+	if info, ok := methods[fullMethod]; ok {
+		return info.operation, info.module
+	}
+	if op, ok := s.lookupOperation(fullMethod, req); ok {
+		return op.MetricLabels()
+	}
+	if info, method, ok := lookupService(fullMethod); ok {
+		return strings.ToUpper(method), info.module
+	}
+	switch r := req.(type) {
+	case *pb.EvaluateRequest:
+		return "EVALUATE", "expression"
+	case *pb.ComplexRequest:
+		if op, ok := s.calculator.Registry.LookupComplex(r.Operation); ok {
+			return op.MetricLabels()
+		}
+	case *pb.RationalRequest:
+		if op, ok := s.calculator.Registry.LookupRational(r.Operation); ok {
+			return op.MetricLabels()
+		}
+	case *pb.IntegerRequest:
+		if op, ok := s.calculator.Registry.LookupInteger(r.Operation); ok {
+			return op.MetricLabels()
+		}
+	}
+	return monitoring.UnknownOperation, monitoring.UnknownModule
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"llamacalc/pkg/bitwise"
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calcstatus"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// integerTypes maps the integer types of messages to package bitwise
+var integerTypes = map[pb.IntegerType]bitwise.Type{
+	pb.IntegerType_INTEGER_TYPE_INT8:   bitwise.Int8,
+	pb.IntegerType_INTEGER_TYPE_INT16:  bitwise.Int16,
+	pb.IntegerType_INTEGER_TYPE_INT32:  bitwise.Int32,
+	pb.IntegerType_INTEGER_TYPE_INT64:  bitwise.Int64,
+	pb.IntegerType_INTEGER_TYPE_UINT8:  bitwise.Uint8,
+	pb.IntegerType_INTEGER_TYPE_UINT16: bitwise.Uint16,
+	pb.IntegerType_INTEGER_TYPE_UINT32: bitwise.Uint32,
+	pb.IntegerType_INTEGER_TYPE_UINT64: bitwise.Uint64,
+}
+
+// programmerService implements the Programmer service with package bitwise
+type programmerService struct {
+	pb.UnimplementedProgrammerServer
+}
+
+// Calculate implements the Calculate RPC method
+func (s *programmerService) Calculate(ctx context.Context, req *pb.ProgrammerRequest) (*pb.ProgrammerResponse, error) {
+	start := time.Now()
+
+	t, err := integerType(req.Type)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	result, err := bitwise.Calculate(t, req.Operation, req.Args...)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return programmerResponse(req.Type, result, start), nil
+}
+
+// Evaluate implements the Evaluate RPC method
+func (s *programmerService) Evaluate(ctx context.Context, req *pb.ProgrammerEvaluateRequest) (*pb.ProgrammerResponse, error) {
+	start := time.Now()
+
+	t, err := integerType(req.Type)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	result, err := bitwise.Evaluate(ctx, t, req.Expression)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	return programmerResponse(req.Type, result, start), nil
+}
+
+// Convert implements the Convert RPC method
+func (s *programmerService) Convert(ctx context.Context, req *pb.ProgrammerConvertRequest) (*pb.ProgrammerResponse, error) {
+	start := time.Now()
+
+	t, err := integerType(req.Type)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+
+	value, err := bitwise.Parse(t, req.Value)
+	if err != nil {
+		return nil, calcstatus.ToStatus(&calc.FieldError{Field: "value", Err: err})
+	}
+
+	return programmerResponse(req.Type, bitwise.Result{Value: value}, start), nil
+}
+
+// integerType returns the bitwise type of an integer type
+func integerType(t pb.IntegerType) (bitwise.Type, error) {
+	if bt, ok := integerTypes[t]; ok {
+		return bt, nil
+	}
+	return bitwise.Type{}, &calc.FieldError{Field: "type", Err: fmt.Errorf("%w: unknown integer type %v", calc.ErrInvalidInput, t)}
+}
+
+// programmerResponse creates the response message of a result
+func programmerResponse(t pb.IntegerType, result bitwise.Result, start time.Time) *pb.ProgrammerResponse {
+	return &pb.ProgrammerResponse{
+		Decimal:    result.Value.Format(10),
+		Hex:        result.Value.Format(16),
+		Octal:      result.Value.Format(8),
+		Binary:     result.Value.Format(2),
+		Overflow:   result.Overflow,
+		Type:       t,
+		DurationNs: time.Since(start).Nanoseconds(),
+	}
+}
@@ -0,0 +1,135 @@
+package server_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calctest"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// programmerClient returns a Programmer client of a test server with options
+func programmerClient(t *testing.T, opts ...calctest.Option) pb.ProgrammerClient {
+	t.Helper()
+	conn, err := calctest.NewServer(t, opts...).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewProgrammerClient(conn)
+}
+
+func TestProgrammer(t *testing.T) {
+	c := programmerClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		Name string
+		Call func() (*pb.ProgrammerResponse, error)
+		Want *pb.ProgrammerResponse
+	}{
+		{"negate minimum", func() (*pb.ProgrammerResponse, error) {
+			return c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Operation: "Negate", Args: []string{"-128"}})
+		}, &pb.ProgrammerResponse{Decimal: "-128", Hex: "0x80", Octal: "0o200", Binary: "0b10000000", Overflow: true}},
+		{"shift", func() (*pb.ProgrammerResponse, error) {
+			return c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_UINT16, Operation: "shl", Args: []string{"0xff", "4"}})
+		}, &pb.ProgrammerResponse{Decimal: "4080", Hex: "0x0ff0", Octal: "0o7760", Binary: "0b0000111111110000"}},
+		{"byte swap", func() (*pb.ProgrammerResponse, error) {
+			return c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_INT32, Operation: "ByteSwap", Args: []string{"0xff"}})
+		}, &pb.ProgrammerResponse{Decimal: "-16777216", Hex: "0xff000000", Octal: "0o37700000000", Binary: "0b11111111000000000000000000000000"}},
+		{"wraparound", func() (*pb.ProgrammerResponse, error) {
+			return c.Evaluate(ctx, &pb.ProgrammerEvaluateRequest{Type: pb.IntegerType_INTEGER_TYPE_UINT8, Expression: "~0 + 1"})
+		}, &pb.ProgrammerResponse{Decimal: "0", Hex: "0x00", Octal: "0o0", Binary: "0b00000000", Overflow: true}},
+		{"rotate by the width", func() (*pb.ProgrammerResponse, error) {
+			return c.Evaluate(ctx, &pb.ProgrammerEvaluateRequest{Type: pb.IntegerType_INTEGER_TYPE_UINT64, Expression: "rotl(0x8000000000000001, 64)"})
+		}, &pb.ProgrammerResponse{
+			Decimal: "9223372036854775809",
+			Hex:     "0x8000000000000001",
+			Octal:   "0o1000000000000000000001",
+			Binary:  "0b1000000000000000000000000000000000000000000000000000000000000001",
+		}},
+		{"convert bit pattern", func() (*pb.ProgrammerResponse, error) {
+			return c.Convert(ctx, &pb.ProgrammerConvertRequest{Type: pb.IntegerType_INTEGER_TYPE_INT16, Value: "0xffff"})
+		}, &pb.ProgrammerResponse{Decimal: "-1", Hex: "0xffff", Octal: "0o177777", Binary: "0b1111111111111111"}},
+		{"convert negative", func() (*pb.ProgrammerResponse, error) {
+			return c.Convert(ctx, &pb.ProgrammerConvertRequest{Type: pb.IntegerType_INTEGER_TYPE_INT64, Value: "-2"})
+		}, &pb.ProgrammerResponse{
+			Decimal: "-2",
+			Hex:     "0xfffffffffffffffe",
+			Octal:   "0o1777777777777777777776",
+			Binary:  "0b1111111111111111111111111111111111111111111111111111111111111110",
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := tc.Call()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Decimal != tc.Want.Decimal || got.Hex != tc.Want.Hex || got.Octal != tc.Want.Octal ||
+				got.Binary != tc.Want.Binary || got.Overflow != tc.Want.Overflow {
+				t.Errorf("got %v, want %v", got, tc.Want)
+			}
+		})
+	}
+}
+
+func TestProgrammerErrors(t *testing.T) {
+	c := programmerClient(t)
+	ctx := context.Background()
+
+	_, err := c.Calculate(ctx, &pb.ProgrammerRequest{Operation: "Add", Args: []string{"1", "2"}})
+	assertError(t, err, "type", calc.ErrInvalidInput)
+	_, err = c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_UINT8, Operation: "Add", Args: []string{"1", "-1"}})
+	assertError(t, err, "b", calc.ErrInvalidInput)
+	_, err = c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Operation: "Shl", Args: []string{"1", "-1"}})
+	assertError(t, err, "n", calc.ErrInvalidInput)
+	_, err = c.Calculate(ctx, &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Operation: "Divide", Args: []string{"1", "0"}})
+	assertError(t, err, "b", calc.ErrDivideByZero)
+	_, err = c.Evaluate(ctx, &pb.ProgrammerEvaluateRequest{Type: pb.IntegerType_INTEGER_TYPE_UINT8, Expression: "1 + 0x1ff"})
+	assertError(t, err, "expression", calc.ErrInvalidInput)
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, "position 5") {
+		t.Errorf("got message %q, want the position of the literal", msg)
+	}
+	_, err = c.Convert(ctx, &pb.ProgrammerConvertRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Value: "128"})
+	assertError(t, err, "value", calc.ErrInvalidInput)
+}
+
+func TestProgrammerPolicy(t *testing.T) {
+	ctx := context.Background()
+	calculate := &pb.ProgrammerRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Operation: "Add", Args: []string{"1", "2"}}
+	evaluate := &pb.ProgrammerEvaluateRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Expression: "1 + 2"}
+	convert := &pb.ProgrammerConvertRequest{Type: pb.IntegerType_INTEGER_TYPE_INT8, Value: "3"}
+
+	tests := []struct {
+		Role string
+		Want codes.Code
+	}{
+		{"GUEST", codes.PermissionDenied},
+		{"USER", codes.OK},
+		{"ADMIN", codes.OK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Role, func(t *testing.T) {
+			c := programmerClient(t, calctest.WithJWTAuth(tc.Role))
+
+			_, err := c.Calculate(ctx, calculate)
+			if status.Code(err) != tc.Want {
+				t.Errorf("Calculate: got %v, want %v", err, tc.Want)
+			}
+			_, err = c.Evaluate(ctx, evaluate)
+			if status.Code(err) != tc.Want {
+				t.Errorf("Evaluate: got %v, want %v", err, tc.Want)
+			}
+			_, err = c.Convert(ctx, convert)
+			if status.Code(err) != tc.Want {
+				t.Errorf("Convert: got %v, want %v", err, tc.Want)
+			}
+		})
+	}
+}
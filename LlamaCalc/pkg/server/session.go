@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/auth"
+	"llamacalc/pkg/calcstatus"
+	"llamacalc/pkg/calculator"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/session"
+)
+
+// sessionService implements the Session service with a session manager.
+// Sessions are owned by the authenticated principal.
+type sessionService struct {
+	pb.UnimplementedSessionServer
+	sessions *session.Manager
+}
+
+// CreateSession implements the CreateSession RPC method
+func (s *sessionService) CreateSession(ctx context.Context, req *pb.CreateSessionRequest) (*pb.CreateSessionResponse, error) {
+	created, err := s.sessions.Create(ctx, owner(ctx))
+	if err != nil {
+		return nil, sessionStatus(err)
+	}
+	return &pb.CreateSessionResponse{SessionId: created.ID}, nil
+}
+
+// EvaluateStatement implements the EvaluateStatement RPC method
+func (s *sessionService) EvaluateStatement(ctx context.Context, req *pb.EvaluateStatementRequest) (*pb.EvaluateStatementResponse, error) {
+	start := time.Now()
+
+	ctx = calculator.WithAngleUnit(ctx, req.AngleUnit)
+	entry, err := s.sessions.Evaluate(ctx, owner(ctx), req.SessionId, req.Statement)
+	if err != nil {
+		return nil, sessionStatus(err)
+	}
+	return &pb.EvaluateStatementResponse{
+		Entry:      historyEntry(entry),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}, nil
+}
+
+// ListHistory implements the ListHistory RPC method
+func (s *sessionService) ListHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryResponse, error) {
+	current, err := s.sessions.Get(ctx, owner(ctx), req.SessionId)
+	if err != nil {
+		return nil, sessionStatus(err)
+	}
+
+	resp := &pb.ListHistoryResponse{
+		Variables: current.Variables,
+		History:   make([]*pb.HistoryEntry, len(current.History)),
+	}
+	for i, entry := range current.History {
+		resp.History[i] = historyEntry(entry)
+	}
+	return resp, nil
+}
+
+// DeleteSession implements the DeleteSession RPC method
+func (s *sessionService) DeleteSession(ctx context.Context, req *pb.DeleteSessionRequest) (*pb.DeleteSessionResponse, error) {
+	if err := s.sessions.Delete(ctx, owner(ctx), req.SessionId); err != nil {
+		return nil, sessionStatus(err)
+	}
+	return &pb.DeleteSessionResponse{}, nil
+}
+
+// owner returns the name of the authenticated caller, which is empty
+// without authentication
+func owner(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Name
+	}
+	return ""
+}
+
+// sessionStatus converts an error of the session manager into a gRPC status
+// error
+func sessionStatus(err error) error {
+	switch {
+	case errors.Is(err, session.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, session.ErrLimit):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return calcstatus.ToStatus(err)
+}
+
+// historyEntry creates the message of a history entry
+func historyEntry(entry session.Entry) *pb.HistoryEntry {
+	return &pb.HistoryEntry{
+		Index:     uint32(entry.Index),
+		Statement: entry.Statement,
+		Variable:  entry.Variable,
+		Result:    entry.Value,
+	}
+}
@@ -0,0 +1,193 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calctest"
+	"llamacalc/pkg/calculator"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+)
+
+// calculatorClient returns a Calculator client of a test server
+func calculatorClient(t *testing.T) pb.CalculatorClient {
+	t.Helper()
+	conn, err := calctest.NewServer(t).Dial()
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewCalculatorClient(conn)
+}
+
+func TestStatistics(t *testing.T) {
+	c := calculatorClient(t)
+
+	resp, err := c.Statistics(context.Background(), &pb.StatisticsRequest{
+		Data:        []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16, 1e9 + 7},
+		Percentiles: []float64{0, 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 5 || resp.Sum != 5e9+47 || resp.Min != 1e9+4 || resp.Max != 1e9+16 || resp.Median != 1e9+7 {
+		t.Errorf("got %v", resp)
+	}
+	if math.Abs(resp.Variance-24.3) > 1e-9 || math.Abs(resp.Stddev-math.Sqrt(24.3)) > 1e-9 {
+		t.Errorf("got variance %v and stddev %v, want 24.3", resp.Variance, resp.Stddev)
+	}
+	if len(resp.Mode) != 1 || resp.Mode[0] != 1e9+7 || resp.ModeCount != 2 {
+		t.Errorf("got mode %v (%d)", resp.Mode, resp.ModeCount)
+	}
+	if len(resp.Percentiles) != 2 || resp.Percentiles[0].Value != resp.Min || resp.Percentiles[1].Percentile != 100 || resp.Percentiles[1].Value != resp.Max {
+		t.Errorf("got percentiles %v", resp.Percentiles)
+	}
+	if resp.Approximate {
+		t.Error("exact statistics reported as approximate")
+	}
+
+	_, err = c.Statistics(context.Background(), &pb.StatisticsRequest{Data: []float64{1, math.NaN()}})
+	assertError(t, err, "data[1]", calc.ErrInvalidInput)
+	_, err = c.Statistics(context.Background(), &pb.StatisticsRequest{Data: []float64{1}, Percentiles: []float64{200}})
+	assertError(t, err, "percentiles[0]", calc.ErrInvalidInput)
+}
+
+func TestStatisticsStream(t *testing.T) {
+	c := calculatorClient(t)
+	ctx := context.Background()
+
+	// 0, 1, ..., 9999 in chunks of 1000, enough for the digest to merge
+	stream, err := c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for chunk := 0; chunk < 10; chunk++ {
+		req := &pb.StatisticsRequest{}
+		for i := 0; i < 1000; i++ {
+			req.Data = append(req.Data, float64(chunk*1000+i))
+		}
+		// The first chunk that sets percentiles wins
+		switch chunk {
+		case 1:
+			req.Percentiles = []float64{1, 99}
+		case 2:
+			req.Percentiles = []float64{50}
+		}
+		if err := stream.Send(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Count != 10000 || resp.Sum != 49995000 || resp.Mean != 4999.5 || resp.Min != 0 || resp.Max != 9999 {
+		t.Errorf("got %v", resp)
+	}
+	if want := 10000 * 10001 / 12.0; math.Abs(resp.Variance-want) > 1e-6 {
+		t.Errorf("got variance %v, want %v", resp.Variance, want)
+	}
+	if !resp.Approximate || math.Abs(resp.Median-4999.5) > 50 {
+		t.Errorf("got median %v, approximate %v", resp.Median, resp.Approximate)
+	}
+	if len(resp.Percentiles) != 2 || resp.Percentiles[0].Percentile != 1 || math.Abs(resp.Percentiles[0].Value-99.99) > 5 ||
+		resp.Percentiles[1].Percentile != 99 || math.Abs(resp.Percentiles[1].Value-9899.01) > 5 {
+		t.Errorf("got percentiles %v", resp.Percentiles)
+	}
+	if resp.Mode != nil {
+		t.Errorf("got mode %v of distinct values", resp.Mode)
+	}
+
+	// Fields are numbered across chunks
+	stream, err = c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Send(&pb.StatisticsRequest{Data: []float64{1, 2}})
+	stream.Send(&pb.StatisticsRequest{Data: []float64{3, math.Inf(1)}})
+	_, err = stream.CloseAndRecv()
+	assertError(t, err, "data[3]", calc.ErrInvalidInput)
+
+	// An empty stream has no statistics
+	stream, err = c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.CloseAndRecv()
+	assertError(t, err, "data", calc.ErrInvalidInput)
+}
+
+func TestStatisticsStreamCancel(t *testing.T) {
+	c := calculatorClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := c.StatisticsStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.Send(&pb.StatisticsRequest{Data: []float64{1, 2, 3}}); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if _, err := stream.CloseAndRecv(); status.Code(err) != codes.Canceled {
+		t.Errorf("got %v, want Canceled", err)
+	}
+
+	// The service stops at the failed receive without a response
+	canceled := &statisticsStream{
+		chunks: []*pb.StatisticsRequest{{Data: []float64{1, 2, 3}}},
+		err:    status.Error(codes.Canceled, "context canceled"),
+	}
+	err = calculator.NewService(calc.NewDefaultCalculator()).StatisticsStream(canceled)
+	if status.Code(err) != codes.Canceled || canceled.resp != nil {
+		t.Errorf("got %v and response %v, want Canceled", err, canceled.resp)
+	}
+
+	// Other calls are not affected
+	if _, err := c.Statistics(context.Background(), &pb.StatisticsRequest{Data: []float64{1}}); err != nil {
+		t.Errorf("got %v after a canceled stream", err)
+	}
+}
+
+// statisticsStream is a StatisticsStream call that receives chunks and
+// then fails with err
+type statisticsStream struct {
+	grpc.ServerStream
+
+	chunks []*pb.StatisticsRequest
+	err    error
+	resp   *pb.StatisticsResponse
+}
+
+func (s *statisticsStream) Recv() (*pb.StatisticsRequest, error) {
+	if len(s.chunks) == 0 {
+		if s.err == nil {
+			return nil, io.EOF
+		}
+		return nil, s.err
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *statisticsStream) SendAndClose(resp *pb.StatisticsResponse) error {
+	if s.resp != nil {
+		return errors.New("response already sent")
+	}
+	s.resp = resp
+	return nil
+}
+
+func (s *statisticsStream) Context() context.Context {
+	return context.Background()
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+	"llamacalc/pkg/calcstatus"
+	pb "llamacalc/pkg/proto/llamacalc/v1"
+	"llamacalc/pkg/symbolic"
+)
+
+// symbolicService implements the Symbolic service with package symbolic
+type symbolicService struct {
+	pb.UnimplementedSymbolicServer
+}
+
+// Differentiate implements the Differentiate RPC method
+func (s *symbolicService) Differentiate(ctx context.Context, req *pb.DifferentiateRequest) (*pb.SymbolicResponse, error) {
+	start := time.Now()
+
+	node, err := parseExpression(req.Expression)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	variable := req.Variable
+	if variable == "" {
+		variable = defaultVariable
+	}
+	order := 1
+	if req.Order != 0 {
+		order = int(min(req.Order, symbolic.MaxOrder+1))
+	}
+
+	d, err := symbolic.Differentiate(node, variable, order)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return symbolicResponse(d, start), nil
+}
+
+// Simplify implements the Simplify RPC method
+func (s *symbolicService) Simplify(ctx context.Context, req *pb.SimplifyRequest) (*pb.SymbolicResponse, error) {
+	start := time.Now()
+
+	node, err := parseExpression(req.Expression)
+	if err != nil {
+		return nil, calcstatus.ToStatus(err)
+	}
+	return symbolicResponse(symbolic.Simplify(node), start), nil
+}
+
+// parseExpression parses the expression of a request
+func parseExpression(expression string) (expr.Node, error) {
+	node, err := expr.Parse(expression)
+	if err != nil {
+		return nil, &calc.FieldError{Field: "expression", Err: fmt.Errorf("%w: %v", calc.ErrInvalidInput, err)}
+	}
+	return node, nil
+}
+
+// symbolicResponse creates the response message of an expression
+func symbolicResponse(node expr.Node, start time.Time) *pb.SymbolicResponse {
+	return &pb.SymbolicResponse{
+		Expression: expr.Format(node),
+		Tree:       expressionNode(node),
+		DurationNs: time.Since(start).Nanoseconds(),
+	}
+}
+
+// expressionNode converts a syntax tree to its message
+func expressionNode(node expr.Node) *pb.ExpressionNode {
+	switch n := node.(type) {
+	case *expr.Number:
+		return &pb.ExpressionNode{Node: &pb.ExpressionNode_Number{Number: &pb.NumberNode{Value: n.Value, Text: n.Text}}}
+	case *expr.Ident:
+		return &pb.ExpressionNode{Node: &pb.ExpressionNode_Identifier{Identifier: n.Name}}
+	case *expr.Unary:
+		return &pb.ExpressionNode{Node: &pb.ExpressionNode_Unary{Unary: &pb.UnaryNode{Op: n.Op, Operand: expressionNode(n.X)}}}
+	case *expr.Binary:
+		return &pb.ExpressionNode{Node: &pb.ExpressionNode_Binary{Binary: &pb.BinaryNode{Op: n.Op, Left: expressionNode(n.X), Right: expressionNode(n.Y)}}}
+	case *expr.Call:
+		call := &pb.CallNode{Function: n.Func, Args: make([]*pb.ExpressionNode, len(n.Args))}
+		for i, arg := range n.Args {
+			call.Args[i] = expressionNode(arg)
+		}
+		return &pb.ExpressionNode{Node: &pb.ExpressionNode_Call{Call: call}}
+	}
+	return nil
+}
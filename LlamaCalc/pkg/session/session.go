@@ -0,0 +1,251 @@
+// Package session keeps stateful calculation sessions.
+//
+// A session evaluates statements, which are expressions or assignments such
+// as "x = 3 * y", and remembers their results. Expressions refer to variables
+// by name, to the last result as ans and to earlier results as $1, $2 and so
+// on. Sessions belong to the principal that created them and are kept in a
+// Store, which evicts them when they are not used.
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+)
+
+var (
+	// ErrNotFound reports a session that does not exist, has expired or
+	// belongs to another principal
+	ErrNotFound = errors.New("session not found")
+	// ErrLimit reports a session, variable or store that is full
+	ErrLimit = errors.New("session limit exceeded")
+)
+
+// Answer is the name of the last result
+const Answer = "ans"
+
+// Limits bound the resources of sessions
+type Limits struct {
+	// MaxSessions is the number of sessions of an owner
+	MaxSessions int
+	// MaxVariables is the number of variables of a session
+	MaxVariables int
+	// MaxHistory is the number of results a session remembers; older
+	// results are forgotten
+	MaxHistory int
+}
+
+// DefaultLimits are used for the limits that are zero
+var DefaultLimits = Limits{MaxSessions: 100, MaxVariables: 100, MaxHistory: 1000}
+
+// withDefaults replaces limits that are not positive with DefaultLimits
+func (l Limits) withDefaults() Limits {
+	if l.MaxSessions <= 0 {
+		l.MaxSessions = DefaultLimits.MaxSessions
+	}
+	if l.MaxVariables <= 0 {
+		l.MaxVariables = DefaultLimits.MaxVariables
+	}
+	if l.MaxHistory <= 0 {
+		l.MaxHistory = DefaultLimits.MaxHistory
+	}
+	return l
+}
+
+// limitError reports that a limit of n things was reached
+func limitError(things string, n int) error {
+	return fmt.Errorf("%w: at most %d %s", ErrLimit, n, things)
+}
+
+// Session is the state of a calculation session
+type Session struct {
+	ID string
+	// Owner is the name of the principal that created the session, empty
+	// without authentication
+	Owner    string
+	Created  time.Time
+	Accessed time.Time
+	// Variables holds the values of the variables by lowercase name
+	Variables map[string]float64
+	// History holds the last results, oldest first
+	History []Entry
+	// Results counts the results of the session, including the forgotten
+	Results int
+}
+
+// Entry is a result in the history of a session
+type Entry struct {
+	// Index numbers the results from 1, so $1 is the first result
+	Index int
+	// Statement is the evaluated statement
+	Statement string
+	// Variable is the variable assigned by the statement, if any
+	Variable string
+	Value    float64
+}
+
+// clone returns a deep copy of s
+func (s *Session) clone() *Session {
+	c := *s
+	c.Variables = make(map[string]float64, len(s.Variables))
+	for name, value := range s.Variables {
+		c.Variables[name] = value
+	}
+	c.History = append([]Entry(nil), s.History...)
+	return &c
+}
+
+// lookup returns the value of a variable, ans or $n
+func (s *Session) lookup(name string) (float64, error) {
+	if strings.EqualFold(name, Answer) {
+		if len(s.History) == 0 {
+			return 0, fmt.Errorf("%w: no previous result", calc.ErrInvalidInput)
+		}
+		return s.History[len(s.History)-1].Value, nil
+	}
+
+	if index, ok := strings.CutPrefix(name, "$"); ok {
+		n, err := strconv.Atoi(index)
+		if err != nil || len(s.History) == 0 || n < s.History[0].Index || n > s.Results {
+			return 0, fmt.Errorf("%w: no result %s in the history", calc.ErrInvalidInput, name)
+		}
+		return s.History[n-s.History[0].Index].Value, nil
+	}
+
+	if value, ok := s.Variables[strings.ToLower(name)]; ok {
+		return value, nil
+	}
+	return 0, fmt.Errorf("%w: unknown variable %s", calc.ErrInvalidInput, name)
+}
+
+// ParseStatement splits a statement into the variable it assigns, if any,
+// and its expression, e.g. "x = 3 * y" into "x" and " 3 * y". Variables
+// are lowercase and may not be constants, ans or $n.
+func ParseStatement(statement string) (variable, expression string, err error) {
+	before, after, ok := strings.Cut(statement, "=")
+	if !ok {
+		return "", statement, nil
+	}
+
+	name := strings.TrimSpace(before)
+	node, err := expr.Parse(name)
+	if ident, ok := node.(*expr.Ident); err != nil || !ok || ident.Name != name || strings.HasPrefix(name, "$") {
+		return "", "", &calc.FieldError{Field: "expression", Err: fmt.Errorf("%w: cannot assign to %q", calc.ErrInvalidInput, name)}
+	}
+	name = strings.ToLower(name)
+	if _, ok := calc.Constants[name]; ok || name == Answer {
+		return "", "", &calc.FieldError{Field: "expression", Err: fmt.Errorf("%w: cannot assign to %s", calc.ErrInvalidInput, name)}
+	}
+	return name, after, nil
+}
+
+// Manager evaluates statements in the sessions of a store on behalf of
+// their owners
+type Manager struct {
+	engine *calc.Calculator
+	store  Store
+	limits Limits
+}
+
+// NewManager creates a manager of the sessions in store, or in a new
+// MemoryStore with the default TTL if store is nil
+func NewManager(engine *calc.Calculator, store Store, limits Limits) *Manager {
+	if store == nil {
+		store = NewMemoryStore(0, 0)
+	}
+	return &Manager{engine: engine, store: store, limits: limits.withDefaults()}
+}
+
+// Create creates an empty session of owner
+func (m *Manager) Create(ctx context.Context, owner string) (*Session, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	s := &Session{ID: id, Owner: owner, Variables: map[string]float64{}}
+	if err := m.store.Create(ctx, s, m.limits.MaxSessions); err != nil {
+		return nil, err
+	}
+	return m.store.Get(ctx, id)
+}
+
+// Get returns a session of owner
+func (m *Manager) Get(ctx context.Context, owner, id string) (*Session, error) {
+	s, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.Owner != owner {
+		return nil, ErrNotFound
+	}
+	return s, nil
+}
+
+// Evaluate evaluates a statement in a session of owner and adds its result
+// to the history. Statements evaluated concurrently in the same session see
+// the variables and results from before either of them.
+func (m *Manager) Evaluate(ctx context.Context, owner, id, statement string) (Entry, error) {
+	variable, expression, err := ParseStatement(statement)
+	if err != nil {
+		return Entry{}, err
+	}
+	s, err := m.Get(ctx, owner, id)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	result := m.engine.EvaluateWith(ctx, expression, s.lookup)
+	if result.Error != nil {
+		return Entry{}, result.Error
+	}
+
+	var entry Entry
+	_, err = m.store.Update(ctx, id, func(s *Session) error {
+		if s.Owner != owner {
+			return ErrNotFound
+		}
+		if variable != "" {
+			if _, ok := s.Variables[variable]; !ok && len(s.Variables) >= m.limits.MaxVariables {
+				return limitError("variables", m.limits.MaxVariables)
+			}
+			s.Variables[variable] = result.Value
+		}
+
+		s.Results++
+		entry = Entry{Index: s.Results, Statement: statement, Variable: variable, Value: result.Value}
+		s.History = append(s.History, entry)
+		if excess := len(s.History) - m.limits.MaxHistory; excess > 0 {
+			s.History = append([]Entry(nil), s.History[excess:]...)
+		}
+		return nil
+	})
+	if err != nil {
+		return Entry{}, err
+	}
+	return entry, nil
+}
+
+// Delete deletes a session of owner
+func (m *Manager) Delete(ctx context.Context, owner, id string) error {
+	if _, err := m.Get(ctx, owner, id); err != nil {
+		return err
+	}
+	return m.store.Delete(ctx, id)
+}
+
+// newID returns a random session ID
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate session ID: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,158 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/session"
+)
+
+// newManager creates a manager of sessions in memory
+func newManager(limits session.Limits) *session.Manager {
+	return session.NewManager(calc.NewDefaultCalculator(), nil, limits)
+}
+
+func TestEvaluate(t *testing.T) {
+	ctx := context.Background()
+	m := newManager(session.Limits{})
+	s, err := m.Create(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		Statement string
+		Variable  string
+		Want      float64
+	}{
+		{"y = 2", "y", 2},
+		{"X = 3 * y", "x", 6},
+		{"x + y", "", 8},
+		{"ans * 2", "", 16},
+		{"$1 + $4", "", 18},
+		{"z = sqrt(ans - 2) + pi - pi", "z", 4},
+	}
+	for i, step := range steps {
+		entry, err := m.Evaluate(ctx, "alice", s.ID, step.Statement)
+		if err != nil {
+			t.Fatalf("%s: %v", step.Statement, err)
+		}
+		if entry.Index != i+1 || entry.Variable != step.Variable || entry.Value != step.Want {
+			t.Errorf("%s: got $%d %s = %g, want $%d %s = %g", step.Statement, entry.Index, entry.Variable, entry.Value, i+1, step.Variable, step.Want)
+		}
+	}
+
+	s, err = m.Get(ctx, "alice", s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.History) != len(steps) || s.Variables["x"] != 6 || s.Variables["z"] != 4 {
+		t.Errorf("got history %v and variables %v", s.History, s.Variables)
+	}
+}
+
+func TestStatementErrors(t *testing.T) {
+	ctx := context.Background()
+	m := newManager(session.Limits{})
+	s, err := m.Create(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, statement := range []string{"ans + 1", "$1", "w * 2", "pi = 3", "ans = 1", "$1 = 2", "2 * x = 4", "x = ", "x = 1 = 2"} {
+		t.Run(statement, func(t *testing.T) {
+			_, err := m.Evaluate(ctx, "alice", s.ID, statement)
+			var fieldErr *calc.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != "expression" || !errors.Is(err, calc.ErrInvalidInput) {
+				t.Errorf("got %v, want ErrInvalidInput for the expression", err)
+			}
+		})
+	}
+
+	// Failed statements are not remembered
+	if s, err = m.Get(ctx, "alice", s.ID); err != nil || s.Results != 0 {
+		t.Errorf("got %d results, %v", s.Results, err)
+	}
+}
+
+func TestOwner(t *testing.T) {
+	ctx := context.Background()
+	m := newManager(session.Limits{})
+	s, err := m.Create(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sessions of other principals do not exist for them
+	if _, err := m.Evaluate(ctx, "bob", s.ID, "1 + 1"); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("Evaluate by another owner: got %v, want ErrNotFound", err)
+	}
+	if err := m.Delete(ctx, "bob", s.ID); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("Delete by another owner: got %v, want ErrNotFound", err)
+	}
+
+	if err := m.Delete(ctx, "alice", s.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Get(ctx, "alice", s.ID); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("Get after Delete: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	ctx := context.Background()
+	m := newManager(session.Limits{MaxSessions: 2, MaxVariables: 2, MaxHistory: 3})
+
+	s, err := m.Create(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Create(ctx, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Create(ctx, "alice"); !errors.Is(err, session.ErrLimit) {
+		t.Errorf("third session: got %v, want ErrLimit", err)
+	}
+	if _, err := m.Create(ctx, "bob"); err != nil {
+		t.Errorf("session of another owner: %v", err)
+	}
+
+	for _, statement := range []string{"a = 1", "b = 2", "a = 3"} {
+		if _, err := m.Evaluate(ctx, "alice", s.ID, statement); err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+	if _, err := m.Evaluate(ctx, "alice", s.ID, "c = 4"); !errors.Is(err, session.ErrLimit) {
+		t.Errorf("third variable: got %v, want ErrLimit", err)
+	}
+
+	// The oldest results are forgotten
+	entry, err := m.Evaluate(ctx, "alice", s.ID, "$2 + $3")
+	if err != nil || entry.Index != 4 || entry.Value != 5 {
+		t.Errorf("got $%d = %g, %v, want $4 = 5", entry.Index, entry.Value, err)
+	}
+	if _, err := m.Evaluate(ctx, "alice", s.ID, "$1"); !errors.Is(err, calc.ErrInvalidInput) {
+		t.Errorf("forgotten result: got %v, want ErrInvalidInput", err)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	m := session.NewManager(calc.NewDefaultCalculator(), session.NewMemoryStore(time.Millisecond, 0), session.Limits{MaxSessions: 1})
+	s, err := m.Create(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if _, err := m.Get(ctx, "alice", s.ID); !errors.Is(err, session.ErrNotFound) {
+		t.Errorf("expired session: got %v, want ErrNotFound", err)
+	}
+	// Expired sessions do not count towards the limit
+	if _, err := m.Create(ctx, "alice"); err != nil {
+		t.Errorf("session after expiry: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long sessions of a MemoryStore are kept after their
+	// last use
+	DefaultTTL = 30 * time.Minute
+	// DefaultMaxSessions is the capacity of a MemoryStore
+	DefaultMaxSessions = 10000
+)
+
+// Store keeps sessions. Implementations evict sessions that have not been
+// used for some time, after which they report ErrNotFound, and return
+// copies, so that sessions only change through Update.
+type Store interface {
+	// Create adds a new session, failing with ErrLimit if its owner has
+	// perOwner sessions already or the store is full
+	Create(ctx context.Context, s *Session, perOwner int) error
+	// Get returns a session
+	Get(ctx context.Context, id string) (*Session, error)
+	// Update applies fn to a session atomically and returns the result. The
+	// session is unchanged if fn fails.
+	Update(ctx context.Context, id string, fn func(*Session) error) (*Session, error)
+	// Delete removes a session
+	Delete(ctx context.Context, id string) error
+}
+
+// MemoryStore keeps sessions in memory, evicting them after a TTL
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]*Session
+	ttl      time.Duration
+	capacity int
+	now      func() time.Time
+}
+
+// NewMemoryStore creates a store that keeps at most capacity sessions for
+// ttl after their last use. Zero values mean DefaultTTL and
+// DefaultMaxSessions.
+func NewMemoryStore(ttl time.Duration, capacity int) *MemoryStore {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if capacity <= 0 {
+		capacity = DefaultMaxSessions
+	}
+	return &MemoryStore{
+		sessions: make(map[string]*Session),
+		ttl:      ttl,
+		capacity: capacity,
+		now:      time.Now,
+	}
+}
+
+// Create implements Store
+func (m *MemoryStore) Create(ctx context.Context, s *Session, perOwner int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	owned := 0
+	for id, existing := range m.sessions {
+		if m.expired(existing, now) {
+			delete(m.sessions, id)
+		} else if existing.Owner == s.Owner {
+			owned++
+		}
+	}
+	if owned >= perOwner {
+		return limitError("sessions", perOwner)
+	}
+	if len(m.sessions) >= m.capacity {
+		return limitError("sessions", m.capacity)
+	}
+
+	s = s.clone()
+	s.Created, s.Accessed = now, now
+	m.sessions[s.ID] = s
+	return nil
+}
+
+// Get implements Store
+func (m *MemoryStore) Get(ctx context.Context, id string) (*Session, error) {
+	return m.Update(ctx, id, func(*Session) error { return nil })
+}
+
+// Update implements Store
+func (m *MemoryStore) Update(ctx context.Context, id string, fn func(*Session) error) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, err := m.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	updated := s.clone()
+	if err := fn(updated); err != nil {
+		return nil, err
+	}
+	m.sessions[id] = updated
+	return updated.clone(), nil
+}
+
+// Delete implements Store
+func (m *MemoryStore) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.lookup(id); err != nil {
+		return err
+	}
+	delete(m.sessions, id)
+	return nil
+}
+
+// lookup returns a session that has not expired and marks it as used
+func (m *MemoryStore) lookup(id string) (*Session, error) {
+	s, ok := m.sessions[id]
+	now := m.now()
+	if !ok || m.expired(s, now) {
+		delete(m.sessions, id)
+		return nil, ErrNotFound
+	}
+	s.Accessed = now
+	return s, nil
+}
+
+// expired reports whether a session was last used more than the TTL ago
+func (m *MemoryStore) expired(s *Session, now time.Time) bool {
+	return now.Sub(s.Accessed) > m.ttl
+}
@@ -0,0 +1,193 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"llamacalc/pkg/calc"
+)
+
+// MaxModeValues is the number of distinct values an Accumulator counts to
+// find the mode. Datasets with more distinct values have no reported mode.
+const MaxModeValues = 1 << 16
+
+// Accumulator computes statistics of a dataset that is added in chunks. Its
+// memory use is bounded by the t-digest compression and MaxModeValues.
+type Accumulator struct {
+	count int64
+
+	// sum and compensation implement Neumaier summation
+	sum          float64
+	compensation float64
+
+	// mean and the central moment sums m2, m3 and m4 are updated online
+	mean, m2, m3, m4 float64
+
+	min, max float64
+
+	digest *TDigest
+	// counts holds the frequency of each value until there are too many
+	// distinct values
+	counts map[float64]int64
+}
+
+// NewAccumulator creates an empty accumulator
+func NewAccumulator() *Accumulator {
+	return &Accumulator{
+		digest: NewTDigest(DefaultCompression),
+		counts: make(map[float64]int64),
+	}
+}
+
+// Count returns the number of values added so far
+func (a *Accumulator) Count() int64 {
+	return a.count
+}
+
+// Add adds values to the dataset. Non-finite values are rejected, in which
+// case none of the values is added.
+func (a *Accumulator) Add(values ...float64) error {
+	return a.add(values)
+}
+
+// add checks and adds values
+func (a *Accumulator) add(values []float64) error {
+	for i, x := range values {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return &calc.FieldError{Field: fmt.Sprintf("data[%d]", a.count+int64(i)), Err: calc.ErrInvalidInput}
+		}
+	}
+
+	for _, x := range values {
+		a.addValue(x)
+	}
+	return nil
+}
+
+// addValue adds a finite value
+func (a *Accumulator) addValue(x float64) {
+	if a.count == 0 || x < a.min {
+		a.min = x
+	}
+	if a.count == 0 || x > a.max {
+		a.max = x
+	}
+
+	// Neumaier summation keeps the low-order bits lost by each addition
+	t := a.sum + x
+	if math.Abs(a.sum) >= math.Abs(x) {
+		a.compensation += (a.sum - t) + x
+	} else {
+		a.compensation += (x - t) + a.sum
+	}
+	a.sum = t
+
+	// Welford's update, extended to the third and fourth moments
+	n1 := float64(a.count)
+	a.count++
+	n := float64(a.count)
+	delta := x - a.mean
+	deltaN := delta / n
+	deltaN2 := deltaN * deltaN
+	term := delta * deltaN * n1
+	a.mean += deltaN
+	a.m4 += term*deltaN2*(n*n-3*n+3) + 6*deltaN2*a.m2 - 4*deltaN*a.m3
+	a.m3 += term*deltaN*(n-2) - 3*deltaN*a.m2
+	a.m2 += term
+
+	if a.digest != nil {
+		a.digest.Add(x)
+	}
+	if a.counts != nil {
+		a.counts[x]++
+		if len(a.counts) > MaxModeValues {
+			a.counts = nil
+		}
+	}
+}
+
+// Summary returns the statistics of the values added so far. The median and
+// percentiles are estimated by the t-digest once it had to merge values.
+func (a *Accumulator) Summary(percentiles []float64) (*Summary, error) {
+	if err := ValidatePercentiles(percentiles); err != nil {
+		return nil, err
+	}
+
+	s, err := a.summary()
+	if err != nil {
+		return nil, err
+	}
+
+	s.Median = a.digest.Quantile(0.5)
+	s.Percentiles = make([]float64, len(percentiles))
+	for i, p := range percentiles {
+		s.Percentiles[i] = a.digest.Quantile(p / 100)
+	}
+	s.Approximate = !a.digest.Exact()
+	s.Mode, s.ModeCount = countsMode(a.counts)
+
+	return s, nil
+}
+
+// summary returns the statistics that do not depend on the order of values
+func (a *Accumulator) summary() (*Summary, error) {
+	if a.count == 0 {
+		return nil, &calc.FieldError{Field: "data", Err: fmt.Errorf("%w: dataset is empty", calc.ErrInvalidInput)}
+	}
+
+	// The compensation of an infinite sum is infinite with the opposite sign
+	sum := a.sum
+	if !math.IsInf(sum, 0) {
+		sum += a.compensation
+	}
+
+	n := float64(a.count)
+	s := &Summary{
+		Count: a.count,
+		Sum:   sum,
+		Mean:  a.mean,
+		Min:   a.min,
+		Max:   a.max,
+	}
+	if a.count > 1 {
+		s.Variance = a.m2 / (n - 1)
+		s.StdDev = math.Sqrt(s.Variance)
+	}
+	if a.m2 > 0 {
+		s.Skewness = math.Sqrt(n) * a.m3 / math.Pow(a.m2, 1.5)
+		s.Kurtosis = n*a.m4/(a.m2*a.m2) - 3
+	}
+
+	// Intermediate results can overflow even though every value is finite
+	for _, v := range []float64{s.Sum, s.Mean, s.Variance, s.Skewness, s.Kurtosis} {
+		switch {
+		case math.IsNaN(v), math.IsInf(v, 1):
+			return nil, calc.ErrOverflow
+		case math.IsInf(v, -1):
+			return nil, calc.ErrUnderflow
+		}
+	}
+
+	return s, nil
+}
+
+// countsMode returns the most frequent values in counts and their frequency
+func countsMode(counts map[float64]int64) ([]float64, int64) {
+	var mode []float64
+	var best int64 = 1
+	for x, count := range counts {
+		switch {
+		case count > best:
+			best = count
+			mode = []float64{x}
+		case count == best && best > 1:
+			mode = append(mode, x)
+		}
+	}
+	if mode == nil {
+		return nil, 0
+	}
+	sort.Float64s(mode)
+	return mode, best
+}
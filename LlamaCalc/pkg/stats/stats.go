@@ -0,0 +1,126 @@
+// Package stats computes descriptive statistics of datasets.
+//
+// Describe computes exact statistics of a dataset held in memory. An
+// Accumulator computes the same statistics online in bounded memory for
+// datasets that arrive in chunks: sums are compensated (Neumaier), moments
+// are updated with Welford's method and percentiles are estimated with a
+// t-digest. Errors use the sentinels of package calc.
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"llamacalc/pkg/calc"
+)
+
+// Summary holds the descriptive statistics of a dataset
+type Summary struct {
+	Count int64
+	// Sum is computed with compensated summation
+	Sum  float64
+	Mean float64
+	// Variance is the sample variance; it is 0 for a single value
+	Variance float64
+	StdDev   float64
+	Min      float64
+	Max      float64
+	Median   float64
+	// Percentiles holds the value of each requested percentile, in order
+	Percentiles []float64
+	// Mode holds the most frequent values in ascending order. It is empty if
+	// no value occurs more than once, or if an Accumulator saw too many
+	// distinct values to track their frequencies.
+	Mode      []float64
+	ModeCount int64
+	// Skewness is the moment coefficient of skewness g1; it is 0 if all
+	// values are equal
+	Skewness float64
+	// Kurtosis is the excess kurtosis g2; it is 0 if all values are equal
+	Kurtosis float64
+	// Approximate is set when Median and Percentiles are estimates
+	Approximate bool
+}
+
+// ValidatePercentiles checks that every percentile is between 0 and 100
+func ValidatePercentiles(percentiles []float64) error {
+	for i, p := range percentiles {
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			return &calc.FieldError{
+				Field: fmt.Sprintf("percentiles[%d]", i),
+				Err:   fmt.Errorf("%w: percentile must be between 0 and 100", calc.ErrInvalidInput),
+			}
+		}
+	}
+	return nil
+}
+
+// Describe computes exact statistics of data. Percentiles interpolate
+// linearly between the closest ranks.
+func Describe(data []float64, percentiles []float64) (*Summary, error) {
+	if err := ValidatePercentiles(percentiles); err != nil {
+		return nil, err
+	}
+
+	// Moments are computed like for streamed data, without the digest and
+	// the frequency table that are replaced by exact values below
+	acc := &Accumulator{}
+	if err := acc.add(data); err != nil {
+		return nil, err
+	}
+	s, err := acc.summary()
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]float64, len(data))
+	copy(sorted, data)
+	sort.Float64s(sorted)
+
+	s.Median = quantile(sorted, 0.5)
+	s.Percentiles = make([]float64, len(percentiles))
+	for i, p := range percentiles {
+		s.Percentiles[i] = quantile(sorted, p/100)
+	}
+	s.Mode, s.ModeCount = sortedMode(sorted)
+
+	return s, nil
+}
+
+// quantile returns the q-quantile of sorted data, interpolating linearly
+// between the closest ranks
+func quantile(sorted []float64, q float64) float64 {
+	h := q * float64(len(sorted)-1)
+	lo := math.Floor(h)
+	i := int(lo)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (h-lo)*(sorted[i+1]-sorted[i])
+}
+
+// sortedMode returns the most frequent values of sorted data and their
+// frequency
+func sortedMode(sorted []float64) ([]float64, int64) {
+	var mode []float64
+	var best int64 = 1
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j] == sorted[i] {
+			j++
+		}
+		switch count := int64(j - i); {
+		case count > best:
+			best = count
+			mode = []float64{sorted[i]}
+		case count == best && best > 1:
+			mode = append(mode, sorted[i])
+		}
+		i = j
+	}
+	if mode == nil {
+		return nil, 0
+	}
+	return mode, best
+}
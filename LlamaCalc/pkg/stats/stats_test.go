@@ -0,0 +1,344 @@
+package stats_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/stats"
+)
+
+// summarize returns the summary of data computed by Describe and by an
+// Accumulator fed in chunks of two values
+func summarize(t *testing.T, data []float64) map[string]*stats.Summary {
+	t.Helper()
+	described, err := stats.Describe(data, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acc := stats.NewAccumulator()
+	for i := 0; i < len(data); i += 2 {
+		if err := acc.Add(data[i:min(i+2, len(data))]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	accumulated, err := acc.Summary(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return map[string]*stats.Summary{"Describe": described, "Accumulator": accumulated}
+}
+
+// twoPass returns the mean and sample variance of data computed with the
+// two-pass algorithm
+func twoPass(data []float64) (mean, variance float64) {
+	for _, x := range data {
+		mean += x
+	}
+	mean /= float64(len(data))
+	for _, x := range data {
+		variance += (x - mean) * (x - mean)
+	}
+	return mean, variance / float64(len(data)-1)
+}
+
+func TestWelford(t *testing.T) {
+	// The naive sum of squares loses all digits of these values
+	data := []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}
+	mean, variance := twoPass(data)
+	if mean != 1e9+10 || variance != 30 {
+		t.Fatalf("two-pass reference is %v, %v", mean, variance)
+	}
+
+	for name, s := range summarize(t, data) {
+		if s.Mean != mean || math.Abs(s.Variance-variance) > 1e-9 || math.Abs(s.StdDev-math.Sqrt(variance)) > 1e-9 {
+			t.Errorf("%s: got mean %v and variance %v, want %v and %v", name, s.Mean, s.Variance, mean, variance)
+		}
+		if s.Min != 1e9+4 || s.Max != 1e9+16 || s.Median != 1e9+10 {
+			t.Errorf("%s: got min %v, max %v and median %v", name, s.Min, s.Max, s.Median)
+		}
+	}
+}
+
+func TestNeumaier(t *testing.T) {
+	tests := []struct {
+		Name string
+		Data []float64
+		Want float64
+	}{
+		// Kahan summation returns 0 for this one
+		{"large term in between", []float64{1, 1e20, 1, -1e20}, 2},
+		{"cancellation", []float64{1e16, 1, -1e16}, 1},
+		{"many small terms", append([]float64{1}, repeat(1e-16, 1000)...), 1 + 1e-13},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			for name, s := range summarize(t, tc.Data) {
+				if math.Abs(s.Sum-tc.Want) > 1e-15*math.Abs(tc.Want) {
+					t.Errorf("%s: got sum %v, want %v", name, s.Sum, tc.Want)
+				}
+			}
+		})
+	}
+}
+
+// repeat returns n copies of x
+func repeat(x float64, n int) []float64 {
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = x
+	}
+	return data
+}
+
+func TestMoments(t *testing.T) {
+	tests := []struct {
+		Name               string
+		Data               []float64
+		Skewness, Kurtosis float64
+	}{
+		{"right-skewed", []float64{2, 4, 4, 4, 5, 5, 7, 9}, 0.65625, -0.21875},
+		{"left-skewed", []float64{-2, -4, -4, -4, -5, -5, -7, -9}, -0.65625, -0.21875},
+		{"uniform", []float64{1, 2, 3, 4, 5}, 0, -1.3},
+		{"two points", []float64{0, 1}, 0, -2},
+		{"heavy tail", []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 10}, 8 / 3.0, 73/9.0 - 3},
+		{"constant", []float64{3, 3, 3}, 0, 0},
+		{"single value", []float64{3}, 0, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			for name, s := range summarize(t, tc.Data) {
+				if math.Abs(s.Skewness-tc.Skewness) > 1e-12 || math.Abs(s.Kurtosis-tc.Kurtosis) > 1e-12 {
+					t.Errorf("%s: got skewness %v and kurtosis %v, want %v and %v", name, s.Skewness, s.Kurtosis, tc.Skewness, tc.Kurtosis)
+				}
+			}
+		})
+	}
+}
+
+func TestMode(t *testing.T) {
+	for name, s := range summarize(t, []float64{3, 1, 2, 3, 1, 5}) {
+		if len(s.Mode) != 2 || s.Mode[0] != 1 || s.Mode[1] != 3 || s.ModeCount != 2 {
+			t.Errorf("%s: got mode %v (%d), want [1 3] (2)", name, s.Mode, s.ModeCount)
+		}
+	}
+	for name, s := range summarize(t, []float64{1, 2, 3}) {
+		if s.Mode != nil || s.ModeCount != 0 {
+			t.Errorf("%s: got mode %v (%d) of distinct values", name, s.Mode, s.ModeCount)
+		}
+	}
+
+	// Frequencies are counted up to MaxModeValues distinct values
+	acc := stats.NewAccumulator()
+	for i := 0; i < stats.MaxModeValues; i++ {
+		if err := acc.Add(float64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := acc.Add(7); err != nil {
+		t.Fatal(err)
+	}
+	s, err := acc.Summary(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Mode) != 1 || s.Mode[0] != 7 || s.ModeCount != 2 {
+		t.Errorf("at the limit: got mode %v (%d), want [7] (2)", s.Mode, s.ModeCount)
+	}
+
+	if err := acc.Add(-1, 7); err != nil {
+		t.Fatal(err)
+	}
+	s, err = acc.Summary(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Mode != nil || s.ModeCount != 0 {
+		t.Errorf("beyond the limit: got mode %v (%d)", s.Mode, s.ModeCount)
+	}
+	if s.Count != stats.MaxModeValues+3 {
+		t.Errorf("got count %d", s.Count)
+	}
+}
+
+func TestPercentiles(t *testing.T) {
+	data := []float64{15, 20, 35, 40, 50}
+	want := []float64{15, 17, 35, 48, 50}
+	percentiles := []float64{0, 10, 50, 95, 100}
+
+	described, err := stats.Describe(data, percentiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	acc := stats.NewAccumulator()
+	if err := acc.Add(data...); err != nil {
+		t.Fatal(err)
+	}
+	accumulated, err := acc.Summary(percentiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The digest is exact until it merges values
+	for name, s := range map[string]*stats.Summary{"Describe": described, "Accumulator": accumulated} {
+		for i, p := range s.Percentiles {
+			if math.Abs(p-want[i]) > 1e-12 {
+				t.Errorf("%s: got percentile %v = %v, want %v", name, percentiles[i], p, want[i])
+			}
+		}
+		if s.Median != 35 || s.Approximate {
+			t.Errorf("%s: got median %v, approximate %v", name, s.Median, s.Approximate)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Data        []float64
+		Percentiles []float64
+		Field       string
+		Want        error
+	}{
+		{"empty", nil, nil, "data", calc.ErrInvalidInput},
+		{"NaN", []float64{1, math.NaN()}, nil, "data[1]", calc.ErrInvalidInput},
+		{"infinity", []float64{math.Inf(1)}, nil, "data[0]", calc.ErrInvalidInput},
+		{"negative percentile", []float64{1}, []float64{50, -1}, "percentiles[1]", calc.ErrInvalidInput},
+		{"percentile above 100", []float64{1}, []float64{101}, "percentiles[0]", calc.ErrInvalidInput},
+		{"NaN percentile", []float64{1}, []float64{math.NaN()}, "percentiles[0]", calc.ErrInvalidInput},
+		{"overflow", []float64{math.MaxFloat64, math.MaxFloat64}, nil, "", calc.ErrOverflow},
+		{"underflow", []float64{-math.MaxFloat64, -math.MaxFloat64}, nil, "", calc.ErrUnderflow},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			acc := stats.NewAccumulator()
+			err := acc.Add(tc.Data...)
+			if err == nil {
+				_, err = acc.Summary(tc.Percentiles)
+			}
+			_, described := stats.Describe(tc.Data, tc.Percentiles)
+
+			for name, err := range map[string]error{"Describe": described, "Accumulator": err} {
+				var fieldErr *calc.FieldError
+				if !errors.Is(err, tc.Want) || tc.Field != "" && (!errors.As(err, &fieldErr) || fieldErr.Field != tc.Field) {
+					t.Errorf("%s: got %v, want %v for field %q", name, err, tc.Want, tc.Field)
+				}
+			}
+		})
+	}
+
+	// Rejected chunks are not added, and fields count the values before them
+	acc := stats.NewAccumulator()
+	if err := acc.Add(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	var fieldErr *calc.FieldError
+	if err := acc.Add(3, math.NaN()); !errors.As(err, &fieldErr) || fieldErr.Field != "data[3]" {
+		t.Errorf("got %v, want an error for data[3]", err)
+	}
+	if acc.Count() != 2 {
+		t.Errorf("got count %d after a rejected chunk, want 2", acc.Count())
+	}
+}
+
+// rankError returns the difference between the rank of x in sorted, as a
+// fraction of the dataset, and q
+func rankError(sorted []float64, x, q float64) float64 {
+	return float64(sort.SearchFloat64s(sorted, x))/float64(len(sorted)-1) - q
+}
+
+func TestTDigest(t *testing.T) {
+	const n = 100000
+	r := rand.New(rand.NewSource(1))
+	normal := make([]float64, n)
+	for i := range normal {
+		normal[i] = r.NormFloat64()
+	}
+	ascending := make([]float64, n)
+	for i := range ascending {
+		ascending[i] = float64(i)
+	}
+
+	tests := []struct {
+		Name   string
+		Data   []float64
+		Digest func(data []float64) *stats.TDigest
+	}{
+		{"normal", normal, digest},
+		{"ascending", ascending, digest},
+		{"merged halves", ascending, func(data []float64) *stats.TDigest {
+			d := digest(data[:n/2])
+			d.Merge(digest(data[n/2:]))
+			return d
+		}},
+		{"merged interleaved", normal, func(data []float64) *stats.TDigest {
+			even, odd := stats.NewTDigest(stats.DefaultCompression), stats.NewTDigest(stats.DefaultCompression)
+			for i, x := range data {
+				if i%2 == 0 {
+					even.Add(x)
+				} else {
+					odd.Add(x)
+				}
+			}
+			odd.Merge(even)
+			return odd
+		}},
+		{"merged into empty", normal, func(data []float64) *stats.TDigest {
+			d := stats.NewTDigest(stats.DefaultCompression)
+			d.Merge(digest(data))
+			return d
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := tc.Digest(tc.Data)
+			sorted := append([]float64(nil), tc.Data...)
+			sort.Float64s(sorted)
+
+			if d.Count() != n || d.Exact() {
+				t.Errorf("got count %d, exact %v", d.Count(), d.Exact())
+			}
+			if d.Quantile(0) != sorted[0] || d.Quantile(1) != sorted[n-1] {
+				t.Errorf("got extremes %v and %v, want %v and %v", d.Quantile(0), d.Quantile(1), sorted[0], sorted[n-1])
+			}
+			// The error in rank shrinks towards the tails
+			for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+				if err := rankError(sorted, d.Quantile(q), q); math.Abs(err) > 0.01*math.Sqrt(q*(1-q)) {
+					t.Errorf("q=%v: got %v with rank error %v", q, d.Quantile(q), err)
+				}
+			}
+		})
+	}
+}
+
+// digest returns a digest of data
+func digest(data []float64) *stats.TDigest {
+	d := stats.NewTDigest(stats.DefaultCompression)
+	for _, x := range data {
+		d.Add(x)
+	}
+	return d
+}
+
+func TestTDigestMergeExact(t *testing.T) {
+	// Digests that have not merged values yet stay exact
+	a, b := digest([]float64{5, 1}), digest([]float64{3, 2, 4})
+	a.Merge(b)
+	if a.Count() != 5 || !a.Exact() || a.Quantile(0.5) != 3 || a.Quantile(0.25) != 2 {
+		t.Errorf("got count %d, exact %v, median %v", a.Count(), a.Exact(), a.Quantile(0.5))
+	}
+	if b.Count() != 3 || b.Quantile(0.5) != 3 {
+		t.Errorf("merging changed the other digest to count %d, median %v", b.Count(), b.Quantile(0.5))
+	}
+
+	empty := stats.NewTDigest(stats.DefaultCompression)
+	a.Merge(empty)
+	if a.Count() != 5 || a.Quantile(0) != 1 || a.Quantile(1) != 5 {
+		t.Errorf("merging an empty digest changed the count to %d", a.Count())
+	}
+}
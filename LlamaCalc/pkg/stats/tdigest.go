@@ -0,0 +1,171 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultCompression is the t-digest compression used by NewAccumulator.
+// Digests keep at most about this many centroids; quantile estimates are
+// most accurate towards the tails.
+const DefaultCompression = 100
+
+// centroid is a cluster of values with their mean and number
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// TDigest estimates quantiles of a stream of values in bounded memory. It is
+// a merging t-digest: values are buffered and periodically merged into
+// centroids whose size is limited by the k1 scale function.
+type TDigest struct {
+	compression float64
+	centroids   []centroid
+	buffer      []float64
+	count       float64
+	min, max    float64
+}
+
+// NewTDigest creates an empty digest with the given compression
+func NewTDigest(compression float64) *TDigest {
+	return &TDigest{
+		compression: compression,
+		buffer:      make([]float64, 0, 5*int(compression)),
+	}
+}
+
+// Add adds a value to the digest
+func (d *TDigest) Add(x float64) {
+	if d.count == 0 || x < d.min {
+		d.min = x
+	}
+	if d.count == 0 || x > d.max {
+		d.max = x
+	}
+	d.count++
+
+	d.buffer = append(d.buffer, x)
+	if len(d.buffer) == cap(d.buffer) {
+		d.merge()
+	}
+}
+
+// Merge adds the values of other to d, e.g. to combine the digests of
+// partitions of a dataset. other is not changed.
+func (d *TDigest) Merge(other *TDigest) {
+	if other.count == 0 {
+		return
+	}
+	if d.count == 0 || other.min < d.min {
+		d.min = other.min
+	}
+	if d.count == 0 || other.max > d.max {
+		d.max = other.max
+	}
+	d.count += other.count
+
+	centroids := make([]centroid, 0, len(other.centroids)+len(other.buffer))
+	centroids = append(centroids, other.centroids...)
+	for _, x := range other.buffer {
+		centroids = append(centroids, centroid{mean: x, weight: 1})
+	}
+	d.merge(centroids...)
+}
+
+// Count returns the number of values added
+func (d *TDigest) Count() int64 {
+	return int64(d.count)
+}
+
+// Exact reports whether no values have been merged into a centroid yet, in
+// which case quantiles are exact
+func (d *TDigest) Exact() bool {
+	d.merge()
+	for _, c := range d.centroids {
+		if c.weight > 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// merge merges the buffered values and extra centroids into the centroids
+func (d *TDigest) merge(extra ...centroid) {
+	if len(d.buffer) == 0 && len(extra) == 0 {
+		return
+	}
+
+	all := make([]centroid, 0, len(d.centroids)+len(extra)+len(d.buffer))
+	all = append(all, d.centroids...)
+	all = append(all, extra...)
+	for _, x := range d.buffer {
+		all = append(all, centroid{mean: x, weight: 1})
+	}
+	d.buffer = d.buffer[:0]
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	// Merge neighbours while the merged centroid spans at most one unit of
+	// the scale function k(q) = compression / (2*pi) * asin(2q - 1), which
+	// keeps centroids small near the tails
+	merged := all[:1]
+	var before float64
+	kLow := d.scale(0)
+	for _, c := range all[1:] {
+		last := &merged[len(merged)-1]
+		q := (before + last.weight + c.weight) / d.count
+		if d.scale(q)-kLow <= 1 {
+			last.weight += c.weight
+			last.mean += (c.mean - last.mean) * c.weight / last.weight
+			continue
+		}
+		before += last.weight
+		kLow = d.scale(before / d.count)
+		merged = append(merged, c)
+	}
+
+	d.centroids = append(d.centroids[:0], merged...)
+}
+
+// scale is the k1 scale function
+func (d *TDigest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*math.Min(q, 1)-1)
+}
+
+// Quantile estimates the q-quantile, 0 <= q <= 1. It interpolates linearly
+// between the centres of the centroids like the exact quantile of sorted
+// data, to which it is equal while Exact reports true.
+func (d *TDigest) Quantile(q float64) float64 {
+	d.merge()
+	if d.count == 0 {
+		return 0
+	}
+
+	// The value at rank h is found at cumulative weight h + 0.5; single
+	// values sit at the centre of their unit of weight
+	target := q*(d.count-1) + 0.5
+
+	prevPos, prevMean := 0.5, d.min
+	var cumulative float64
+	for _, c := range d.centroids {
+		pos := cumulative + c.weight/2
+		cumulative += c.weight
+		if pos < prevPos {
+			continue
+		}
+		if target <= pos {
+			return interpolate(prevPos, prevMean, pos, c.mean, target)
+		}
+		prevPos, prevMean = pos, c.mean
+	}
+
+	return interpolate(prevPos, prevMean, d.count-0.5, d.max, target)
+}
+
+// interpolate returns the value at x on the line through (x0, y0) and (x1, y1)
+func interpolate(x0, y0, x1, y1, x float64) float64 {
+	if x1 <= x0 {
+		return y1
+	}
+	return y0 + (x-x0)/(x1-x0)*(y1-y0)
+}
@@ -0,0 +1,474 @@
+package symbolic
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"llamacalc/pkg/calc/expr"
+)
+
+// maxFoldBits limits the size of powers of numbers that are folded into a
+// single number, so that e.g. 10^100000 stays a power
+const maxFoldBits = 1024
+
+// Simplify returns an equivalent expression in canonical form. Numbers are
+// folded exactly, like terms and powers of the same base are combined,
+// x*1, x+0 and x^1 become x, and terms are ordered by decreasing degree.
+// Products are not expanded. Simplification assumes that divisors are not
+// zero, so x/x becomes 1, but keeps divisions of numbers by zero.
+func Simplify(node expr.Node) expr.Node {
+	return fromNode(node).node()
+}
+
+// arithmetic are the operators that are simplified, by the lowercase name
+// of their operation
+var arithmetic = map[string]string{}
+
+func init() {
+	for _, op := range []string{"+", "-", "*", "/", "^"} {
+		arithmetic[strings.ToLower(expr.Operators[op])] = op
+	}
+}
+
+// sum is the normal form of an expression: a constant plus terms, by key
+type sum struct {
+	constant *big.Rat
+	terms    map[string]*term
+}
+
+// term is a rational coefficient times a product of factors, by key
+type term struct {
+	coef    *big.Rat
+	factors map[string]*factor
+}
+
+// factor is a base raised to an exponent. The base is an identifier, a call,
+// an operator other than + - * / and ^, a sum of several terms, or a number
+// with an exponent that is not folded.
+type factor struct {
+	base expr.Node
+	exp  *sum
+}
+
+// constant returns the sum of a number
+func constant(r *big.Rat) *sum {
+	return &sum{constant: r, terms: map[string]*term{}}
+}
+
+// integer returns the sum of an integer
+func integer(n int64) *sum {
+	return constant(big.NewRat(n, 1))
+}
+
+// atom returns the sum of a node that is a factor of its own
+func atom(base expr.Node) *sum {
+	return fromTerm(&term{coef: big.NewRat(1, 1), factors: map[string]*factor{expr.Format(base): {base: base, exp: integer(1)}}})
+}
+
+// fromTerm returns the sum of a single term
+func fromTerm(t *term) *sum {
+	if t.coef.Sign() == 0 {
+		return integer(0)
+	}
+	if len(t.factors) == 0 {
+		return constant(t.coef)
+	}
+	// A sum that is a factor of its own is a sum again
+	for _, f := range t.factors {
+		if len(t.factors) == 1 && f.exp.isOne() && isSum(f.base) {
+			return fromNode(f.base).scale(t.coef)
+		}
+	}
+	return &sum{constant: new(big.Rat), terms: map[string]*term{t.key(): t}}
+}
+
+// isSum reports whether a node adds or subtracts terms
+func isSum(node expr.Node) bool {
+	b, ok := node.(*expr.Binary)
+	return ok && (b.Op == "+" || b.Op == "-")
+}
+
+// fromNode converts an expression to its normal form
+func fromNode(node expr.Node) *sum {
+	switch n := node.(type) {
+	case *expr.Number:
+		if r, ok := numberRat(n); ok {
+			return constant(r)
+		}
+		return atom(n)
+
+	case *expr.Ident:
+		return atom(&expr.Ident{Name: strings.ToLower(n.Name)})
+
+	case *expr.Unary:
+		switch n.Op {
+		case "-":
+			return fromNode(n.X).scale(big.NewRat(-1, 1))
+		case "+":
+			return fromNode(n.X)
+		}
+		return atom(&expr.Unary{Op: n.Op, X: Simplify(n.X)})
+
+	case *expr.Binary:
+		x, y := fromNode(n.X), fromNode(n.Y)
+		switch n.Op {
+		case "+":
+			return x.add(y)
+		case "-":
+			return x.add(y.scale(big.NewRat(-1, 1)))
+		case "*":
+			return x.mul(y)
+		case "/":
+			return x.mul(y.pow(integer(-1)))
+		case "^":
+			return x.pow(y)
+		}
+		return atom(&expr.Binary{Op: n.Op, X: x.node(), Y: y.node()})
+
+	case *expr.Call:
+		// Operations called by name are operators, e.g. pow(x, 2) is x^2
+		if op, ok := arithmetic[strings.ToLower(n.Func)]; ok && len(n.Args) == 2 {
+			return fromNode(&expr.Binary{Op: op, X: n.Args[0], Y: n.Args[1]})
+		}
+		call := &expr.Call{Func: strings.ToLower(n.Func), Args: make([]expr.Node, len(n.Args))}
+		for i, arg := range n.Args {
+			call.Args[i] = Simplify(arg)
+		}
+		if r, ok := fold(call); ok {
+			return constant(r)
+		}
+		return atom(call)
+	}
+	return atom(node)
+}
+
+// numberRat returns the exact value of a number. Numbers that overflow or
+// underflow are kept as written rather than expanded to huge fractions.
+func numberRat(n *expr.Number) (*big.Rat, bool) {
+	if math.IsInf(n.Value, 0) || n.Value == 0 && strings.ContainsAny(n.Text, "123456789") {
+		return nil, false
+	}
+	if n.Text != "" {
+		if r, ok := new(big.Rat).SetString(n.Text); ok {
+			return r, true
+		}
+	}
+	if r := new(big.Rat); n.Value == n.Value && n.Value-n.Value == 0 {
+		return r.SetFloat64(n.Value), true
+	}
+	return nil, false
+}
+
+// isConstant reports whether s is a number
+func (s *sum) isConstant() bool {
+	return len(s.terms) == 0
+}
+
+// isOne reports whether s is the number 1
+func (s *sum) isOne() bool {
+	return s.isConstant() && s.constant.Cmp(big.NewRat(1, 1)) == 0
+}
+
+// single returns the term of a sum that is a single term
+func (s *sum) single() (*term, bool) {
+	if s.constant.Sign() != 0 || len(s.terms) != 1 {
+		return nil, false
+	}
+	for _, t := range s.terms {
+		return t, true
+	}
+	return nil, false
+}
+
+// asTerm returns s as a single term, which is s itself as a factor if s
+// has several terms
+func (s *sum) asTerm() *term {
+	if s.isConstant() {
+		return &term{coef: s.constant, factors: map[string]*factor{}}
+	}
+	if t, ok := s.single(); ok {
+		return t
+	}
+	base := s.node()
+	return &term{coef: big.NewRat(1, 1), factors: map[string]*factor{expr.Format(base): {base: base, exp: integer(1)}}}
+}
+
+// undefined reports whether s divides a number by zero
+func (s *sum) undefined() bool {
+	for _, t := range s.terms {
+		for _, f := range t.factors {
+			if n, ok := f.base.(*expr.Number); ok && n.Value == 0 && f.exp.isConstant() && f.exp.constant.Sign() < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scale returns s times r
+func (s *sum) scale(r *big.Rat) *sum {
+	if r.Sign() == 0 && !s.undefined() {
+		return integer(0)
+	}
+	result := constant(new(big.Rat).Mul(s.constant, r))
+	for key, t := range s.terms {
+		result.terms[key] = &term{coef: new(big.Rat).Mul(t.coef, r), factors: t.factors}
+	}
+	return result
+}
+
+// add returns s + o
+func (s *sum) add(o *sum) *sum {
+	result := constant(new(big.Rat).Add(s.constant, o.constant))
+	for key, t := range s.terms {
+		result.terms[key] = t
+	}
+	for key, t := range o.terms {
+		existing, ok := result.terms[key]
+		if !ok {
+			result.terms[key] = t
+			continue
+		}
+		coef := new(big.Rat).Add(existing.coef, t.coef)
+		if coef.Sign() == 0 {
+			delete(result.terms, key)
+			continue
+		}
+		result.terms[key] = &term{coef: coef, factors: t.factors}
+	}
+	return result
+}
+
+// mul returns s * o without expanding sums of several terms
+func (s *sum) mul(o *sum) *sum {
+	if s.isConstant() {
+		return o.scale(s.constant)
+	}
+	if o.isConstant() {
+		return s.scale(o.constant)
+	}
+	return fromTerm(s.asTerm().mul(o.asTerm()))
+}
+
+// mul returns t * o, combining the exponents of factors with equal bases
+func (t *term) mul(o *term) *term {
+	result := &term{coef: new(big.Rat).Mul(t.coef, o.coef), factors: make(map[string]*factor, len(t.factors)+len(o.factors))}
+	for key, f := range t.factors {
+		result.factors[key] = f
+	}
+	for key, f := range o.factors {
+		existing, ok := result.factors[key]
+		if !ok {
+			result.factors[key] = f
+			continue
+		}
+		exp := existing.exp.add(f.exp)
+		if exp.isConstant() && exp.constant.Sign() == 0 {
+			delete(result.factors, key)
+			continue
+		}
+		result.factors[key] = &factor{base: f.base, exp: exp}
+	}
+	return result
+}
+
+// pow returns s^e. Integer powers of a single term distribute over its
+// factors; other powers of anything but a single factor are kept whole.
+func (s *sum) pow(e *sum) *sum {
+	if e.isConstant() {
+		n := e.constant
+		switch {
+		case n.Sign() == 0:
+			return integer(1)
+		case n.Cmp(big.NewRat(1, 1)) == 0:
+			return s
+		}
+
+		if n.IsInt() {
+			if s.isConstant() {
+				if r, ok := powRat(s.constant, n); ok {
+					return constant(r)
+				}
+			} else if t, ok := s.single(); ok {
+				if coef, ok := powRat(t.coef, n); ok {
+					result := &term{coef: coef, factors: make(map[string]*factor, len(t.factors))}
+					for key, f := range t.factors {
+						result.factors[key] = &factor{base: f.base, exp: f.exp.mul(e)}
+					}
+					return fromTerm(result)
+				}
+			}
+		}
+	}
+	if s.isOne() {
+		return s
+	}
+
+	// x^a stays a single factor
+	if t, ok := s.single(); ok && t.coef.Cmp(big.NewRat(1, 1)) == 0 && len(t.factors) == 1 {
+		for key, f := range t.factors {
+			if f.exp.isOne() {
+				return fromTerm(&term{coef: big.NewRat(1, 1), factors: map[string]*factor{key: {base: f.base, exp: e}}})
+			}
+		}
+	}
+	base := s.node()
+	return fromTerm(&term{coef: big.NewRat(1, 1), factors: map[string]*factor{expr.Format(base): {base: base, exp: e}}})
+}
+
+// powRat returns r^n for an integer n, unless the result would be too large
+// or r is zero and n negative
+func powRat(r, n *big.Rat) (*big.Rat, bool) {
+	exp := n.Num()
+	if r.Sign() == 0 {
+		return new(big.Rat), exp.Sign() > 0
+	}
+	if r.IsInt() && r.Num().CmpAbs(big.NewInt(1)) == 0 {
+		if exp.Bit(0) == 0 {
+			return big.NewRat(1, 1), true
+		}
+		return r, true
+	}
+	if !exp.IsInt64() || (r.Num().BitLen()+r.Denom().BitLen())*int(min(max(exp.Int64(), -exp.Int64()), maxFoldBits+1)) > maxFoldBits {
+		return nil, false
+	}
+	k := exp.Int64()
+	num := new(big.Int).Exp(r.Num(), big.NewInt(max(k, -k)), nil)
+	den := new(big.Int).Exp(r.Denom(), big.NewInt(max(k, -k)), nil)
+	if k < 0 {
+		num, den = den, num
+	}
+	return new(big.Rat).SetFrac(num, den), true
+}
+
+// key returns the key of the product of a term's factors
+func (t *term) key() string {
+	return expr.Format(productNode(big.NewRat(1, 1), t.factors))
+}
+
+// degree returns the sum of the numeric exponents of a term
+func (t *term) degree() float64 {
+	var degree float64
+	for _, f := range t.factors {
+		if f.exp.isConstant() {
+			exp, _ := f.exp.constant.Float64()
+			degree += exp
+		}
+	}
+	return degree
+}
+
+// node converts s back to an expression with terms of decreasing degree
+// and the constant last
+func (s *sum) node() expr.Node {
+	keys := make([]string, 0, len(s.terms))
+	for key := range s.terms {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		di, dj := s.terms[keys[i]].degree(), s.terms[keys[j]].degree()
+		if di != dj {
+			return di > dj
+		}
+		return keys[i] < keys[j]
+	})
+
+	var result expr.Node
+	appendTerm := func(coef *big.Rat, term expr.Node) {
+		switch {
+		case result == nil && coef.Sign() < 0:
+			result = negate(term)
+		case result == nil:
+			result = term
+		case coef.Sign() < 0:
+			result = &expr.Binary{Op: "-", X: result, Y: term}
+		default:
+			result = &expr.Binary{Op: "+", X: result, Y: term}
+		}
+	}
+	for _, key := range keys {
+		t := s.terms[key]
+		appendTerm(t.coef, productNode(new(big.Rat).Abs(t.coef), t.factors))
+	}
+	if s.constant.Sign() != 0 || result == nil {
+		appendTerm(s.constant, ratNode(new(big.Rat).Abs(s.constant)))
+	}
+	return result
+}
+
+// negate negates the leftmost factor of a product, e.g. -3*x/4 rather than
+// -(3*x/4)
+func negate(node expr.Node) expr.Node {
+	if b, ok := node.(*expr.Binary); ok && (b.Op == "*" || b.Op == "/") {
+		return &expr.Binary{Op: b.Op, X: negate(b.X), Y: b.Y}
+	}
+	return &expr.Unary{Op: "-", X: node}
+}
+
+// productNode returns the product of a non-negative coefficient and factors,
+// with the factors with negative numeric exponents as the divisor
+func productNode(coef *big.Rat, factors map[string]*factor) expr.Node {
+	keys := make([]string, 0, len(factors))
+	for key := range factors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var numerator, denominator []expr.Node
+	if coef.Num().Cmp(big.NewInt(1)) != 0 {
+		numerator = append(numerator, intNode(coef.Num()))
+	}
+	if !coef.IsInt() {
+		denominator = append(denominator, intNode(coef.Denom()))
+	}
+	for _, key := range keys {
+		f := factors[key]
+		if f.exp.isConstant() && f.exp.constant.Sign() < 0 {
+			denominator = append(denominator, powerNode(f.base, f.exp.scale(big.NewRat(-1, 1))))
+		} else {
+			numerator = append(numerator, powerNode(f.base, f.exp))
+		}
+	}
+
+	if len(numerator) == 0 {
+		numerator = append(numerator, intNode(big.NewInt(1)))
+	}
+	result := product(numerator)
+	if len(denominator) > 0 {
+		result = &expr.Binary{Op: "/", X: result, Y: product(denominator)}
+	}
+	return result
+}
+
+// product multiplies nodes from left to right
+func product(nodes []expr.Node) expr.Node {
+	result := nodes[0]
+	for _, node := range nodes[1:] {
+		result = &expr.Binary{Op: "*", X: result, Y: node}
+	}
+	return result
+}
+
+// powerNode returns base^exp, or base if exp is 1
+func powerNode(base expr.Node, exp *sum) expr.Node {
+	if exp.isOne() {
+		return base
+	}
+	return &expr.Binary{Op: "^", X: base, Y: exp.node()}
+}
+
+// ratNode returns a node of a non-negative rational number
+func ratNode(r *big.Rat) expr.Node {
+	if r.IsInt() {
+		return intNode(r.Num())
+	}
+	return &expr.Binary{Op: "/", X: intNode(r.Num()), Y: intNode(r.Denom())}
+}
+
+// intNode returns a node of a non-negative integer
+func intNode(n *big.Int) expr.Node {
+	value, _ := new(big.Float).SetInt(n).Float64()
+	return &expr.Number{Value: value, Text: n.String()}
+}
@@ -0,0 +1,342 @@
+// Package symbolic differentiates and simplifies parsed expressions.
+//
+// Derivatives follow the sum, product, quotient, power and chain rules, with
+// the trigonometric functions in radians. Results are simplified exactly:
+// numbers are rational, and functions of numbers are only folded for values
+// that are known exactly, such as sqrt(4) or cos(0).
+package symbolic
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+)
+
+const (
+	// MaxOrder is the highest order of derivatives
+	MaxOrder = 10
+	// MaxNodes is the largest number of nodes of a result
+	MaxNodes = 10000
+
+	// maxUnsimplified is the largest number of nodes of a derivative before
+	// simplification
+	maxUnsimplified = 2 * MaxNodes
+)
+
+// errTooLarge reports a derivative with more than MaxNodes nodes
+var errTooLarge = &calc.FieldError{Field: "expression", Err: fmt.Errorf("%w: derivative has more than %d nodes", calc.ErrInvalidInput, MaxNodes)}
+
+// Differentiate returns the simplified derivative of node of the given
+// order with respect to variable
+func Differentiate(node expr.Node, variable string, order int) (expr.Node, error) {
+	if err := checkVariable(variable); err != nil {
+		return nil, err
+	}
+	if order < 1 || order > MaxOrder {
+		return nil, &calc.FieldError{Field: "order", Err: fmt.Errorf("%w: order must be between 1 and %d", calc.ErrInvalidInput, MaxOrder)}
+	}
+
+	d := Simplify(node)
+	for i := 0; i < order; i++ {
+		var err error
+		if d, err = derivative(d, strings.ToLower(variable)); err != nil {
+			return nil, &calc.FieldError{Field: "expression", Err: err}
+		}
+		// Rules copy their arguments, so unsimplified derivatives are larger
+		if size(d, maxUnsimplified) > maxUnsimplified {
+			return nil, errTooLarge
+		}
+		if d = Simplify(d); size(d, MaxNodes) > MaxNodes {
+			return nil, errTooLarge
+		}
+	}
+	return d, nil
+}
+
+// checkVariable checks that variable is a name that is not a constant
+func checkVariable(variable string) error {
+	name, err := expr.Parse(variable)
+	if ident, ok := name.(*expr.Ident); err != nil || !ok || ident.Name != variable {
+		return &calc.FieldError{Field: "variable", Err: fmt.Errorf("%w: %q is not a name", calc.ErrInvalidInput, variable)}
+	}
+	if _, ok := calc.Constants[strings.ToLower(variable)]; ok {
+		return &calc.FieldError{Field: "variable", Err: fmt.Errorf("%w: %s is a constant", calc.ErrInvalidInput, variable)}
+	}
+	return nil
+}
+
+// derivative differentiates a simplified node, whose names are lowercase
+func derivative(node expr.Node, variable string) (expr.Node, error) {
+	if !depends(node, variable) {
+		return number(0), nil
+	}
+
+	switch n := node.(type) {
+	case *expr.Ident:
+		return number(1), nil
+
+	case *expr.Unary:
+		if n.Op != "-" && n.Op != "+" {
+			return nil, fmt.Errorf("%w: no derivative rule for %s", calc.ErrInvalidInput, n.Op)
+		}
+		dx, err := derivative(n.X, variable)
+		if err != nil {
+			return nil, err
+		}
+		return &expr.Unary{Op: n.Op, X: dx}, nil
+
+	case *expr.Binary:
+		return binaryDerivative(n, variable)
+
+	case *expr.Call:
+		return callDerivative(n, variable)
+	}
+	return nil, fmt.Errorf("%w: no derivative rule for %s", calc.ErrInvalidInput, node)
+}
+
+// binaryDerivative applies the sum, product, quotient and power rules
+func binaryDerivative(n *expr.Binary, variable string) (expr.Node, error) {
+	if n.Op != "+" && n.Op != "-" && n.Op != "*" && n.Op != "/" && n.Op != "^" {
+		return nil, fmt.Errorf("%w: no derivative rule for %s", calc.ErrInvalidInput, n.Op)
+	}
+	dx, err := derivative(n.X, variable)
+	if err != nil {
+		return nil, err
+	}
+	dy, err := derivative(n.Y, variable)
+	if err != nil {
+		return nil, err
+	}
+
+	x, y := n.X, n.Y
+	switch n.Op {
+	case "+", "-":
+		return binary(n.Op, dx, dy), nil
+	case "*":
+		return binary("+", binary("*", dx, y), binary("*", x, dy)), nil
+	case "/":
+		return binary("/", binary("-", binary("*", dx, y), binary("*", x, dy)), binary("^", y, number(2))), nil
+	}
+
+	switch {
+	case !depends(y, variable):
+		// (x^y)' = y*x^(y - 1)*x'
+		return binary("*", binary("*", y, binary("^", x, binary("-", y, number(1)))), dx), nil
+	case !depends(x, variable):
+		// (x^y)' = x^y*ln(x)*y'
+		return binary("*", binary("*", n, call("ln", x)), dy), nil
+	}
+	// (x^y)' = x^y*(y'*ln(x) + y*x'/x)
+	return binary("*", n, binary("+", binary("*", dy, call("ln", x)), binary("/", binary("*", y, dx), x))), nil
+}
+
+// derivatives are the derivatives of functions of one argument, to be
+// multiplied by the derivative of the argument
+var derivatives = map[string]func(u expr.Node) expr.Node{
+	"sqrt":  func(u expr.Node) expr.Node { return binary("/", number(1), binary("*", number(2), call("sqrt", u))) },
+	"exp":   func(u expr.Node) expr.Node { return call("exp", u) },
+	"ln":    func(u expr.Node) expr.Node { return binary("/", number(1), u) },
+	"log10": func(u expr.Node) expr.Node { return binary("/", number(1), binary("*", u, call("ln", number(10)))) },
+	"sin":   func(u expr.Node) expr.Node { return call("cos", u) },
+	"cos":   func(u expr.Node) expr.Node { return &expr.Unary{Op: "-", X: call("sin", u)} },
+	"tan":   func(u expr.Node) expr.Node { return binary("/", number(1), binary("^", call("cos", u), number(2))) },
+	"asin": func(u expr.Node) expr.Node {
+		return binary("/", number(1), call("sqrt", binary("-", number(1), binary("^", u, number(2)))))
+	},
+	"acos": func(u expr.Node) expr.Node {
+		return binary("/", number(-1), call("sqrt", binary("-", number(1), binary("^", u, number(2)))))
+	},
+	"atan": func(u expr.Node) expr.Node {
+		return binary("/", number(1), binary("+", number(1), binary("^", u, number(2))))
+	},
+	"sinh": func(u expr.Node) expr.Node { return call("cosh", u) },
+	"cosh": func(u expr.Node) expr.Node { return call("sinh", u) },
+	"tanh": func(u expr.Node) expr.Node { return binary("/", number(1), binary("^", call("cosh", u), number(2))) },
+	"asinh": func(u expr.Node) expr.Node {
+		return binary("/", number(1), call("sqrt", binary("+", binary("^", u, number(2)), number(1))))
+	},
+	"acosh": func(u expr.Node) expr.Node {
+		return binary("/", number(1), call("sqrt", binary("-", binary("^", u, number(2)), number(1))))
+	},
+	"atanh": func(u expr.Node) expr.Node {
+		return binary("/", number(1), binary("-", number(1), binary("^", u, number(2))))
+	},
+	"abs": func(u expr.Node) expr.Node { return binary("/", u, call("abs", u)) },
+}
+
+// callDerivative applies the chain rule
+func callDerivative(n *expr.Call, variable string) (expr.Node, error) {
+	switch {
+	case n.Func == "logbase" && len(n.Args) == 2:
+		// logbase(x, b) = ln(x)/ln(b)
+		return derivative(binary("/", call("ln", n.Args[0]), call("ln", n.Args[1])), variable)
+	case n.Func == "nthroot" && len(n.Args) == 2 && !depends(n.Args[1], variable):
+		// nthroot(u, n)' = nthroot(u, n)*u'/(n*u)
+		du, err := derivative(n.Args[0], variable)
+		if err != nil {
+			return nil, err
+		}
+		return binary("/", binary("*", n, du), binary("*", n.Args[1], n.Args[0])), nil
+	case n.Func == "nthroot" && len(n.Args) == 2:
+		return derivative(binary("^", n.Args[0], binary("/", number(1), n.Args[1])), variable)
+	}
+
+	rule, ok := derivatives[n.Func]
+	if !ok {
+		return nil, fmt.Errorf("%w: no derivative rule for %s", calc.ErrInvalidInput, n.Func)
+	}
+	if len(n.Args) != 1 {
+		return nil, fmt.Errorf("%w: %s expects 1 argument, got %d", calc.ErrInvalidInput, n.Func, len(n.Args))
+	}
+	du, err := derivative(n.Args[0], variable)
+	if err != nil {
+		return nil, err
+	}
+	return binary("*", rule(n.Args[0]), du), nil
+}
+
+// exactValues are the values of functions that are folded exactly. They
+// report false for arguments without an exact rational value.
+var exactValues = map[string]func(x *big.Rat) (*big.Rat, bool){
+	"sqrt": func(x *big.Rat) (*big.Rat, bool) {
+		if x.Sign() < 0 {
+			return nil, false
+		}
+		num, den := new(big.Int).Sqrt(x.Num()), new(big.Int).Sqrt(x.Denom())
+		r := new(big.Rat).SetFrac(num, den)
+		return r, new(big.Rat).Mul(r, r).Cmp(x) == 0
+	},
+	"abs":   func(x *big.Rat) (*big.Rat, bool) { return new(big.Rat).Abs(x), true },
+	"floor": func(x *big.Rat) (*big.Rat, bool) { return floor(x), true },
+	"ceil": func(x *big.Rat) (*big.Rat, bool) {
+		return new(big.Rat).Neg(floor(new(big.Rat).Neg(x))), true
+	},
+	"exp":   valueAt(0, 1),
+	"ln":    valueAt(1, 0),
+	"sin":   valueAt(0, 0),
+	"cos":   valueAt(0, 1),
+	"tan":   valueAt(0, 0),
+	"asin":  valueAt(0, 0),
+	"acos":  valueAt(1, 0),
+	"atan":  valueAt(0, 0),
+	"sinh":  valueAt(0, 0),
+	"cosh":  valueAt(0, 1),
+	"tanh":  valueAt(0, 0),
+	"asinh": valueAt(0, 0),
+	"acosh": valueAt(1, 0),
+	"atanh": valueAt(0, 0),
+}
+
+// valueAt returns a function that is only known to be y at x
+func valueAt(x, y int64) func(*big.Rat) (*big.Rat, bool) {
+	return func(r *big.Rat) (*big.Rat, bool) {
+		return big.NewRat(y, 1), r.Cmp(big.NewRat(x, 1)) == 0
+	}
+}
+
+// floor returns the largest integer not greater than x
+func floor(x *big.Rat) *big.Rat {
+	// Int division rounds towards negative infinity for positive divisors
+	q := new(big.Int).Div(x.Num(), x.Denom())
+	return new(big.Rat).SetInt(q)
+}
+
+// fold returns the exact value of a call of a function of one number
+func fold(n *expr.Call) (*big.Rat, bool) {
+	value, ok := exactValues[n.Func]
+	if !ok || len(n.Args) != 1 {
+		return nil, false
+	}
+	if x, ok := rat(n.Args[0]); ok {
+		return value(x)
+	}
+	return nil, false
+}
+
+// rat returns the value of a simplified number, which is an integer, a
+// fraction of integers, or a negated integer or fraction
+func rat(node expr.Node) (*big.Rat, bool) {
+	switch n := node.(type) {
+	case *expr.Number:
+		return numberRat(n)
+	case *expr.Unary:
+		if x, ok := rat(n.X); ok && n.Op == "-" {
+			return x.Neg(x), true
+		}
+	case *expr.Binary:
+		if n.Op != "/" {
+			return nil, false
+		}
+		x, ok := rat(n.X)
+		y, ok2 := rat(n.Y)
+		if ok && ok2 && y.Sign() != 0 {
+			return x.Quo(x, y), true
+		}
+	}
+	return nil, false
+}
+
+// depends reports whether node uses variable
+func depends(node expr.Node, variable string) bool {
+	switch n := node.(type) {
+	case *expr.Ident:
+		return strings.EqualFold(n.Name, variable)
+	case *expr.Unary:
+		return depends(n.X, variable)
+	case *expr.Binary:
+		return depends(n.X, variable) || depends(n.Y, variable)
+	case *expr.Call:
+		for _, arg := range n.Args {
+			if depends(arg, variable) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// size returns the number of nodes of an expression, counting no further
+// than limit + 1
+func size(node expr.Node, limit int) int {
+	switch n := node.(type) {
+	case *expr.Unary:
+		return 1 + size(n.X, limit-1)
+	case *expr.Binary:
+		total := 1 + size(n.X, limit-1)
+		if total > limit {
+			return total
+		}
+		return total + size(n.Y, limit-total)
+	case *expr.Call:
+		total := 1
+		for _, arg := range n.Args {
+			if total > limit {
+				break
+			}
+			total += size(arg, limit-total)
+		}
+		return total
+	}
+	return 1
+}
+
+// number returns the node of an integer
+func number(n int64) expr.Node {
+	if n < 0 {
+		return &expr.Unary{Op: "-", X: number(-n)}
+	}
+	return intNode(big.NewInt(n))
+}
+
+// binary returns the node of a binary operation
+func binary(op string, x, y expr.Node) expr.Node {
+	return &expr.Binary{Op: op, X: x, Y: y}
+}
+
+// call returns the node of a call of a function
+func call(name string, args ...expr.Node) expr.Node {
+	return &expr.Call{Func: name, Args: args}
+}
@@ -0,0 +1,169 @@
+package symbolic_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"llamacalc/pkg/calc"
+	"llamacalc/pkg/calc/expr"
+	"llamacalc/pkg/numerics"
+	"llamacalc/pkg/symbolic"
+)
+
+var simplifyCases = []struct {
+	Expression string
+	Want       string
+}{
+	{"x + x", "2*x"},
+	{"2*x*3*x", "6*x^2"},
+	{"x - x + 0", "0"},
+	{"x/x", "1"},
+	{"1/2*x", "x/2"},
+	{"-3/4*x^2", "-3*x^2/4"},
+	{"x^2*x^-3", "1/x"},
+	{"x*y^-2", "x/y^2"},
+	{"(x + 1)*(x + 1)", "(x + 1)^2"},
+	{"(x + 1)^2/(x + 1)", "x + 1"},
+	{"-(x + 1) + 3", "-x + 2"},
+	{"x^(1/2)*x^(1/2)", "x"},
+	{"2^x*2^x", "2^(2*x)"},
+	{"(-x)^2", "x^2"},
+	{"pow(X, 2) + add(x, 1)", "x^2 + x + 1"},
+	{"sqrt(16) + cos(0) + ln(1)", "5"},
+	{"sqrt(2)*sqrt(2)", "sqrt(2)^2"},
+	{"0.1 + 0.2", "3/10"},
+	{"2^10", "1024"},
+	{"10^10000", "10^10000"},
+	{"0*(1/0)", "0/0"},
+	{"a^b^c", "a^b^c"},
+	{"(a^b)^c", "(a^b)^c"},
+	{"~x + 0", "~x"},
+}
+
+func TestSimplify(t *testing.T) {
+	for _, tc := range simplifyCases {
+		t.Run(tc.Expression, func(t *testing.T) {
+			node, err := expr.Parse(tc.Expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := expr.Format(symbolic.Simplify(node)); got != tc.Want {
+				t.Errorf("got %s, want %s", got, tc.Want)
+			}
+
+			// The canonical form is simplified already
+			node, err = expr.Parse(tc.Want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := expr.Format(symbolic.Simplify(node)); got != tc.Want {
+				t.Errorf("simplifying %s again gives %s", tc.Want, got)
+			}
+		})
+	}
+}
+
+var differentiateCases = []struct {
+	Expression string
+	Order      int
+	Want       string
+}{
+	{"3*x^2 - 2*x + 1", 1, "6*x - 2"},
+	{"x^3", 2, "6*x"},
+	{"sin(x)*cos(x)", 1, "cos(x)^2 - sin(x)^2"},
+	{"x/(x + 1)", 1, "1/(x + 1)^2"},
+	{"exp(2*x)", 1, "2*exp(2*x)"},
+	{"x^x", 1, "(ln(x) + 1)*x^x"},
+	{"2^x", 1, "2^x*ln(2)"},
+	{"sqrt(x^2 + 1)", 1, "x/sqrt(x^2 + 1)"},
+	{"tan(x)", 1, "1/cos(x)^2"},
+	{"acos(x)", 1, "-1/sqrt(-x^2 + 1)"},
+	{"atan(x^2)", 1, "2*x/(x^4 + 1)"},
+	{"logbase(x, 2)", 1, "1/(ln(2)*x)"},
+	{"nthroot(x, 3)", 1, "nthroot(x, 3)/(3*x)"},
+	{"abs(sinh(x))", 1, "cosh(x)*sinh(x)/abs(sinh(x))"},
+	{"pi*x^2 + e", 1, "2*pi*x"},
+	{"sin(x)*exp(x)", 4, "-4*exp(x)*sin(x)"},
+}
+
+func TestDifferentiate(t *testing.T) {
+	engine := calc.NewDefaultCalculator()
+	for _, tc := range differentiateCases {
+		t.Run(tc.Expression, func(t *testing.T) {
+			node, err := expr.Parse(tc.Expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := symbolic.Differentiate(node, "x", tc.Order)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := expr.Format(d)
+			if got != tc.Want {
+				t.Errorf("got %s, want %s", got, tc.Want)
+			}
+
+			// The derivative agrees with numerical differentiation
+			if tc.Order > numerics.MaxOrder {
+				return
+			}
+			f, err := engine.Function(tc.Expression, "x")
+			if err != nil {
+				t.Skip(err)
+			}
+			df, err := engine.Function(got, "x")
+			if err != nil {
+				t.Fatalf("Function(%q): %v", got, err)
+			}
+			want, err := numerics.Derivative(context.Background(), f, 0.7, tc.Order, numerics.Options{Tolerance: 1e-8})
+			if err != nil {
+				t.Fatal(err)
+			}
+			value, err := df(context.Background(), 0.7)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(value-want.Value) > 1e-6*math.Max(1, math.Abs(want.Value)) {
+				t.Errorf("%s is %g at 0.7, want %g", got, value, want.Value)
+			}
+		})
+	}
+}
+
+func TestDifferentiateErrors(t *testing.T) {
+	cases := []struct {
+		Expression string
+		Variable   string
+		Order      int
+		Field      string
+	}{
+		{"floor(x)", "x", 1, "expression"},
+		{"x % 2", "x", 1, "expression"},
+		{"sin(x, 2)", "x", 1, "expression"},
+		{"x^2", "pi", 1, "variable"},
+		{"x^2", "2*x", 1, "variable"},
+		{"x^2", "x", 0, "order"},
+		{"x^2", "x", symbolic.MaxOrder + 1, "order"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.Expression, func(t *testing.T) {
+			node, err := expr.Parse(tc.Expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = symbolic.Differentiate(node, tc.Variable, tc.Order)
+			var fieldErr *calc.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tc.Field || !errors.Is(err, calc.ErrInvalidInput) {
+				t.Errorf("got %v, want ErrInvalidInput for field %s", err, tc.Field)
+			}
+		})
+	}
+
+	// Derivatives of constants are zero whatever their functions
+	node, _ := expr.Parse("floor(y) + x")
+	if d, err := symbolic.Differentiate(node, "x", 1); err != nil || expr.Format(d) != "1" {
+		t.Errorf("got %v, %v, want 1", d, err)
+	}
+}
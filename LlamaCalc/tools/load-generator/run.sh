@@ -62,7 +62,7 @@ else
 fi
 
 # Build the command
-CMD="ghz --proto=/protos/calculator.proto --call=llamacalc.Calculator.$METHOD $SECURITY_OPTS --data='$DATA' --rps=$RATE --connections=$CONCURRENCY --insecure"
+CMD="ghz --import-paths=/protos --proto=/protos/llamacalc/v1/calculator.proto --call=llamacalc.v1.Calculator.$METHOD $SECURITY_OPTS --data='$DATA' --rps=$RATE --connections=$CONCURRENCY --insecure"
 
 # Add either duration or total
 if [ "$TOTAL" -gt 0 ]; then